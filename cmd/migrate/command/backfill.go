@@ -0,0 +1,94 @@
+package command
+
+import (
+  "fmt"
+  "github.com/spf13/cobra"
+  "golang.org/x/net/context"
+  "log"
+  "manga-explorer/database"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/infrastructure/file"
+  "os"
+  "path/filepath"
+)
+
+var backfillPagesCmd = &cobra.Command{
+  Use:     "backfill-pages",
+  Short:   "Extract image metadata of pages uploaded before the metadata is stored",
+  Example: "migrate backfill-pages --env --dir ./files",
+  Run:     runBackfillPages,
+}
+
+func init() {
+  backfillPagesCmd.Flags().String("dir", "./files", "directory of the stored files")
+  backfillPagesCmd.Flags().Int("batch", 100, "number of pages processed on each query")
+
+  rootCmd.AddCommand(backfillPagesCmd)
+}
+
+func runBackfillPages(command *cobra.Command, args []string) {
+  db, err := openDb(getDSN(command))
+  if err != nil {
+    log.Fatalln("Failed to open database: ", err)
+  }
+  defer database.Close(db)
+
+  dir, _ := command.Flags().GetString("dir")
+  batch, _ := command.Flags().GetInt("batch")
+  ctx := context.Background()
+
+  // Pages which are failed to be processed still don't have the metadata, so use the last id instead of offset
+  lastId := ""
+  succeed, failed := 0, 0
+  for {
+    var pages []mangas.Page
+    query := db.NewSelect().
+      Model(&pages).
+      Where("width IS NULL").
+      Order("id").
+      Limit(batch)
+    if len(lastId) != 0 {
+      query = query.Where("id > ?", lastId)
+    }
+    if err = query.Scan(ctx); err != nil {
+      log.Fatalln("Failed to get pages: ", err)
+    }
+    if len(pages) == 0 {
+      break
+    }
+    lastId = pages[len(pages)-1].Id
+
+    for i := range pages {
+      page := &pages[i]
+      info, err := readStoredImage(filepath.Join(dir, file.MangaAsset.String(), page.ImageURL.String()))
+      if err != nil {
+        log.Printf("Failed to read image of page %s (%s): %s\n", page.Id, page.ImageURL, err)
+        failed++
+        continue
+      }
+
+      page.SetImageInfo(info)
+      _, err = db.NewUpdate().
+        Model(page).
+        Column("width", "height", "size", "mime_type", "blur_hash").
+        WherePK().
+        Exec(ctx)
+      if err != nil {
+        log.Printf("Failed to update page %s: %s\n", page.Id, err)
+        failed++
+        continue
+      }
+      succeed++
+    }
+  }
+
+  fmt.Printf("Success! %d pages updated, %d pages failed\n", succeed, failed)
+}
+
+func readStoredImage(path string) (file.ImageInfo, error) {
+  data, err := os.ReadFile(path)
+  if err != nil {
+    return file.ImageInfo{}, err
+  }
+  return file.ReadImageInfo(data)
+}
@@ -0,0 +1,282 @@
+package command
+
+import (
+  "errors"
+  "fmt"
+  "github.com/spf13/cobra"
+  "github.com/uptrace/bun"
+  "golang.org/x/net/context"
+  "io"
+  "io/fs"
+  "log"
+  "manga-explorer/database"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/users"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
+  "manga-explorer/internal/infrastructure/file/s3"
+  fileService "manga-explorer/internal/infrastructure/file/service"
+  "manga-explorer/internal/infrastructure/repository/files/pg"
+  "net/http"
+  "os"
+  "path"
+  "path/filepath"
+  "strings"
+  "time"
+)
+
+var gcCmd = &cobra.Command{
+  Use:     "gc",
+  Short:   "Delete stored files which are not referenced by pages, mangas, volumes and profiles",
+  Example: "migrate gc --env --dir ./files --grace 24h --dry-run",
+  Run:     runGC,
+}
+
+func init() {
+  gcCmd.Flags().String("storage", "local", "storage of the files, local or s3 which uses the S3 config from environment variables")
+  gcCmd.Flags().String("dir", "./files", "directory of the stored files for local storage")
+  gcCmd.Flags().Duration("grace", 24*time.Hour, "only delete files older than the duration, so files of uploads in progress are kept")
+  gcCmd.Flags().Bool("dry-run", false, "only report the unreferenced files without deleting them")
+
+  rootCmd.AddCommand(gcCmd)
+}
+
+// storedObject file or variant of the file on the storage, the key is relative to the asset type directory
+type storedObject struct {
+  Key     string
+  Name    file.Name
+  Size    int64
+  ModTime time.Time
+}
+
+type storage interface {
+  // Walk call fn for each stored file and variant of the asset type
+  Walk(types file.AssetType, fn func(object storedObject) error) error
+  Remove(types file.AssetType, key string) error
+}
+
+// unreferencedFile the file and the variants which are not used by any record
+type unreferencedFile struct {
+  keys    []string
+  size    int64
+  modTime time.Time // The newest of the file and the variants
+}
+
+func runGC(command *cobra.Command, args []string) {
+  db, err := openDb(getDSN(command))
+  if err != nil {
+    log.Fatalln("Failed to open database: ", err)
+  }
+  defer database.Close(db)
+
+  grace, _ := command.Flags().GetDuration("grace")
+  dryRun, _ := command.Flags().GetBool("dry-run")
+  store, err := openStorage(command)
+  if err != nil {
+    log.Fatalln("Failed to open storage: ", err)
+  }
+
+  findReferenced := func(types file.AssetType) (map[file.Name]struct{}, error) {
+    return findReferencedFiles(db, types)
+  }
+  result, err := collectGarbage(store, pg.NewReference(db), findReferenced, time.Now().Add(-grace), dryRun, os.Stdout)
+  if err != nil {
+    log.Fatalln(err)
+  }
+
+  if dryRun {
+    fmt.Printf("Success! %d unreferenced files found (%d bytes)\n", result.count, result.size)
+    return
+  }
+  fmt.Printf("Success! %d files deleted (%d bytes), %d files failed\n", result.count, result.size, result.failed)
+}
+
+// gcResult number of the files and the size which are deleted or found on dry run
+type gcResult struct {
+  count  int
+  size   int64
+  failed int
+}
+
+// collectGarbage delete the stored files which are not referenced and modified before the time, each deleted file is
+// reported to the writer. The files are only reported without being deleted on dry run.
+func collectGarbage(store storage, references repository.IReference, findReferenced func(types file.AssetType) (map[file.Name]struct{}, error),
+  before time.Time, dryRun bool, out io.Writer) (gcResult, error) {
+
+  result := gcResult{}
+  for _, types := range []file.AssetType{file.MangaAsset, file.CoverAsset, file.VolumeAsset, file.ProfileAsset} {
+    // Get the files first, so the files uploaded after it is walked are never seen as unreferenced
+    unreferenced := map[file.Name]*unreferencedFile{}
+    err := store.Walk(types, func(object storedObject) error {
+      current, ok := unreferenced[object.Name]
+      if !ok {
+        current = &unreferencedFile{}
+        unreferenced[object.Name] = current
+      }
+      current.keys = append(current.keys, object.Key)
+      current.size += object.Size
+      if object.ModTime.After(current.modTime) {
+        current.modTime = object.ModTime
+      }
+      return nil
+    })
+    if err != nil {
+      return result, fmt.Errorf("failed to walk %s: %w", types, err)
+    }
+
+    referenced, err := findReferenced(types)
+    if err != nil {
+      return result, fmt.Errorf("failed to get referenced %s: %w", types, err)
+    }
+
+    for name, current := range unreferenced {
+      if _, ok := referenced[name]; ok || !current.modTime.Before(before) {
+        continue
+      }
+
+      if dryRun {
+        fmt.Fprintf(out, "Unreferenced %s/%s (%d bytes, modified %s)\n", types, name, current.size, current.modTime.Format(time.RFC3339))
+        result.count++
+        result.size += current.size
+        continue
+      }
+
+      collected, err := references.Collect(types, name, before, func() error {
+        for _, key := range current.keys {
+          if err := store.Remove(types, key); err != nil {
+            return err
+          }
+        }
+        return nil
+      })
+      if err != nil {
+        log.Printf("Failed to delete %s/%s: %s\n", types, name, err)
+        result.failed++
+        continue
+      }
+      if collected {
+        fmt.Fprintf(out, "Deleted %s/%s (%d bytes)\n", types, name, current.size)
+        result.count++
+        result.size += current.size
+      }
+    }
+  }
+  return result, nil
+}
+
+// findReferencedFiles get the name of all files of the asset type which are still used by the records
+func findReferencedFiles(db bun.IDB, types file.AssetType) (map[file.Name]struct{}, error) {
+  var query *bun.SelectQuery
+  switch types {
+  case file.MangaAsset:
+    query = db.NewSelect().Model((*mangas.Page)(nil)).Column("image_url")
+  case file.CoverAsset:
+    query = db.NewSelect().Model((*mangas.Manga)(nil)).Column("cover_url").Where("cover_url IS NOT NULL")
+  case file.VolumeAsset:
+    query = db.NewSelect().Model((*mangas.Volume)(nil)).Column("cover_url").Where("cover_url IS NOT NULL")
+  case file.ProfileAsset:
+    query = db.NewSelect().Model((*users.Profile)(nil)).Column("photo_url").Where("photo_url IS NOT NULL")
+  default:
+    return nil, fmt.Errorf("unknown asset type %s", types)
+  }
+
+  var names []file.Name
+  err := query.Scan(context.Background(), &names)
+  if err != nil {
+    return nil, err
+  }
+  result := make(map[file.Name]struct{}, len(names))
+  for _, name := range names {
+    result[name] = struct{}{}
+  }
+  return result, nil
+}
+
+func openStorage(command *cobra.Command) (storage, error) {
+  storageType, _ := command.Flags().GetString("storage")
+  switch storageType {
+  case "local":
+    dir, _ := command.Flags().GetString("dir")
+    return localStorage{dir: dir}, nil
+  case "s3":
+    config, err := common.LoadConfig()
+    if err != nil {
+      return nil, err
+    }
+    publicClient, privateClient, err := fileService.NewS3Clients(config, &http.Client{})
+    if err != nil {
+      return nil, err
+    }
+    return s3Storage{publicClient: publicClient, privateClient: privateClient}, nil
+  }
+  return nil, fmt.Errorf("unknown storage %s, it should be local or s3", storageType)
+}
+
+type localStorage struct {
+  dir string
+}
+
+func (l localStorage) Walk(types file.AssetType, fn func(object storedObject) error) error {
+  root := filepath.Join(l.dir, types.String())
+  return filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+    if errors.Is(err, fs.ErrNotExist) && path == root {
+      return nil
+    }
+    if err != nil || entry.IsDir() {
+      return err
+    }
+
+    info, err := entry.Info()
+    if err != nil {
+      return err
+    }
+    key, err := filepath.Rel(root, path)
+    if err != nil {
+      return err
+    }
+    return fn(storedObject{
+      Key:     filepath.ToSlash(key),
+      Name:    file.Name(entry.Name()),
+      Size:    info.Size(),
+      ModTime: info.ModTime(),
+    })
+  })
+}
+
+func (l localStorage) Remove(types file.AssetType, key string) error {
+  err := os.Remove(filepath.Join(l.dir, types.String(), filepath.FromSlash(key)))
+  if errors.Is(err, fs.ErrNotExist) {
+    return nil
+  }
+  return err
+}
+
+type s3Storage struct {
+  publicClient  *s3.Client
+  privateClient *s3.Client
+}
+
+// getClient get the client of the bucket where the asset type is stored, the same as the file service
+func (s s3Storage) getClient(types file.AssetType) *s3.Client {
+  if types.IsPrivate() {
+    return s.privateClient
+  }
+  return s.publicClient
+}
+
+func (s s3Storage) Walk(types file.AssetType, fn func(object storedObject) error) error {
+  prefix := types.String() + "/"
+  return s.getClient(types).ListObjects(context.Background(), prefix, func(object s3.Object) error {
+    return fn(storedObject{
+      Key:     strings.TrimPrefix(object.Key, prefix),
+      Name:    file.Name(path.Base(object.Key)),
+      Size:    object.Size,
+      ModTime: object.LastModified,
+    })
+  })
+}
+
+func (s s3Storage) Remove(types file.AssetType, key string) error {
+  return s.getClient(types).DeleteObject(context.Background(), types.String()+"/"+key)
+}
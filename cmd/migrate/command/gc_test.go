@@ -0,0 +1,155 @@
+package command
+
+import (
+  "bytes"
+  "errors"
+  "io/fs"
+  "manga-explorer/internal/infrastructure/file"
+  referenceMock "manga-explorer/internal/infrastructure/file/repository/mocks"
+  "os"
+  "path/filepath"
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/mock"
+  "github.com/stretchr/testify/require"
+)
+
+var (
+  gcNow    = time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+  gcBefore = gcNow.Add(-24 * time.Hour)
+  gcOld    = gcBefore.Add(-time.Hour)
+  gcFresh  = gcBefore.Add(time.Hour)
+)
+
+// testStorage create local storage with the files, the key is the path relative to the storage directory
+func testStorage(t *testing.T, files map[string]time.Time) localStorage {
+  dir := t.TempDir()
+  for key, modTime := range files {
+    path := filepath.Join(dir, filepath.FromSlash(key))
+    require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+    require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+    require.NoError(t, os.Chtimes(path, modTime, modTime))
+  }
+  return localStorage{dir: dir}
+}
+
+func testReferenced(referenced map[file.AssetType][]file.Name) func(types file.AssetType) (map[file.Name]struct{}, error) {
+  return func(types file.AssetType) (map[file.Name]struct{}, error) {
+    result := map[file.Name]struct{}{}
+    for _, name := range referenced[types] {
+      result[name] = struct{}{}
+    }
+    return result, nil
+  }
+}
+
+func exists(store localStorage, key string) bool {
+  _, err := os.Stat(filepath.Join(store.dir, filepath.FromSlash(key)))
+  return !errors.Is(err, fs.ErrNotExist)
+}
+
+func testGCFiles() map[string]time.Time {
+  return map[string]time.Time{
+    "mangas/used.png":              gcOld,
+    "mangas/small/used.png":        gcOld,
+    "mangas/unused.png":            gcOld,
+    "mangas/small/unused.png":      gcOld,
+    "mangas/medium/unused.png":     gcOld,
+    "mangas/fresh.png":             gcFresh,
+    "mangas/.staging/leftover.png": gcOld,
+    "covers/unused.webp":           gcOld,
+    "covers/reacquired.webp":       gcOld,
+    "profiles/resized.png":         gcOld,
+    "profiles/small/resized.png":   gcFresh,
+    "volumes/used.jpg":             gcOld,
+    "volumes/failed.jpg":           gcOld,
+  }
+}
+
+func TestCollectGarbage(t *testing.T) {
+  store := testStorage(t, testGCFiles())
+  findReferenced := testReferenced(map[file.AssetType][]file.Name{
+    file.MangaAsset:  {"used.png"},
+    file.VolumeAsset: {"used.jpg"},
+  })
+
+  references := referenceMock.NewReferenceMock(t)
+  collect := func(types file.AssetType, name file.Name, before time.Time, remove func() error) (bool, error) {
+    assert.Equal(t, gcBefore, before)
+    return true, remove()
+  }
+  references.EXPECT().Collect(file.AssetType(file.MangaAsset), file.Name("unused.png"), gcBefore, mock.Anything).RunAndReturn(collect).Once()
+  references.EXPECT().Collect(file.AssetType(file.MangaAsset), file.Name("leftover.png"), gcBefore, mock.Anything).RunAndReturn(collect).Once()
+  references.EXPECT().Collect(file.AssetType(file.CoverAsset), file.Name("unused.webp"), gcBefore, mock.Anything).RunAndReturn(collect).Once()
+  // The file is acquired again after it is walked
+  references.EXPECT().Collect(file.AssetType(file.CoverAsset), file.Name("reacquired.webp"), gcBefore, mock.Anything).Return(false, nil).Once()
+  references.EXPECT().Collect(file.AssetType(file.VolumeAsset), file.Name("failed.jpg"), gcBefore, mock.Anything).Return(false, errors.New("failed")).Once()
+
+  out := &bytes.Buffer{}
+  result, err := collectGarbage(store, references, findReferenced, gcBefore, false, out)
+  require.NoError(t, err)
+  assert.Equal(t, gcResult{count: 3, size: 5 * 4, failed: 1}, result)
+  assert.Contains(t, out.String(), "Deleted mangas/unused.png (12 bytes)")
+
+  deleted := []string{
+    "mangas/unused.png",
+    "mangas/small/unused.png",
+    "mangas/medium/unused.png",
+    "mangas/.staging/leftover.png",
+    "covers/unused.webp",
+  }
+  for key := range testGCFiles() {
+    assert.Equal(t, !contains(deleted, key), exists(store, key), key)
+  }
+}
+
+func TestCollectGarbage_DryRun(t *testing.T) {
+  store := testStorage(t, testGCFiles())
+  findReferenced := testReferenced(map[file.AssetType][]file.Name{
+    file.MangaAsset:  {"used.png"},
+    file.VolumeAsset: {"used.jpg"},
+  })
+  // Nothing is collected on dry run
+  references := referenceMock.NewReferenceMock(t)
+
+  out := &bytes.Buffer{}
+  result, err := collectGarbage(store, references, findReferenced, gcBefore, true, out)
+  require.NoError(t, err)
+  assert.Equal(t, gcResult{count: 5, size: 7 * 4}, result)
+  for _, reported := range []string{"mangas/unused.png", "mangas/leftover.png", "covers/unused.webp", "covers/reacquired.webp", "volumes/failed.jpg"} {
+    assert.Contains(t, out.String(), "Unreferenced "+reported)
+  }
+  assert.NotContains(t, out.String(), "mangas/used.png")
+  assert.NotContains(t, out.String(), "volumes/used.jpg")
+  assert.NotContains(t, out.String(), "fresh.png")
+  assert.NotContains(t, out.String(), "resized.png")
+
+  for key := range testGCFiles() {
+    assert.True(t, exists(store, key), key)
+  }
+}
+
+func TestCollectGarbage_ReferencedError(t *testing.T) {
+  store := testStorage(t, testGCFiles())
+  references := referenceMock.NewReferenceMock(t)
+  findReferenced := func(types file.AssetType) (map[file.Name]struct{}, error) {
+    return nil, errors.New("connection refused")
+  }
+
+  _, err := collectGarbage(store, references, findReferenced, gcBefore, false, &bytes.Buffer{})
+  assert.ErrorContains(t, err, "connection refused")
+  for key := range testGCFiles() {
+    assert.True(t, exists(store, key), key)
+  }
+}
+
+func contains(values []string, value string) bool {
+  for _, current := range values {
+    if current == value {
+      return true
+    }
+  }
+  return false
+}
@@ -25,15 +25,16 @@ var rootCmd = &cobra.Command{
 }
 
 func init() {
-  rootCmd.Flags().StringP("user", "u", "", "database username")
-  rootCmd.Flags().StringP("pass", "p", "", "database password")
-  rootCmd.Flags().String("host", "", "database host url")
-  rootCmd.Flags().StringP("database", "d", "manga_explorer", "database name")
-  rootCmd.Flags().String("dsn", "", "database dsn")
+  // Database connection flags are shared with the sub commands
+  rootCmd.PersistentFlags().StringP("user", "u", "", "database username")
+  rootCmd.PersistentFlags().StringP("pass", "p", "", "database password")
+  rootCmd.PersistentFlags().String("host", "", "database host url")
+  rootCmd.PersistentFlags().StringP("database", "d", "manga_explorer", "database name")
+  rootCmd.PersistentFlags().String("dsn", "", "database dsn")
+  rootCmd.PersistentFlags().Bool("no-ssl", false, "Disable SSL on database communication")
+  rootCmd.PersistentFlags().Bool("env", false, "Use environment variables for database connection")
   rootCmd.Flags().StringP("seed", "s", "", "seed database without migrating")
   rootCmd.Flags().Bool("special", false, "add special record (admin) from env")
-  rootCmd.Flags().Bool("no-ssl", false, "Disable SSL on database communication")
-  rootCmd.Flags().Bool("env", false, "Use environment variables for database connection")
 
   rootCmd.MarkFlagsRequiredTogether("user", "pass", "host")
   rootCmd.MarkFlagsMutuallyExclusive("user", "dsn", "env")
@@ -42,27 +43,7 @@ func init() {
 }
 
 func runRoot(command *cobra.Command, args []string) {
-  dsn, _ := command.Flags().GetString("dsn")
-  if len(dsn) == 0 {
-    env, _ := command.Flags().GetBool("env")
-    if env {
-      config, err := common.LoadConfig()
-      if err != nil {
-        panic(err)
-      }
-      dsn = config.DatabaseDSN()
-    } else {
-      username, _ := command.Flags().GetString("user")
-      pass, _ := command.Flags().GetString("pass")
-      host, _ := command.Flags().GetString("host")
-      dbName, _ := command.Flags().GetString("database")
-      noSSL, _ := command.Flags().GetBool("no-ssl")
-      dsn = fmt.Sprintf("postgres://%s:%s@%s/%s", username, pass, host, dbName)
-      if noSSL {
-        dsn += "?sslmode=disable"
-      }
-    }
-  }
+  dsn := getDSN(command)
 
   //log.Println("Open database connection with DSN: ", dsn)
 
@@ -120,6 +101,34 @@ func Execute() error {
   return rootCmd.Execute()
 }
 
+// getDSN get the database dsn from the connection flags
+func getDSN(command *cobra.Command) string {
+  dsn, _ := command.Flags().GetString("dsn")
+  if len(dsn) != 0 {
+    return dsn
+  }
+
+  env, _ := command.Flags().GetBool("env")
+  if env {
+    config, err := common.LoadConfig()
+    if err != nil {
+      panic(err)
+    }
+    return config.DatabaseDSN()
+  }
+
+  username, _ := command.Flags().GetString("user")
+  pass, _ := command.Flags().GetString("pass")
+  host, _ := command.Flags().GetString("host")
+  dbName, _ := command.Flags().GetString("database")
+  noSSL, _ := command.Flags().GetBool("no-ssl")
+  dsn = fmt.Sprintf("postgres://%s:%s@%s/%s", username, pass, host, dbName)
+  if noSSL {
+    dsn += "?sslmode=disable"
+  }
+  return dsn
+}
+
 func openDb(dsn string) (*bun.DB, error) {
   sqlDb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
   if err := sqlDb.Ping(); err != nil {
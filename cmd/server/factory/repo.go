@@ -4,6 +4,8 @@ import (
   "github.com/uptrace/bun"
   mangaRepo "manga-explorer/internal/domain/mangas/repository"
   userRepo "manga-explorer/internal/domain/users/repository"
+  fileRepo "manga-explorer/internal/infrastructure/file/repository"
+  filePg "manga-explorer/internal/infrastructure/repository/files/pg"
   mangaPg "manga-explorer/internal/infrastructure/repository/mangas/pg"
   userPg "manga-explorer/internal/infrastructure/repository/users/pg"
 )
@@ -18,6 +20,12 @@ type Repository struct {
   Genre        mangaRepo.IGenre
   Rate         mangaRepo.IRate
   Translation  mangaRepo.ITranslation
+  Tag          mangaRepo.ITag
+  Person       mangaRepo.IPerson
+  Group        mangaRepo.IGroup
+  AltTitle     mangaRepo.IAlternativeTitle
+
+  FileReference fileRepo.IReference
 }
 
 func CreateRepositories(db bun.IDB) Repository {
@@ -31,5 +39,11 @@ func CreateRepositories(db bun.IDB) Repository {
     Genre:        mangaPg.NewMangaGenre(db),
     Rate:         mangaPg.NewMangaRate(db),
     Translation:  mangaPg.NewTranslationRepository(db),
+    Tag:          mangaPg.NewMangaTag(db),
+    Person:       mangaPg.NewPerson(db),
+    Group:        mangaPg.NewGroup(db),
+    AltTitle:     mangaPg.NewAlternativeTitleRepository(db),
+
+    FileReference: filePg.NewReference(db),
   }
 }
@@ -18,6 +18,9 @@ func CreateRouter(config *common.Config, service *Service, router gin.IRouter) r
     Manga:        mangaController.NewMangaController(service.Manga),
     MangaChapter: mangaController.NewChapterController(service.Chapter),
     MangaGenre:   mangaController.NewGenreController(service.Genre),
+    MangaTag:     mangaController.NewTagController(service.Tag),
+    MangaPerson:  mangaController.NewPersonController(service.Person),
+    MangaGroup:   mangaController.NewGroupController(service.Group),
   }
 
   middlewareConfig := route.ConfigMiddleware{
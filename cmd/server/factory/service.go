@@ -1,12 +1,14 @@
 package factory
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"manga-explorer/internal/app/service"
 	"manga-explorer/internal/common"
 	"manga-explorer/internal/common/constant"
 	mangaService "manga-explorer/internal/domain/mangas/service"
 	userService "manga-explorer/internal/domain/users/service"
+	fileRepo "manga-explorer/internal/infrastructure/file/repository"
 	fileService "manga-explorer/internal/infrastructure/file/service"
 	mailService "manga-explorer/internal/infrastructure/mail/service"
 )
@@ -22,6 +24,9 @@ type Service struct {
 	Manga          mangaService.IManga
 	Chapter        mangaService.IChapter
 	Genre          mangaService.IGenre
+	Tag            mangaService.ITag
+	Person         mangaService.IPerson
+	Group          mangaService.IGroup
 }
 
 func CreateServices(config *common.Config, repository *Repository, router gin.IRouter) Service {
@@ -32,15 +37,29 @@ func CreateServices(config *common.Config, repository *Repository, router gin.IR
 			User: config.SMTPUser,
 			Pass: config.SMTPPass,
 		}),
-		File:           fileService.NewLocalFileService(config, config.Endpoint(), "/static", "./files", router), // Used for both user profile and manga chapter images
+		File:           createFileService(config, repository.FileReference, router), // Used for both user profile and manga chapter images
 		Authentication: service.NewCredential(config, repository.Credential, repository.User),
 		Verification:   service.NewVerification(config, repository.Verification),
 		Genre:          service.NewGenreService(repository.Genre),
+		Tag:            service.NewTagService(repository.Tag),
+		Person:         service.NewPersonService(repository.Person),
 	}
 
 	result.User = service.NewUser(config, repository.User, result.Verification, result.Authentication, result.Mail, result.File)
-	result.Manga = service.NewMangaService(result.File, repository.Manga, repository.Translation, repository.Comment, repository.Rate)
+	result.Manga = service.NewMangaService(result.File, repository.Manga, repository.Translation, repository.AltTitle, repository.Comment, repository.Rate, repository.User)
 	result.Chapter = service.NewChapterService(result.File, repository.Chapter, repository.Comment)
+	result.Group = service.NewGroupService(result.File, repository.Group)
 
 	return result
 }
+
+// createFileService create the file service based on the storage config, the local storage serves the files by itself
+func createFileService(config *common.Config, references fileRepo.IReference, router gin.IRouter) fileService.IFile {
+	switch config.Storage {
+	case "local":
+		return fileService.NewLocalFileService(config, config.Endpoint(), "/static", "./files", router, references)
+	case "s3":
+		return fileService.NewS3FileService(config, references)
+	}
+	panic(fmt.Sprintf("Unknown storage %s, it should be local or s3", config.Storage))
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+	"manga-explorer/internal/domain/mangas"
+)
+
+// Each group only has single chapter with the same number and language on each volume. It could not be an unique
+// index, because the groups and the chapters are on different tables. The advisory lock serializes the check for the
+// same group, so concurrent transactions could not credit the group on the same chapter.
+var chapterGroupConstraintQueries = []string{
+	`CREATE OR REPLACE FUNCTION check_chapter_group_unique() RETURNS trigger AS $$
+DECLARE
+	current_chapter_id uuid;
+BEGIN
+	IF TG_TABLE_NAME = 'chapters' THEN
+		current_chapter_id := NEW.id;
+	ELSE
+		current_chapter_id := NEW.chapter_id;
+	END IF;
+
+	PERFORM pg_advisory_xact_lock(hashtext('chapter_groups:' || credited.group_id::text))
+	FROM (SELECT group_id FROM chapter_groups WHERE chapter_id = current_chapter_id ORDER BY group_id) AS credited;
+
+	IF EXISTS (
+		SELECT 1
+		FROM chapter_groups AS cg
+		JOIN chapter_groups AS other ON other.group_id = cg.group_id AND other.chapter_id != cg.chapter_id
+		JOIN chapters AS base ON base.id = cg.chapter_id
+		JOIN chapters AS target ON target.id = other.chapter_id
+		WHERE cg.chapter_id = current_chapter_id
+			AND target.manga_id = base.manga_id AND target.volume_id IS NOT DISTINCT FROM base.volume_id
+			AND target.language = base.language AND target.number = base.number
+	) THEN
+		RAISE EXCEPTION 'group already credited on the same chapter'
+			USING ERRCODE = 'unique_violation', CONSTRAINT = '` + mangas.ChapterGroupConstraint + `';
+	END IF;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS chapter_groups_unique_check ON chapter_groups`,
+	`CREATE TRIGGER chapter_groups_unique_check
+	AFTER INSERT OR UPDATE ON chapter_groups
+	FOR EACH ROW EXECUTE FUNCTION check_chapter_group_unique()`,
+	`DROP TRIGGER IF EXISTS chapters_group_unique_check ON chapters`,
+	`CREATE TRIGGER chapters_group_unique_check
+	AFTER UPDATE OF manga_id, volume_id, language, number ON chapters
+	FOR EACH ROW EXECUTE FUNCTION check_chapter_group_unique()`,
+}
+
+// Chapters without credited group only has single chapter with the same number and language on each volume, like the
+// previous chapter_lang_idx. The groups are credited after the chapter is inserted and the last group could be removed
+// later, so the check is deferred until the groups are known.
+var chapterConstraintQueries = []string{
+	`CREATE OR REPLACE FUNCTION check_chapter_ungrouped_unique() RETURNS trigger AS $$
+DECLARE
+	current_chapter chapters%ROWTYPE;
+BEGIN
+	IF TG_TABLE_NAME = 'chapters' THEN
+		SELECT * INTO current_chapter FROM chapters WHERE id = NEW.id;
+	ELSE
+		SELECT * INTO current_chapter FROM chapters WHERE id = OLD.chapter_id;
+	END IF;
+
+	-- Deleted chapter or chapter with credited groups which is checked by check_chapter_group_unique
+	IF NOT FOUND OR EXISTS (SELECT 1 FROM chapter_groups WHERE chapter_id = current_chapter.id) THEN
+		RETURN NULL;
+	END IF;
+
+	PERFORM pg_advisory_xact_lock(hashtext('chapters:' || current_chapter.manga_id::text || ':' ||
+		current_chapter.language || ':' || current_chapter.number::text));
+
+	IF EXISTS (
+		SELECT 1
+		FROM chapters AS target
+		WHERE target.id != current_chapter.id AND target.manga_id = current_chapter.manga_id
+			AND target.volume_id IS NOT DISTINCT FROM current_chapter.volume_id
+			AND target.language = current_chapter.language AND target.number = current_chapter.number
+			AND NOT EXISTS (SELECT 1 FROM chapter_groups WHERE chapter_id = target.id)
+	) THEN
+		RAISE EXCEPTION 'chapter without group already exists'
+			USING ERRCODE = 'unique_violation', CONSTRAINT = '` + mangas.ChapterConstraint + `';
+	END IF;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS ` + mangas.ChapterConstraint + ` ON chapters`,
+	`CREATE CONSTRAINT TRIGGER ` + mangas.ChapterConstraint + `
+	AFTER INSERT OR UPDATE OF manga_id, volume_id, language, number ON chapters
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION check_chapter_ungrouped_unique()`,
+	`DROP TRIGGER IF EXISTS ` + mangas.ChapterConstraint + ` ON chapter_groups`,
+	`CREATE CONSTRAINT TRIGGER ` + mangas.ChapterConstraint + `
+	AFTER UPDATE OR DELETE ON chapter_groups
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION check_chapter_ungrouped_unique()`,
+}
+
+func createChapterGroupConstraint(ctx context.Context, db bun.IDB) error {
+	// Uniqueness used to be per translator, which prevents the same translator to upload the chapter for other group.
+	// It is replaced by the checks for chapters with and without credited groups.
+	_, err := db.ExecContext(ctx, "ALTER TABLE chapters DROP CONSTRAINT IF EXISTS chapter_lang_idx")
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "DROP INDEX IF EXISTS chapter_manga_lang_idx")
+	if err != nil {
+		return err
+	}
+
+	for _, query := range append(chapterGroupConstraintQueries, chapterConstraintQueries...) {
+		if _, err = db.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"manga-explorer/database/fixtures"
 	"manga-explorer/internal/domain/mangas"
 	"manga-explorer/internal/domain/users"
+	"manga-explorer/internal/infrastructure/file"
 	"manga-explorer/internal/util"
 	"os"
 	"slices"
@@ -37,6 +38,17 @@ var tables = []interface{}{
 	(*mangas.MangaGenre)(nil),
 	(*mangas.Translation)(nil),
 	(*mangas.ChapterHistory)(nil),
+	(*mangas.Person)(nil),
+	(*mangas.MangaStaff)(nil),
+	(*mangas.AlternativeTitle)(nil),
+	(*mangas.MangaRelation)(nil),
+	(*mangas.Tag)(nil),
+	(*mangas.MangaTag)(nil),
+	(*mangas.Group)(nil),
+	(*mangas.GroupMember)(nil),
+	(*mangas.ChapterGroup)(nil),
+	(*mangas.MangaSlug)(nil),
+	(*file.Reference)(nil),
 }
 
 func addDebugLog(db *bun.DB) {
@@ -47,6 +59,8 @@ func Migrate(db *bun.DB) error {
 
 	// Registering many-to-many model
 	db.RegisterModel(util.Nil[mangas.MangaGenre]())
+	db.RegisterModel(util.Nil[mangas.MangaTag]())
+	db.RegisterModel(util.Nil[mangas.ChapterGroup]())
 
 	for _, table := range tables {
 		_, err := db.NewCreateTable().
@@ -59,11 +73,17 @@ func Migrate(db *bun.DB) error {
 			return err
 		}
 	}
-	return nil
+
+	if err := upgradeTables(ctx, db); err != nil {
+		return err
+	}
+	return createChapterGroupConstraint(ctx, db)
 }
 
 func RegisterModels(db *bun.DB) {
 	db.RegisterModel(util.Nil[mangas.MangaGenre]())
+	db.RegisterModel(util.Nil[mangas.MangaTag]())
+	db.RegisterModel(util.Nil[mangas.ChapterGroup]())
 	for _, model := range tables {
 		db.RegisterModel(model)
 	}
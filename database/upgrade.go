@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+	"manga-explorer/internal/domain/mangas"
+	"manga-explorer/internal/util"
+)
+
+// Creating the tables is skipped when they already exist, so the columns added after the tables are created should
+// be added by these queries. All queries are executed on each migration, so they should be idempotent.
+var upgradeQueries = []string{
+	// Content rating of the manga and the ratings allowed by the user
+	`ALTER TABLE mangas ADD COLUMN IF NOT EXISTS content_rating SMALLINT NOT NULL DEFAULT 0`,
+	`ALTER TABLE profiles ADD COLUMN IF NOT EXISTS allowed_ratings VARCHAR[]`,
+	// Demographic of the manga
+	`ALTER TABLE mangas ADD COLUMN IF NOT EXISTS demographic SMALLINT NOT NULL DEFAULT 0`,
+	// Cover of the volume
+	`ALTER TABLE volumes ADD COLUMN IF NOT EXISTS cover_url VARCHAR`,
+	// Decimal chapter number, label and kind of the chapter. The type could not be altered when the column is used by
+	// the triggers, so it is only altered once.
+	`DO $$
+BEGIN
+	IF (SELECT data_type FROM information_schema.columns WHERE table_name = 'chapters' AND column_name = 'number') != 'numeric' THEN
+		ALTER TABLE chapters ALTER COLUMN number TYPE numeric(10,2);
+	END IF;
+END
+$$`,
+	`ALTER TABLE chapters ADD COLUMN IF NOT EXISTS label VARCHAR`,
+	`ALTER TABLE chapters ADD COLUMN IF NOT EXISTS kind SMALLINT NOT NULL DEFAULT 0`,
+	// Chapter belongs to the manga directly and the volume is optional, the manga is taken from the current volume.
+	// The foreign key is recreated, so deleting the volume keeps the chapters.
+	`ALTER TABLE chapters ADD COLUMN IF NOT EXISTS manga_id uuid REFERENCES mangas (id) ON DELETE CASCADE`,
+	`UPDATE chapters SET manga_id = volumes.manga_id FROM volumes WHERE volumes.id = chapters.volume_id AND chapters.manga_id IS NULL`,
+	`ALTER TABLE chapters ALTER COLUMN manga_id SET NOT NULL`,
+	`ALTER TABLE chapters ALTER COLUMN volume_id DROP NOT NULL`,
+	`ALTER TABLE chapters DROP CONSTRAINT IF EXISTS chapters_volume_id_fkey`,
+	`ALTER TABLE chapters ADD CONSTRAINT chapters_volume_id_fkey FOREIGN KEY (volume_id) REFERENCES volumes (id) ON DELETE SET NULL`,
+	// Slug of the manga, it is generated by backfillMangaSlugs for the existing mangas
+	`ALTER TABLE mangas ADD COLUMN IF NOT EXISTS slug VARCHAR UNIQUE`,
+	// Permission to download the chapters and volumes
+	`ALTER TABLE mangas ADD COLUMN IF NOT EXISTS allow_download BOOLEAN NOT NULL DEFAULT false`,
+	// Permission to export the chapters and volumes as EPUB
+	`ALTER TABLE mangas ADD COLUMN IF NOT EXISTS allow_export BOOLEAN NOT NULL DEFAULT true`,
+	// Image metadata of the pages, the existing pages are filled by the backfill-pages command
+	`ALTER TABLE pages ADD COLUMN IF NOT EXISTS width INTEGER`,
+	`ALTER TABLE pages ADD COLUMN IF NOT EXISTS height INTEGER`,
+	`ALTER TABLE pages ADD COLUMN IF NOT EXISTS size BIGINT`,
+	`ALTER TABLE pages ADD COLUMN IF NOT EXISTS mime_type VARCHAR`,
+	`ALTER TABLE pages ADD COLUMN IF NOT EXISTS blur_hash VARCHAR`,
+}
+
+func upgradeTables(ctx context.Context, db bun.IDB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, query := range upgradeQueries {
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+		return backfillMangaSlugs(ctx, tx)
+	})
+}
+
+// backfillMangaSlugs generate slug of the mangas created before the slug exists, the older manga gets the slug first
+func backfillMangaSlugs(ctx context.Context, tx bun.Tx) error {
+	var result []mangas.Manga
+	err := tx.NewSelect().
+		Model(&result).
+		Column("id", "original_title").
+		Where("slug IS NULL").
+		Order("created_at").
+		Scan(ctx)
+	if err != nil || len(result) == 0 {
+		return err
+	}
+
+	// Previous slugs are still resolved to the manga, so they could not be used too
+	var used []string
+	err = tx.NewSelect().
+		Model(util.Nil[mangas.Manga]()).
+		Column("slug").
+		Where("slug IS NOT NULL").
+		UnionAll(tx.NewSelect().
+			Model(util.Nil[mangas.MangaSlug]()).
+			Column("slug")).
+		Scan(ctx, &used)
+	if err != nil {
+		return err
+	}
+
+	for _, manga := range result {
+		slug := mangas.NextAvailableSlug(mangas.NewSlug(manga.OriginalTitle), used)
+		used = append(used, slug)
+
+		_, err = tx.NewUpdate().
+			Model(util.Nil[mangas.Manga]()).
+			Set("slug = ?", slug).
+			Where("id = ?", manga.Id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -908,56 +908,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/chapters/{chapter_id}/pages": {
-            "post": {
-                "description": "insert new page for specific chapter",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/chapters/{chapter_id}/download": {
+            "get": {
+                "description": "Download specific chapter as CBZ archive with ComicInfo.xml metadata, the manga should allow download",
                 "produces": [
-                    "application/json"
+                    "application/vnd.comicbook+zip"
                 ],
                 "tags": [
                     "manga",
                     "chapter"
                 ],
-                "summary": "Insert Chapter Page",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "page image",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
+                "summary": "Download Chapter",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "success": {
+                                        "error": {
                                             "allOf": [
                                                 {
-                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
                                                 },
                                                 {
                                                     "type": "object",
                                                     "properties": {
-                                                        "data": {
+                                                        "details": {
                                                             "type": "object"
                                                         }
                                                     }
@@ -968,6 +955,35 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/chapters/{chapter_id}/epub": {
+            "get": {
+                "description": "Export specific chapter as fixed-layout EPUB, the manga should allow export",
+                "produces": [
+                    "application/epub+zip"
+                ],
+                "tags": [
+                    "manga",
+                    "chapter"
+                ],
+                "summary": "Export Chapter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "language of the title, defaulted to the chapter language",
+                        "name": "language",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1000,9 +1016,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete specifc chapter pages",
+            }
+        },
+        "/chapters/{chapter_id}/groups": {
+            "patch": {
+                "description": "add or remove groups credited on specific chapter",
                 "consumes": [
                     "application/json"
                 ],
@@ -1013,15 +1031,15 @@ const docTemplate = `{
                     "manga",
                     "chapter"
                 ],
-                "summary": "Delete Chapter Pages",
+                "summary": "Edit Chapter Groups",
                 "parameters": [
                     {
-                        "description": "page delete input",
+                        "description": "chapter groups edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PageDeleteInput"
+                            "$ref": "#/definitions/dto.ChapterGroupEditInput"
                         }
                     }
                 ],
@@ -1089,9 +1107,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/chapters/{manga_id}/histories": {
-            "get": {
-                "description": "get chapters of manga history on current logged-in user",
+        "/chapters/{chapter_id}/pages": {
+            "post": {
+                "description": "insert new page for specific chapter",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
@@ -1099,22 +1120,26 @@ const docTemplate = `{
                     "manga",
                     "chapter"
                 ],
-                "summary": "Get Manga History Chapters",
+                "summary": "Insert Chapter Page",
                 "parameters": [
                     {
                         "type": "integer",
-                        "name": "element",
-                        "in": "query"
+                        "description": "page number",
+                        "name": "page",
+                        "in": "formData",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "file",
+                        "description": "page image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1134,7 +1159,7 @@ const docTemplate = `{
                                                         "data": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/dto.ChapterResponse"
+                                                                "$ref": "#/definitions/dto.PageUploadResponse"
                                                             }
                                                         }
                                                     }
@@ -1177,19 +1202,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/genres": {
-            "get": {
-                "description": "Get all registered genres",
+            },
+            "delete": {
+                "description": "delete specifc chapter pages",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga",
-                    "genre"
+                    "chapter"
+                ],
+                "summary": "Delete Chapter Pages",
+                "parameters": [
+                    {
+                        "description": "page delete input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PageDeleteInput"
+                        }
+                    }
                 ],
-                "summary": "Get All Genres",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1210,10 +1247,37 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/dto.GenreResponse"
-                                                            }
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -1225,34 +1289,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/chapters/{chapter_id}/pages/archive": {
             "post": {
-                "description": "Create new genre",
+                "description": "insert all pages of specific chapter from CBZ/ZIP archive, the pages are numbered by natural order of the image names",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga",
-                    "genre"
+                    "chapter"
                 ],
-                "summary": "Create Genre",
+                "summary": "Insert Chapter Page Archive",
                 "parameters": [
                     {
-                        "description": "genre create input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.GenreCreateInput"
-                        }
+                        "type": "file",
+                        "description": "CBZ/ZIP archive",
+                        "name": "archive",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1270,7 +1334,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.PageUploadResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -1314,9 +1381,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres/{genre_id}": {
-            "put": {
-                "description": "Edit specific genre by id",
+        "/chapters/{chapter_id}/pages/order": {
+            "patch": {
+                "description": "renumber all pages of specific chapter, the page ids should contain all pages of the chapter in the new order",
                 "consumes": [
                     "application/json"
                 ],
@@ -1325,17 +1392,17 @@ const docTemplate = `{
                 ],
                 "tags": [
                     "manga",
-                    "genre"
+                    "chapter"
                 ],
-                "summary": "Edit Genre",
+                "summary": "Reorder Chapter Pages",
                 "parameters": [
                     {
-                        "description": "genre edit input",
+                        "description": "page order",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.GenreEditInput"
+                            "$ref": "#/definitions/dto.PageReorderInput"
                         }
                     }
                 ],
@@ -1401,17 +1468,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete specific genre by id",
+            }
+        },
+        "/chapters/{chapter_id}/pages/{number}": {
+            "put": {
+                "description": "replace the image of specific chapter page without changing the other pages",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga",
-                    "genre"
+                    "chapter"
+                ],
+                "summary": "Replace Chapter Page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "page image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Delete Genre",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1432,7 +1520,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "$ref": "#/definitions/dto.PageUploadResponse"
                                                         }
                                                     }
                                                 }
@@ -1462,10 +1550,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/common.FieldError"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -1479,21 +1564,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas": {
+        "/chapters/{manga_id}/histories": {
             "get": {
-                "description": "Get random manga with limit query",
+                "description": "get chapters of manga history on current logged-in user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "chapter"
                 ],
-                "summary": "Random Manga",
+                "summary": "Get Manga History Chapters",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "total response manga",
-                        "name": "limit",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
                         "in": "query"
                     }
                 ],
@@ -1519,7 +1609,7 @@ const docTemplate = `{
                                                         "data": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/dto.MinimalMangaResponse"
+                                                                "$ref": "#/definitions/dto.ChapterResponse"
                                                             }
                                                         }
                                                     }
@@ -1562,33 +1652,82 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create new manga",
-                "consumes": [
+            }
+        },
+        "/genres": {
+            "get": {
+                "description": "Get all registered genres",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "genre"
+                ],
+                "summary": "Get All Genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.GenreResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new genre",
+                "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "genre"
                 ],
-                "summary": "Create Manga",
+                "summary": "Create Genre",
                 "parameters": [
                     {
-                        "description": "manga create input",
+                        "description": "genre create input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.MangaCreateInput"
+                            "$ref": "#/definitions/dto.GenreCreateInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -1650,26 +1789,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/favorites": {
-            "get": {
-                "description": "get current logged-in user manga's favorite",
+        "/genres/{genre_id}": {
+            "put": {
+                "description": "Edit specific genre by id",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "genre"
                 ],
-                "summary": "Get Manga Favorite",
+                "summary": "Edit Genre",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "element",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "genre edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenreEditInput"
+                        }
                     }
                 ],
                 "responses": {
@@ -1692,10 +1834,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/dto.MangaFavoriteResponse"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -1737,30 +1876,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/histories": {
-            "get": {
-                "description": "get current logged-in manga histories",
+            },
+            "delete": {
+                "description": "Delete specific genre by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
-                ],
-                "summary": "Get Manga Histories",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "element",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    }
+                    "manga",
+                    "genre"
                 ],
+                "summary": "Delete Genre",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1781,10 +1907,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/dto.MangaHistoryResponse"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -1814,7 +1937,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -1828,19 +1954,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/search": {
+        "/groups": {
             "get": {
-                "description": "search manga by the body",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get all scanlation groups, filtered by name when it is provided",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "group"
                 ],
-                "summary": "Search Manga",
+                "summary": "Get All Groups",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1853,13 +1977,10 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "description": "search query",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.MangaSearchQuery"
-                        }
+                        "type": "string",
+                        "description": "group name",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1884,7 +2005,7 @@ const docTemplate = `{
                                                         "data": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/dto.MinimalMangaResponse"
+                                                                "$ref": "#/definitions/dto.GroupResponse"
                                                             }
                                                         }
                                                     }
@@ -1915,7 +2036,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -1927,11 +2051,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/translates": {
-            "delete": {
-                "description": "delete specific translations based on the id",
+            },
+            "post": {
+                "description": "Create new group which could be credited on chapters",
                 "consumes": [
                     "application/json"
                 ],
@@ -1939,23 +2061,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "group"
                 ],
-                "summary": "Delete Translations",
+                "summary": "Create Group",
                 "parameters": [
                     {
-                        "description": "translation delete input",
+                        "description": "group create input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TranslationDeleteInput"
+                            "$ref": "#/definitions/dto.GroupCreateInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -2017,30 +2140,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/translates/{translate_id}": {
-            "put": {
-                "description": "edit specific manga translation",
-                "consumes": [
-                    "application/json"
-                ],
+        "/groups/{group_id}": {
+            "get": {
+                "description": "Get specific group with the members",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
-                ],
-                "summary": "Edit Translation",
-                "parameters": [
-                    {
-                        "description": "translation edit input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.TranslationEditInput"
-                        }
-                    }
+                    "manga",
+                    "group"
                 ],
+                "summary": "Find Group By Id",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2061,7 +2171,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "$ref": "#/definitions/dto.GroupResponse"
                                                         }
                                                     }
                                                 }
@@ -2103,11 +2213,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/{manga_id/chapters": {
-            "post": {
-                "description": "create chapter for specific manga",
+            },
+            "put": {
+                "description": "Edit specific group by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -2116,23 +2224,23 @@ const docTemplate = `{
                 ],
                 "tags": [
                     "manga",
-                    "chapter"
+                    "group"
                 ],
-                "summary": "Create Chapter",
+                "summary": "Edit Group",
                 "parameters": [
                     {
-                        "description": "chapter create input",
+                        "description": "group edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ChapterCreateInput"
+                            "$ref": "#/definitions/dto.GroupEditInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -2192,17 +2300,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/{manga_id}": {
-            "get": {
+            },
+            "delete": {
+                "description": "Delete specific group by id, the group will be removed from all credited chapters",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "group"
                 ],
-                "summary": "Find Manga By Id",
+                "summary": "Delete Group",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2223,7 +2331,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "$ref": "#/definitions/dto.MangaResponse"
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -2265,28 +2373,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Edit specific manga by id",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/groups/{group_id}/chapters": {
+            "get": {
+                "description": "Get all chapters released by specific group ordered by the newest",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "group"
                 ],
-                "summary": "Edit Manga",
+                "summary": "Find Group Releases",
                 "parameters": [
                     {
-                        "description": "manga edit input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.MangaEditInput"
-                        }
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2309,7 +2418,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.ChapterResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -2339,10 +2451,13 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
+                                                        }
+                                                    }
+                                                }
                                             ]
                                         }
                                     }
@@ -2353,16 +2468,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/comments": {
-            "get": {
-                "description": "Get all comments from specific manga",
+        "/groups/{group_id}/members": {
+            "patch": {
+                "description": "Add, remove or change the role of group members",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "group"
+                ],
+                "summary": "Edit Group Members",
+                "parameters": [
+                    {
+                        "description": "group members edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GroupMemberEditInput"
+                        }
+                    }
                 ],
-                "summary": "Get Manga Comments",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2383,10 +2513,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/dto.CommentResponse"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -2428,33 +2555,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create comment for specific manga",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/mangas": {
+            "get": {
+                "description": "Get random manga with limit query, it only shows mangas allowed by user's content ratings preference (anonymous user only get safe mangas)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga"
                 ],
-                "summary": "Create Manga Comment",
+                "summary": "Random Manga",
                 "parameters": [
                     {
-                        "description": "manga comment create input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.MangaCommentCreateInput"
-                        }
+                        "type": "integer",
+                        "description": "total response manga",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -2472,7 +2595,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.MinimalMangaResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -2514,13 +2640,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/{manga_id}/covers": {
-            "patch": {
-                "description": "update manga cover image",
+            },
+            "post": {
+                "description": "create new manga",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -2528,19 +2652,21 @@ const docTemplate = `{
                 "tags": [
                     "manga"
                 ],
-                "summary": "Update Manga Cover",
+                "summary": "Create Manga",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "cover image",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
+                        "description": "manga create input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaCreateInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -2602,28 +2728,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/favorites": {
-            "post": {
-                "description": "add or remove manga as favorite based on op field",
-                "consumes": [
-                    "application/json"
-                ],
+        "/mangas/favorites": {
+            "get": {
+                "description": "get current logged-in user manga's favorite",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga"
                 ],
-                "summary": "Modify Favorite Manga",
+                "summary": "Get Manga Favorite",
                 "parameters": [
                     {
-                        "description": "modification input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.FavoriteMangaModificationInput"
-                        }
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2646,7 +2770,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.MangaFavoriteResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -2690,28 +2817,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/genres": {
-            "patch": {
-                "description": "Modify specific manga's genres",
-                "consumes": [
-                    "application/json"
-                ],
+        "/mangas/histories": {
+            "get": {
+                "description": "get current logged-in manga histories",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga"
                 ],
-                "summary": "Edit Manga Genres",
+                "summary": "Get Manga Histories",
                 "parameters": [
                     {
-                        "description": "manga's genre edit input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.MangaGenreEditInput"
-                        }
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2734,7 +2859,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.MangaHistoryResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -2778,16 +2906,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/ratings": {
+        "/mangas/search": {
             "get": {
-                "description": "Get all ratings from specific manga",
+                "description": "search manga by the body, it only shows mangas allowed by user's content ratings preference (anonymous user only get safe mangas)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga"
                 ],
-                "summary": "Get Manga Ratings",
+                "summary": "Search Manga",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "description": "search query",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaSearchQuery"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2810,7 +2962,7 @@ const docTemplate = `{
                                                         "data": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/dto.RateResponse"
+                                                                "$ref": "#/definitions/dto.MinimalMangaResponse"
                                                             }
                                                         }
                                                     }
@@ -2853,9 +3005,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create or update rate for specific manga on current logged-in user",
+            }
+        },
+        "/mangas/translates": {
+            "delete": {
+                "description": "delete specific translations based on the id",
                 "consumes": [
                     "application/json"
                 ],
@@ -2865,21 +3019,21 @@ const docTemplate = `{
                 "tags": [
                     "manga"
                 ],
-                "summary": "Create Manga Rating",
+                "summary": "Delete Translations",
                 "parameters": [
                     {
-                        "description": "rate create input",
+                        "description": "translation delete input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.RateUpsertInput"
+                            "$ref": "#/definitions/dto.TranslationDeleteInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -2941,9 +3095,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/translates": {
-            "post": {
-                "description": "create manga translation for specific manga",
+        "/mangas/translates/{translate_id}": {
+            "put": {
+                "description": "edit specific manga translation",
                 "consumes": [
                     "application/json"
                 ],
@@ -2953,21 +3107,21 @@ const docTemplate = `{
                 "tags": [
                     "manga"
                 ],
-                "summary": "Insert Manga Translation",
+                "summary": "Edit Translation",
                 "parameters": [
                     {
-                        "description": "manga translation insert input",
+                        "description": "translation edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.MangaTranslationInsertInput"
+                            "$ref": "#/definitions/dto.TranslationEditInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -3027,9 +3181,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete all translations of specific manga based on languages provided",
+            }
+        },
+        "/mangas/{manga_id/chapters": {
+            "post": {
+                "description": "create chapter for specific manga",
                 "consumes": [
                     "application/json"
                 ],
@@ -3037,23 +3193,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "chapter"
                 ],
-                "summary": "Delete Manga Translation",
+                "summary": "Create Chapter",
                 "parameters": [
                     {
-                        "description": "manga translations delete input",
+                        "description": "chapter create input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.MangaTranslationsDeleteInput"
+                            "$ref": "#/definitions/dto.ChapterCreateInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -3115,22 +3272,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/mangas/{manga_id}/translates/{language}": {
+        "/mangas/{manga_id}": {
             "get": {
-                "description": "get all specifc manga translations",
+                "description": "Find manga by the id or slug, previous slugs of the manga are still resolved",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "manga"
                 ],
-                "summary": "Find Manga Translations",
+                "summary": "Find Manga By Id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "expected translation language",
-                        "name": "language",
-                        "in": "path"
+                        "description": "manga id or slug",
+                        "name": "manga_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3153,7 +3311,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "$ref": "#/definitions/dto.TranslationResponse"
+                                                            "$ref": "#/definitions/dto.MangaResponse"
                                                         }
                                                     }
                                                 }
@@ -3195,11 +3353,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mangas/{manga_id}/volumes": {
-            "post": {
-                "description": "create new volume on specific manga",
+            },
+            "put": {
+                "description": "Edit specific manga by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3209,21 +3365,21 @@ const docTemplate = `{
                 "tags": [
                     "manga"
                 ],
-                "summary": "Create Volume",
+                "summary": "Edit Manga",
                 "parameters": [
                     {
-                        "description": "volume create input",
+                        "description": "manga edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.VolumeCreateInput"
+                            "$ref": "#/definitions/dto.MangaEditInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -3283,28 +3439,67 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete specific volumes on manga",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/mangas/{manga_id}/chapters": {
+            "get": {
+                "description": "Get paginated chapters of specific manga, the chapters have read flag when the user is logged-in",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga"
+                    "manga",
+                    "chapter"
                 ],
-                "summary": "Delete Volume",
+                "summary": "Find Manga Chapters",
                 "parameters": [
                     {
-                        "description": "volume delete input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.VolumeDeleteInput"
-                        }
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "chapter language",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "volume id",
+                        "name": "volume_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "translator id",
+                        "name": "translator_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "publish_date"
+                        ],
+                        "type": "string",
+                        "description": "chapter order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "order direction",
+                        "name": "direction",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3327,7 +3522,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.ChapterResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -3371,16 +3569,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "get": {
-                "description": "Get all registered users",
+        "/mangas/{manga_id}/chapters/volume": {
+            "patch": {
+                "description": "move chapters of the manga into another volume, leave volume_id empty to detach the chapters from the volume",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga",
+                    "chapter"
+                ],
+                "summary": "Move Chapters",
+                "parameters": [
+                    {
+                        "description": "chapter move input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChapterMoveInput"
+                        }
+                    }
                 ],
-                "summary": "Get Users",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3401,10 +3614,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/dto.UserResponse"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -3446,30 +3656,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Edit logged-in user's details",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/mangas/{manga_id}/comments": {
+            "get": {
+                "description": "Get all comments from specific manga",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Edit User",
-                "parameters": [
-                    {
-                        "description": "user edit input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UserEditInput"
-                        }
-                    }
+                    "manga"
                 ],
+                "summary": "Get Manga Comments",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3490,7 +3688,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.CommentResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -3534,22 +3735,25 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create user arbitrarily",
+                "description": "create comment for specific manga",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Add User",
+                "summary": "Create Manga Comment",
                 "parameters": [
                     {
-                        "description": "add user input",
+                        "description": "manga comment create input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.AddUserInput"
+                            "$ref": "#/definitions/dto.MangaCommentCreateInput"
                         }
                     }
                 ],
@@ -3603,10 +3807,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/common.FieldError"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -3620,28 +3821,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/change-password": {
-            "post": {
-                "description": "Change logged-in user's password",
+        "/mangas/{manga_id}/covers": {
+            "patch": {
+                "description": "update manga cover image",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Change Password",
+                "summary": "Update Manga Cover",
                 "parameters": [
                     {
-                        "description": "change password input",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ChangePasswordInput"
-                        }
+                        "type": "file",
+                        "description": "cover image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3664,7 +3863,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "$ref": "#/definitions/dto.UploadResponse"
                                                         }
                                                     }
                                                 }
@@ -3704,9 +3903,67 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/mangas/{manga_id}/download": {
+            "patch": {
+                "description": "Allow or disallow chapters and volumes of specific manga to be downloaded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Edit Manga Download",
+                "parameters": [
+                    {
+                        "description": "manga's download edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaDownloadEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -3738,16 +3995,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/email-verif": {
-            "post": {
-                "description": "Request for email verification",
+        "/mangas/{manga_id}/export": {
+            "patch": {
+                "description": "Allow or disallow chapters and volumes of specific manga to be exported as EPUB",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
+                ],
+                "summary": "Edit Manga Export",
+                "parameters": [
+                    {
+                        "description": "manga's export edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaExportEditInput"
+                        }
+                    }
                 ],
-                "summary": "Request Verify Email",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3808,57 +4079,32 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.ErrorWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "error": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/dto.ErrorResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     }
                 }
             }
         },
-        "/users/email-verif/{token}": {
-            "get": {
-                "description": "Verify email based on the token",
+        "/mangas/{manga_id}/favorites": {
+            "post": {
+                "description": "add or remove manga as favorite based on op field",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Verify Email",
+                "summary": "Modify Favorite Manga",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
+                        "description": "modification input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.FavoriteMangaModificationInput"
+                        }
                     }
                 ],
                 "responses": {
@@ -3921,55 +4167,32 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.ErrorWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "error": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/dto.ErrorResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     }
                 }
-            },
-            "post": {
-                "description": "Verify email based on the token",
+            }
+        },
+        "/mangas/{manga_id}/genres": {
+            "patch": {
+                "description": "Modify specific manga's genres",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Verify Email",
+                "summary": "Edit Manga Genres",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
+                        "description": "manga's genre edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaGenreEditInput"
+                        }
                     }
                 ],
                 "responses": {
@@ -4032,9 +4255,56 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/mangas/{manga_id}/ratings": {
+            "get": {
+                "description": "Get all ratings from specific manga",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Get Manga Ratings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.RateResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -4064,11 +4334,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/profiles": {
-            "put": {
-                "description": "Edit user logged-in user's profile",
+            },
+            "post": {
+                "description": "create or update rate for specific manga on current logged-in user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4076,23 +4344,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Edit User Profile",
+                "summary": "Create Manga Rating",
                 "parameters": [
                     {
-                        "description": "profile edit input",
+                        "description": "rate create input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProfileEditInput"
+                            "$ref": "#/definitions/dto.RateUpsertInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -4154,28 +4422,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/profiles/image": {
-            "put": {
-                "description": "Edit logged-in user profile image",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/mangas/{manga_id}/relations": {
+            "get": {
+                "description": "get all mangas related to specific manga",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Edit Profile Image",
-                "parameters": [
-                    {
-                        "type": "file",
-                        "description": "profile's image",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "manga"
                 ],
+                "summary": "Find Manga Relations",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4196,7 +4452,10 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "type": "object"
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.MangaRelationResponse"
+                                                            }
                                                         }
                                                     }
                                                 }
@@ -4239,18 +4498,32 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete logged-in user profile image",
+            "post": {
+                "description": "link specific manga with another manga, the inverse relation is created too when the kind has it (sequel and prequel)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
+                ],
+                "summary": "Create Manga Relation",
+                "parameters": [
+                    {
+                        "description": "manga relation create input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaRelationCreateInput"
+                        }
+                    }
                 ],
-                "summary": "Delete Profile Image",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -4310,11 +4583,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/register": {
-            "post": {
-                "description": "Create new user",
+            },
+            "delete": {
+                "description": "unlink specific manga with another manga on both directions",
                 "consumes": [
                     "application/json"
                 ],
@@ -4322,23 +4593,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Register",
+                "summary": "Delete Manga Relation",
                 "parameters": [
                     {
-                        "description": "user registration input",
+                        "description": "manga relation delete input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UserRegisterInput"
+                            "$ref": "#/definitions/dto.MangaRelationDeleteInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -4356,7 +4627,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "$ref": "#/definitions/dto.UserResponse"
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -4386,10 +4657,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/common.FieldError"
-                                                            }
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -4403,9 +4671,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/reset-password": {
-            "post": {
-                "description": "Request to reset password by user's email",
+        "/mangas/{manga_id}/slug": {
+            "patch": {
+                "description": "Change slug of specific manga, the previous slug will still redirect to the manga",
                 "consumes": [
                     "application/json"
                 ],
@@ -4413,17 +4681,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Request Reset Password",
+                "summary": "Edit Manga Slug",
                 "parameters": [
                     {
-                        "description": "change password input",
+                        "description": "manga's slug edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ChangePasswordInput"
+                            "$ref": "#/definitions/dto.MangaSlugEditInput"
                         }
                     }
                 ],
@@ -4487,43 +4755,13 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.ErrorWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "error": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/dto.ErrorResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     }
                 }
             }
         },
-        "/users/reset-password/{token}": {
-            "get": {
-                "description": "Reset password after request",
+        "/mangas/{manga_id}/staff": {
+            "patch": {
+                "description": "Add or remove people credited on specific manga",
                 "consumes": [
                     "application/json"
                 ],
@@ -4531,25 +4769,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Reset Password",
+                "summary": "Edit Manga Staff",
                 "parameters": [
                     {
-                        "description": "reset password input",
+                        "description": "manga's staff edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ResetPasswordInput"
+                            "$ref": "#/definitions/dto.MangaStaffEditInput"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4612,41 +4843,13 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.ErrorWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "error": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/dto.ErrorResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     }
                 }
-            },
-            "post": {
-                "description": "Reset password after request",
+            }
+        },
+        "/mangas/{manga_id}/tags": {
+            "patch": {
+                "description": "Add or remove tags of specific manga",
                 "consumes": [
                     "application/json"
                 ],
@@ -4654,25 +4857,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Reset Password",
+                "summary": "Edit Manga Tags",
                 "parameters": [
                     {
-                        "description": "reset password input",
+                        "description": "manga's tags edit input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ResetPasswordInput"
+                            "$ref": "#/definitions/dto.MangaTagEditInput"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4735,9 +4931,56 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/mangas/{manga_id}/titles": {
+            "get": {
+                "description": "get all alternative titles of specific manga",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Find Manga Alternative Titles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.AlternativeTitleResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -4767,11 +5010,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{user_id}": {
-            "put": {
-                "description": "Edit user in extended fields (password) without checking",
+            },
+            "post": {
+                "description": "add alternative titles (romaji, abbreviation, synonym) for specific manga",
                 "consumes": [
                     "application/json"
                 ],
@@ -4779,23 +5020,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Edit User Extended",
+                "summary": "Insert Manga Alternative Titles",
                 "parameters": [
                     {
-                        "description": "user edit extended input",
+                        "description": "manga alternative title insert input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UserEditExtendedInput"
+                            "$ref": "#/definitions/dto.MangaAlternativeTitleInsertInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -4853,52 +5094,30 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.ErrorWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "error": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/dto.ErrorResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "details": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     }
                 }
             },
             "delete": {
-                "description": "Delete user",
+                "description": "delete alternative titles of specific manga based on the id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Delete User",
+                "summary": "Delete Manga Alternative Titles",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "user id",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "manga alternative title delete input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaAlternativeTitleDeleteInput"
+                        }
                     }
                 ],
                 "responses": {
@@ -4951,7 +5170,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "$ref": "#/definitions/common.ParameterError"
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -4965,28 +5184,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{user_id}/profiles": {
-            "get": {
-                "description": "Get user's details",
+        "/mangas/{manga_id}/translates": {
+            "post": {
+                "description": "create manga translation for specific manga",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Get User Profile",
+                "summary": "Insert Manga Translation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "user id",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "manga translation insert input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MangaTranslationInsertInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -5004,7 +5228,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "data": {
-                                                            "$ref": "#/definitions/dto.ProfileResponse"
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -5034,7 +5258,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "details": {
-                                                            "$ref": "#/definitions/common.ParameterError"
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -5047,8 +5271,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Edit user's profile on extended fields",
+            "delete": {
+                "description": "delete all translations of specific manga based on languages provided",
                 "consumes": [
                     "application/json"
                 ],
@@ -5056,17 +5280,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "manga"
                 ],
-                "summary": "Edit User Profile Extended",
+                "summary": "Delete Manga Translation",
                 "parameters": [
                     {
-                        "description": "user profile edit input",
+                        "description": "manga translations delete input",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProfileEditExtendedInput"
+                            "$ref": "#/definitions/dto.MangaTranslationsDeleteInput"
                         }
                     }
                 ],
@@ -5134,38 +5358,3031 @@ const docTemplate = `{
                 }
             }
         },
-        "/volumes/{volume_id}": {
+        "/mangas/{manga_id}/translates/{language}": {
             "get": {
-                "description": "Get specific volume details with the chapters associated with it",
+                "description": "get all specifc manga translations",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manga",
-                    "chapter"
+                    "manga"
                 ],
-                "summary": "Find Volume Details",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
+                "summary": "Find Manga Translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "expected translation language",
+                        "name": "language",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.TranslationResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/mangas/{manga_id}/volumes": {
+            "post": {
+                "description": "create new volume on specific manga",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Create Volume",
+                "parameters": [
+                    {
+                        "description": "volume create input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.VolumeCreateInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "delete specific volumes on manga",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Delete Volume",
+                "parameters": [
+                    {
+                        "description": "volume delete input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.VolumeDeleteInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/people": {
+            "get": {
+                "description": "Get all registered people, filtered by name when it is provided",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "person"
+                ],
+                "summary": "Get All People",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "element",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "person name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.PersonResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new person which could be credited on mangas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "person"
+                ],
+                "summary": "Create Person",
+                "parameters": [
+                    {
+                        "description": "person create input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PersonCreateInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/people/{person_id}": {
+            "get": {
+                "description": "Get specific person with the mangas credited to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "person"
+                ],
+                "summary": "Find Person By Id",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.PersonResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Edit specific person by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "person"
+                ],
+                "summary": "Edit Person",
+                "parameters": [
+                    {
+                        "description": "person edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PersonEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete specific person by id, the person will be removed from all credited mangas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "person"
+                ],
+                "summary": "Delete Person",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Get all registered tags",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "tag"
+                ],
+                "summary": "Get All Tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.TagResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "tag"
+                ],
+                "summary": "Create Tag",
+                "parameters": [
+                    {
+                        "description": "tag create input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagCreateInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{tag_id}": {
+            "put": {
+                "description": "Edit specific tag by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "tag"
+                ],
+                "summary": "Edit Tag",
+                "parameters": [
+                    {
+                        "description": "tag edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete specific tag by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "tag"
+                ],
+                "summary": "Delete Tag",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Get all registered users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get Users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/dto.UserResponse"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Edit logged-in user's details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit User",
+                "parameters": [
+                    {
+                        "description": "user edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create user arbitrarily",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Add User",
+                "parameters": [
+                    {
+                        "description": "add user input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddUserInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/change-password": {
+            "post": {
+                "description": "Change logged-in user's password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change Password",
+                "parameters": [
+                    {
+                        "description": "change password input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePasswordInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/email-verif": {
+            "post": {
+                "description": "Request for email verification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Request Verify Email",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/email-verif/{token}": {
+            "get": {
+                "description": "Verify email based on the token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify Email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Verify email based on the token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify Email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profiles": {
+            "put": {
+                "description": "Edit user logged-in user's profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit User Profile",
+                "parameters": [
+                    {
+                        "description": "profile edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProfileEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profiles/image": {
+            "put": {
+                "description": "Edit logged-in user profile image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit Profile Image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "profile's image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.UploadResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete logged-in user profile image",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete Profile Image",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "description": "Create new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register",
+                "parameters": [
+                    {
+                        "description": "user registration input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRegisterInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.UserResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/common.FieldError"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/reset-password": {
+            "post": {
+                "description": "Request to reset password by user's email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Request Reset Password",
+                "parameters": [
+                    {
+                        "description": "change password input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePasswordInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/reset-password/{token}": {
+            "get": {
+                "description": "Reset password after request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Reset Password",
+                "parameters": [
+                    {
+                        "description": "reset password input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResetPasswordInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Reset password after request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Reset Password",
+                "parameters": [
+                    {
+                        "description": "reset password input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResetPasswordInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}": {
+            "put": {
+                "description": "Edit user in extended fields (password) without checking",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit User Extended",
+                "parameters": [
+                    {
+                        "description": "user edit extended input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserEditExtendedInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete user",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user id",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "$ref": "#/definitions/common.ParameterError"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/profiles": {
+            "get": {
+                "description": "Get user's details",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get User Profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user id",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.ProfileResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "$ref": "#/definitions/common.ParameterError"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Edit user's profile on extended fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit User Profile Extended",
+                "parameters": [
+                    {
+                        "description": "user profile edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProfileEditExtendedInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/volumes/{volume_id}": {
+            "get": {
+                "description": "Get specific volume details with the chapters associated with it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga",
+                    "chapter"
+                ],
+                "summary": "Find Volume Details",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.VolumeResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "edit volume title, description and number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Edit Volume",
+                "parameters": [
+                    {
+                        "description": "volume edit input",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.VolumeEditInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/volumes/{volume_id}/covers": {
+            "patch": {
+                "description": "upload or replace volume cover image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manga"
+                ],
+                "summary": "Update Volume Cover",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "cover image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "success": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "$ref": "#/definitions/dto.UploadResponse"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "details": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/volumes/{volume_id}/download": {
+            "get": {
+                "description": "Download specific volume as ZIP archive containing CBZ archive of each chapter, the manga should allow download",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "manga",
+                    "chapter"
+                ],
+                "summary": "Download Volume",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/dto.SuccessWrapper"
+                                    "$ref": "#/definitions/dto.ErrorWrapper"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "success": {
+                                        "error": {
                                             "allOf": [
                                                 {
-                                                    "$ref": "#/definitions/dto.SuccessResponse"
+                                                    "$ref": "#/definitions/dto.ErrorResponse"
                                                 },
                                                 {
                                                     "type": "object",
                                                     "properties": {
-                                                        "data": {
-                                                            "$ref": "#/definitions/dto.VolumeResponse"
+                                                        "details": {
+                                                            "type": "object"
                                                         }
                                                     }
                                                 }
@@ -5175,6 +8392,35 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/volumes/{volume_id}/epub": {
+            "get": {
+                "description": "Export specific volume as fixed-layout EPUB where each chapter is a section, the manga should allow export",
+                "produces": [
+                    "application/epub+zip"
+                ],
+                "tags": [
+                    "manga",
+                    "chapter"
+                ],
+                "summary": "Export Volume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only export chapters in the language",
+                        "name": "language",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5310,6 +8556,23 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.AlternativeTitleResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lang": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.ChangePasswordInput": {
             "type": "object",
             "required": [
@@ -5329,15 +8592,26 @@ const docTemplate = `{
         "dto.ChapterCreateInput": {
             "type": "object",
             "required": [
-                "chapter",
                 "language",
-                "title",
-                "volume_id"
+                "title"
             ],
             "properties": {
                 "chapter": {
-                    "type": "integer",
-                    "minimum": 1
+                    "type": "number",
+                    "minimum": 0
+                },
+                "groups": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
                 },
                 "language": {
                     "type": "string"
@@ -5356,14 +8630,20 @@ const docTemplate = `{
         "dto.ChapterEditInput": {
             "type": "object",
             "required": [
-                "chapter",
+                "kind",
                 "language",
-                "title",
-                "volume_id"
+                "title"
             ],
             "properties": {
                 "chapter": {
-                    "type": "integer"
+                    "type": "number",
+                    "minimum": 0
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
                 },
                 "language": {
                     "type": "string"
@@ -5379,11 +8659,70 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ChapterGroupEditInput": {
+            "type": "object",
+            "properties": {
+                "adds": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removes": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ChapterMoveInput": {
+            "type": "object",
+            "required": [
+                "chapter_ids"
+            ],
+            "properties": {
+                "chapter_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "volume_id": {
+                    "description": "Leave empty to detach the chapters from the volume",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChapterReferenceResponse": {
+            "type": "object",
+            "properties": {
+                "chapter": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.ChapterResponse": {
             "type": "object",
             "properties": {
                 "chapter": {
-                    "type": "integer"
+                    "type": "number"
                 },
                 "comments": {
                     "type": "array",
@@ -5394,18 +8733,45 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GroupResponse"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
+                "kind": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
                 "language": {
                     "type": "string"
                 },
+                "manga_id": {
+                    "type": "string"
+                },
+                "manga_title": {
+                    "type": "string"
+                },
+                "next": {
+                    "$ref": "#/definitions/dto.ChapterReferenceResponse"
+                },
                 "pages": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/dto.PageResponse"
                     }
                 },
+                "prev": {
+                    "$ref": "#/definitions/dto.ChapterReferenceResponse"
+                },
+                "read": {
+                    "type": "boolean"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -5466,64 +8832,186 @@ const docTemplate = `{
                 "code": {
                     "type": "integer"
                 },
-                "details": {},
-                "message": {
+                "details": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorWrapper": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/dto.ErrorResponse"
+                }
+            }
+        },
+        "dto.FavoriteMangaModificationInput": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "add",
+                        "remove"
+                    ]
+                }
+            }
+        },
+        "dto.GenreCreateInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GenreEditInput": {
+            "type": "object",
+            "required": [
+                "new_name"
+            ],
+            "properties": {
+                "new_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GenreResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GroupCreateInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GroupEditInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "website": {
                     "type": "string"
                 }
             }
         },
-        "dto.ErrorWrapper": {
+        "dto.GroupMemberEditInput": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/dto.ErrorResponse"
+                "adds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InternalGroupMember"
+                    }
+                },
+                "removes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "dto.FavoriteMangaModificationInput": {
+        "dto.GroupMemberResponse": {
             "type": "object",
-            "required": [
-                "op"
-            ],
             "properties": {
-                "op": {
-                    "type": "string",
-                    "enum": [
-                        "add",
-                        "remove"
-                    ]
+                "role": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "dto.GenreCreateInput": {
+        "dto.GroupResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GroupMemberResponse"
+                    }
+                },
                 "name": {
                     "type": "string"
+                },
+                "website": {
+                    "type": "string"
                 }
             }
         },
-        "dto.GenreEditInput": {
+        "dto.InternalAlternativeTitle": {
             "type": "object",
             "required": [
-                "new_name"
+                "kind",
+                "lang",
+                "title"
             ],
             "properties": {
-                "new_name": {
+                "kind": {
+                    "type": "string"
+                },
+                "lang": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "dto.GenreResponse": {
+        "dto.InternalGroupMember": {
             "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
             "properties": {
-                "id": {
+                "role": {
                     "type": "string"
                 },
-                "name": {
+                "user_id": {
                     "type": "string"
                 }
             }
@@ -5531,6 +9019,12 @@ const docTemplate = `{
         "dto.InternalProfileResponse": {
             "type": "object",
             "properties": {
+                "allowed_ratings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "bio": {
                     "type": "string"
                 },
@@ -5545,6 +9039,21 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.InternalStaff": {
+            "type": "object",
+            "required": [
+                "person_id",
+                "role"
+            ],
+            "properties": {
+                "person_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.InternalTranslation": {
             "type": "object",
             "required": [
@@ -5590,6 +9099,32 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.MangaAlternativeTitleDeleteInput": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.MangaAlternativeTitleInsertInput": {
+            "type": "object",
+            "properties": {
+                "titles": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.InternalAlternativeTitle"
+                    }
+                }
+            }
+        },
         "dto.MangaCommentCreateInput": {
             "type": "object",
             "required": [
@@ -5616,6 +9151,12 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "content_rating": {
+                    "type": "string"
+                },
+                "demographic": {
+                    "type": "string"
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -5639,6 +9180,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.MangaDownloadEditInput": {
+            "type": "object",
+            "required": [
+                "allowed"
+            ],
+            "properties": {
+                "allowed": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dto.MangaEditInput": {
             "type": "object",
             "required": [
@@ -5648,6 +9200,12 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "content_rating": {
+                    "type": "string"
+                },
+                "demographic": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -5666,12 +9224,48 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.MangaExportEditInput": {
+            "type": "object",
+            "required": [
+                "allowed"
+            ],
+            "properties": {
+                "allowed": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dto.MangaFavoriteResponse": {
             "type": "object",
             "properties": {
+                "allow_download": {
+                    "type": "boolean"
+                },
+                "allow_export": {
+                    "type": "boolean"
+                },
+                "alt_titles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AlternativeTitleResponse"
+                    }
+                },
+                "chapters": {
+                    "description": "Chapters which are not belonged to any volume",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ChapterResponse"
+                    }
+                },
+                "content_rating": {
+                    "type": "string"
+                },
                 "cover_url": {
                     "type": "string"
                 },
+                "demographic": {
+                    "type": "string"
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -5693,9 +9287,30 @@ const docTemplate = `{
                 "rate": {
                     "type": "number"
                 },
+                "relations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MangaRelationResponse"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StaffResponse"
+                    }
+                },
                 "status": {
                     "type": "string"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
+                },
                 "title": {
                     "type": "string"
                 },
@@ -5743,9 +9358,34 @@ const docTemplate = `{
         "dto.MangaHistoryResponse": {
             "type": "object",
             "properties": {
+                "allow_download": {
+                    "type": "boolean"
+                },
+                "allow_export": {
+                    "type": "boolean"
+                },
+                "alt_titles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AlternativeTitleResponse"
+                    }
+                },
+                "chapters": {
+                    "description": "Chapters which are not belonged to any volume",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ChapterResponse"
+                    }
+                },
+                "content_rating": {
+                    "type": "string"
+                },
                 "cover_url": {
                     "type": "string"
                 },
+                "demographic": {
+                    "type": "string"
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -5767,9 +9407,30 @@ const docTemplate = `{
                 "rate": {
                     "type": "number"
                 },
+                "relations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MangaRelationResponse"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StaffResponse"
+                    }
+                },
                 "status": {
                     "type": "string"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
+                },
                 "title": {
                     "type": "string"
                 },
@@ -5797,12 +9458,80 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.MangaRelationCreateInput": {
+            "type": "object",
+            "required": [
+                "kind",
+                "related_id"
+            ],
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "related_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MangaRelationDeleteInput": {
+            "type": "object",
+            "required": [
+                "related_id"
+            ],
+            "properties": {
+                "related_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MangaRelationResponse": {
+            "type": "object",
+            "properties": {
+                "cover_url": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "manga_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.MangaResponse": {
             "type": "object",
             "properties": {
+                "allow_download": {
+                    "type": "boolean"
+                },
+                "allow_export": {
+                    "type": "boolean"
+                },
+                "alt_titles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AlternativeTitleResponse"
+                    }
+                },
+                "chapters": {
+                    "description": "Chapters which are not belonged to any volume",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ChapterResponse"
+                    }
+                },
+                "content_rating": {
+                    "type": "string"
+                },
                 "cover_url": {
                     "type": "string"
                 },
+                "demographic": {
+                    "type": "string"
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -5812,18 +9541,39 @@ const docTemplate = `{
                         "$ref": "#/definitions/dto.GenreResponse"
                     }
                 },
-                "id": {
-                    "type": "string"
-                },
-                "origin": {
+                "id": {
+                    "type": "string"
+                },
+                "origin": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "relations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MangaRelationResponse"
+                    }
+                },
+                "slug": {
                     "type": "string"
                 },
-                "rate": {
-                    "type": "number"
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StaffResponse"
+                    }
                 },
                 "status": {
                     "type": "string"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
+                },
                 "title": {
                     "type": "string"
                 },
@@ -5866,11 +9616,63 @@ const docTemplate = `{
                 "page": {
                     "type": "integer"
                 },
+                "staff": {
+                    "type": "string"
+                },
+                "tag": {
+                    "$ref": "#/definitions/common.CriterionOption-string"
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
+        "dto.MangaSlugEditInput": {
+            "type": "object",
+            "required": [
+                "slug"
+            ],
+            "properties": {
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.MangaStaffEditInput": {
+            "type": "object",
+            "properties": {
+                "adds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InternalStaff"
+                    }
+                },
+                "removes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InternalStaff"
+                    }
+                }
+            }
+        },
+        "dto.MangaTagEditInput": {
+            "type": "object",
+            "properties": {
+                "adds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "dto.MangaTranslationInsertInput": {
             "type": "object",
             "properties": {
@@ -5900,9 +9702,18 @@ const docTemplate = `{
         "dto.MinimalMangaResponse": {
             "type": "object",
             "properties": {
+                "content_rating": {
+                    "type": "string"
+                },
+                "cover_thumbnail_url": {
+                    "type": "string"
+                },
                 "cover_url": {
                     "type": "string"
                 },
+                "demographic": {
+                    "type": "string"
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -5921,9 +9732,18 @@ const docTemplate = `{
                 "rate": {
                     "type": "number"
                 },
+                "slug": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
+                },
                 "title": {
                     "type": "string"
                 },
@@ -5952,21 +9772,153 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.PageReorderInput": {
+            "type": "object",
+            "required": [
+                "page_ids"
+            ],
+            "properties": {
+                "page_ids": {
+                    "description": "All page ids of the chapter in the new order",
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "dto.PageResponse": {
             "type": "object",
             "properties": {
+                "blurhash": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "image_url": {
                     "description": "Can be returning image bytes",
                     "type": "string"
                 },
+                "mime_type": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "In bytes",
+                    "type": "integer"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PageUploadResponse": {
+            "type": "object",
+            "properties": {
+                "mime_type": {
+                    "type": "string"
+                },
+                "original_size": {
+                    "description": "In bytes",
+                    "type": "integer"
+                },
                 "page": {
                     "type": "integer"
+                },
+                "stored_size": {
+                    "description": "In bytes",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PersonCreateInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "native_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PersonEditInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "native_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PersonResponse": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "native_name": {
+                    "type": "string"
+                },
+                "works": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonWorkResponse"
+                    }
+                }
+            }
+        },
+        "dto.PersonWorkResponse": {
+            "type": "object",
+            "properties": {
+                "manga_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
         "dto.ProfileEditExtendedInput": {
             "type": "object",
             "properties": {
+                "allowed_ratings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "bio": {
                     "type": "string"
                 },
@@ -5989,6 +9941,12 @@ const docTemplate = `{
                 "last_name"
             ],
             "properties": {
+                "allowed_ratings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "bio": {
                     "type": "string"
                 },
@@ -6093,6 +10051,20 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.StaffResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "person_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.SuccessResponse": {
             "type": "object",
             "properties": {
@@ -6113,6 +10085,50 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TagCreateInput": {
+            "type": "object",
+            "required": [
+                "group",
+                "name"
+            ],
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagEditInput": {
+            "type": "object",
+            "required": [
+                "group",
+                "new_name"
+            ],
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "new_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagResponse": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.TranslationDeleteInput": {
             "type": "object",
             "required": [
@@ -6163,6 +10179,22 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UploadResponse": {
+            "type": "object",
+            "properties": {
+                "mime_type": {
+                    "type": "string"
+                },
+                "original_size": {
+                    "description": "In bytes",
+                    "type": "integer"
+                },
+                "stored_size": {
+                    "description": "In bytes",
+                    "type": "integer"
+                }
+            }
+        },
         "dto.UserEditExtendedInput": {
             "type": "object",
             "required": [
@@ -6277,6 +10309,23 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.VolumeEditInput": {
+            "type": "object",
+            "required": [
+                "number"
+            ],
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.VolumeResponse": {
             "type": "object",
             "properties": {
@@ -6286,6 +10335,12 @@ const docTemplate = `{
                         "$ref": "#/definitions/dto.ChapterResponse"
                     }
                 },
+                "cover_url": {
+                    "type": "string"
+                },
+                "desc": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
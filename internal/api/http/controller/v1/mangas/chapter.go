@@ -30,7 +30,7 @@ type ChapterController struct {
 // @Param			chapter_id	path		uuid.UUID	true	"chapter id"
 // @Param			page		formData	integer		true	"page number"
 // @Param			image		formData	file		true	"page image"
-// @Success		201			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Success		201			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.PageUploadResponse}}
 // @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
 // @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
 // @Router			/chapters/{chapter_id}/pages [post]
@@ -47,7 +47,7 @@ func (m ChapterController) InsertChapterPage(ctx *gin.Context) {
     return
   }
 
-  stat, errorPages := m.chapterService.InsertChapterPage(&input)
+  result, errorPages, stat := m.chapterService.InsertChapterPage(&input)
   if stat.IsError() {
     details := struct {
       Pages []uint16
@@ -57,7 +57,80 @@ func (m ChapterController) InsertChapterPage(ctx *gin.Context) {
     resp.ErrorDetailed(ctx, stat, details)
     return
   }
-  resp.Success(ctx, stat, nil, nil)
+  resp.Success(ctx, stat, result, nil)
+}
+
+// @Summary		Insert Chapter Page Archive
+// @Description	insert all pages of specific chapter from CBZ/ZIP archive, the pages are numbered by natural order of the image names
+// @Tags			manga, chapter
+// @Accept			mpfd
+// @Produce		json
+// @Param			chapter_id	path		uuid.UUID	true	"chapter id"
+// @Param			archive		formData	file		true	"CBZ/ZIP archive"
+// @Success		201			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.PageUploadResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/pages/archive [post]
+func (m ChapterController) InsertChapterPageArchive(ctx *gin.Context) {
+  input := dto.PageArchiveCreateInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindMultipartForm(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  result, stat := m.chapterService.InsertChapterArchive(&input)
+  resp.Conditional(ctx, stat, result, nil)
+}
+
+// @Summary		Reorder Chapter Pages
+// @Description	renumber all pages of specific chapter, the page ids should contain all pages of the chapter in the new order
+// @Tags			manga, chapter
+// @Accept			json
+// @Produce		json
+// @Param			chapter_id	path		uuid.UUID				true	"chapter id"
+// @Param			input		body		dto.PageReorderInput	true	"page order"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/pages/order [patch]
+func (m ChapterController) ReorderChapterPages(ctx *gin.Context) {
+  input := dto.PageReorderInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.chapterService.ReorderChapterPages(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Replace Chapter Page
+// @Description	replace the image of specific chapter page without changing the other pages
+// @Tags			manga, chapter
+// @Accept			mpfd
+// @Produce		json
+// @Param			chapter_id	path		uuid.UUID	true	"chapter id"
+// @Param			number		path		int			true	"page number"
+// @Param			image		formData	file		true	"page image"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.PageUploadResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/pages/{number} [put]
+func (m ChapterController) ReplaceChapterPage(ctx *gin.Context) {
+  input := dto.PageReplaceInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindMultipartForm(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  result, stat := m.chapterService.ReplaceChapterPage(&input)
+  resp.Conditional(ctx, stat, result, nil)
 }
 
 // @Summary		Delete Chapter Pages
@@ -108,6 +181,54 @@ func (m ChapterController) EditChapter(ctx *gin.Context) {
   resp.Conditional(ctx, stat, nil, nil)
 }
 
+// @Summary		Edit Chapter Groups
+// @Description	add or remove groups credited on specific chapter
+// @Tags			manga, chapter
+// @Accept			json
+// @Produce		json
+// @Param			chapter_id	path		uuid.UUID					true	"chapter id"
+// @Param			input		body		dto.ChapterGroupEditInput	true	"chapter groups edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/groups [patch]
+func (m ChapterController) EditChapterGroups(ctx *gin.Context) {
+  input := dto.ChapterGroupEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.chapterService.EditChapterGroups(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Move Chapters
+// @Description	move chapters of the manga into another volume, leave volume_id empty to detach the chapters from the volume
+// @Tags			manga, chapter
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID				true	"manga id"
+// @Param			input		body		dto.ChapterMoveInput	true	"chapter move input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/chapters/volume [patch]
+func (m ChapterController) MoveChapters(ctx *gin.Context) {
+  input := dto.ChapterMoveInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.chapterService.MoveChapters(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
 // @Summary		Create Chapter Comment
 // @Description	create comment for specific chapter
 // @Tags			manga, chapter
@@ -292,6 +413,96 @@ func (m ChapterController) FindChapterDetails(ctx *gin.Context) {
   resp.Conditional(ctx, stat, pages, nil)
 }
 
+// @Summary		Download Chapter
+// @Description	Download specific chapter as CBZ archive with ComicInfo.xml metadata, the manga should allow download
+// @Tags			manga, chapter
+// @Produce		application/vnd.comicbook+zip
+// @Param			chapter_id	path		uuid.UUID	true	"chapter id"
+// @Success		200			{file}		binary
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/download [get]
+func (m ChapterController) DownloadChapter(ctx *gin.Context) {
+  chapterId := ctx.Param("chapter_id")
+  if !util.IsUUID(chapterId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("chapter_id", " should be uuid type"))
+    return
+  }
+
+  download, stat := m.chapterService.DownloadChapter(chapterId)
+  resp.File(ctx, stat, &download)
+}
+
+// @Summary		Download Volume
+// @Description	Download specific volume as ZIP archive containing CBZ archive of each chapter, the manga should allow download
+// @Tags			manga, chapter
+// @Produce		application/zip
+// @Param			volume_id	path		uuid.UUID	true	"volume id"
+// @Success		200			{file}		binary
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/volumes/{volume_id}/download [get]
+func (m ChapterController) DownloadVolume(ctx *gin.Context) {
+  volumeId := ctx.Param("volume_id")
+  if !util.IsUUID(volumeId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("volume_id", " should be uuid type"))
+    return
+  }
+
+  download, stat := m.chapterService.DownloadVolume(volumeId)
+  resp.File(ctx, stat, &download)
+}
+
+// @Summary		Export Chapter
+// @Description	Export specific chapter as fixed-layout EPUB, the manga should allow export
+// @Tags			manga, chapter
+// @Produce		application/epub+zip
+// @Param			chapter_id	path		uuid.UUID	true	"chapter id"
+// @Param			language	query		string		false	"language of the title, defaulted to the chapter language"
+// @Success		200			{file}		binary
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/chapters/{chapter_id}/epub [get]
+func (m ChapterController) ExportChapter(ctx *gin.Context) {
+  input := dto.ChapterExportInput{}
+  input.ConstructURI(ctx)
+
+  stat, fieldErrors := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  export, stat := m.chapterService.ExportChapter(&input)
+  resp.File(ctx, stat, &export)
+}
+
+// @Summary		Export Volume
+// @Description	Export specific volume as fixed-layout EPUB where each chapter is a section, the manga should allow export
+// @Tags			manga, chapter
+// @Produce		application/epub+zip
+// @Param			volume_id	path		uuid.UUID	true	"volume id"
+// @Param			language	query		string		false	"only export chapters in the language"
+// @Success		200			{file}		binary
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/volumes/{volume_id}/epub [get]
+func (m ChapterController) ExportVolume(ctx *gin.Context) {
+  input := dto.VolumeExportInput{}
+  input.ConstructURI(ctx)
+
+  stat, fieldErrors := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  export, stat := m.chapterService.ExportVolume(&input)
+  resp.File(ctx, stat, &export)
+}
+
 // @Summary		Find Volume Details
 // @Description	Get specific volume details with the chapters associated with it
 // @Tags			manga, chapter
@@ -312,6 +523,40 @@ func (m ChapterController) FindVolumeDetails(ctx *gin.Context) {
   resp.Conditional(ctx, stat, chapters, nil)
 }
 
+// @Summary		Find Manga Chapters
+// @Description	Get paginated chapters of specific manga, the chapters have read flag when the user is logged-in
+// @Tags			manga, chapter
+// @Produce		json
+// @Param			manga_id		path		uuid.UUID			true	"manga id"
+// @Param			page			query		dto.PagedQueryInput	false	"pagination query"
+// @Param			language		query		string				false	"chapter language"
+// @Param			volume_id		query		string				false	"volume id"
+// @Param			translator_id	query		string				false	"translator id"
+// @Param			order			query		string				false	"chapter order"		Enums(number, publish_date)
+// @Param			direction		query		string				false	"order direction"	Enums(asc, desc)
+// @Success		200				{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.ChapterResponse}}
+// @Failure		400				{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400				{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/chapters [get]
+func (m ChapterController) FindMangaChapters(ctx *gin.Context) {
+  input := dto.MangaChaptersFindInput{}
+  input.ConstructURI(ctx)
+
+  stat, fieldErrors := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  userId := common.GetOptionalUserId(ctx)
+  if userId.HasValue() {
+    input.UserId = *userId.Value()
+  }
+
+  chapters, page, stat := m.chapterService.FindMangaChapters(&input)
+  resp.Conditional(ctx, stat, chapters, page)
+}
+
 // @Summary		Get Manga History Chapters
 // @Description	get chapters of manga history on current logged-in user
 // @Tags			manga, chapter
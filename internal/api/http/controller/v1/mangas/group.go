@@ -0,0 +1,185 @@
+package mangas
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/service"
+  "manga-explorer/internal/util"
+  "manga-explorer/internal/util/httputil"
+  "manga-explorer/internal/util/httputil/resp"
+)
+
+func NewGroupController(groupService service.IGroup) GroupController {
+  return GroupController{groupService: groupService}
+}
+
+type GroupController struct {
+  groupService service.IGroup
+}
+
+// @Summary		Get All Groups
+// @Description	Get all scanlation groups, filtered by name when it is provided
+// @Tags			manga, group
+// @Produce		json
+// @Param			paged	query		dto.PagedQueryInput	true	"pagination query"
+// @Param			name	query		string				false	"group name"
+// @Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.GroupResponse}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Router			/groups [get]
+func (g GroupController) ListGroups(ctx *gin.Context) {
+  input := dto.GroupListInput{}
+  stat, fieldsErr := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  groups, pages, stat := g.groupService.ListGroups(&input)
+  resp.Conditional(ctx, stat, groups, pages)
+}
+
+// @Summary		Find Group By Id
+// @Description	Get specific group with the members
+// @Tags			manga, group
+// @Produce		json
+// @Param			group_id	path		uuid.UUID	true	"group id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.GroupResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/groups/{group_id} [get]
+func (g GroupController) FindGroupById(ctx *gin.Context) {
+  groupId := ctx.Param("group_id")
+  if len(groupId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("group_id"))
+    return
+  }
+
+  if !util.IsUUID(groupId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("group_id", " should be uuid type"))
+    return
+  }
+
+  group, stat := g.groupService.FindGroupById(groupId)
+  resp.Conditional(ctx, stat, group, nil)
+}
+
+// @Summary		Find Group Releases
+// @Description	Get all chapters released by specific group ordered by the newest
+// @Tags			manga, group
+// @Produce		json
+// @Param			group_id	path		uuid.UUID			true	"group id"
+// @Param			paged		query		dto.PagedQueryInput	true	"pagination query"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.ChapterResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Router			/groups/{group_id}/chapters [get]
+func (g GroupController) FindGroupReleases(ctx *gin.Context) {
+  input := dto.GroupReleaseInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  chapters, pages, stat := g.groupService.FindGroupReleases(&input)
+  resp.Conditional(ctx, stat, chapters, pages)
+}
+
+// @Summary		Create Group
+// @Description	Create new group which could be credited on chapters
+// @Tags			manga, group
+// @Accept			json
+// @Produce		json
+// @Param			input	body		dto.GroupCreateInput	true	"group create input"
+// @Success		201		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/groups [post]
+func (g GroupController) CreateGroup(ctx *gin.Context) {
+  input := dto.GroupCreateInput{}
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = g.groupService.CreateGroup(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Group
+// @Description	Edit specific group by id
+// @Tags			manga, group
+// @Accept			json
+// @Produce		json
+// @Param			group_id	path		uuid.UUID			true	"group id"
+// @Param			input		body		dto.GroupEditInput	true	"group edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/groups/{group_id} [put]
+func (g GroupController) EditGroup(ctx *gin.Context) {
+  input := dto.GroupEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = g.groupService.UpdateGroup(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Group Members
+// @Description	Add, remove or change the role of group members
+// @Tags			manga, group
+// @Accept			json
+// @Produce		json
+// @Param			group_id	path		uuid.UUID					true	"group id"
+// @Param			input		body		dto.GroupMemberEditInput	true	"group members edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/groups/{group_id}/members [patch]
+func (g GroupController) EditGroupMembers(ctx *gin.Context) {
+  input := dto.GroupMemberEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = g.groupService.EditGroupMembers(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Delete Group
+// @Description	Delete specific group by id, the group will be removed from all credited chapters
+// @Tags			manga, group
+// @Produce		json
+// @Param			group_id	path		uuid.UUID	true	"group id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/groups/{group_id} [delete]
+func (g GroupController) DeleteGroup(ctx *gin.Context) {
+  groupId := ctx.Param("group_id")
+  if len(groupId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("group_id"))
+    return
+  }
+
+  if !util.IsUUID(groupId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("group_id", " should be uuid type"))
+    return
+  }
+
+  stat := g.groupService.DeleteGroup(groupId)
+  resp.Conditional(ctx, stat, nil, nil)
+}
@@ -22,7 +22,7 @@ type MangaController struct {
 }
 
 // @Summary		Get All Mangas
-// @Description	get all registered mangas
+// @Description	get all registered mangas, it only shows mangas allowed by user's content ratings preference (anonymous user only get safe mangas)
 // @Tags			manga
 // @Param			paged	query	dto.PagedQueryInput	true	"pagination query"
 // @Produce		json
@@ -37,12 +37,12 @@ func (m MangaController) ListManga(ctx *gin.Context) {
     return
   }
 
-  mangas, pages, stat := m.mangaService.ListMangas(&input)
+  mangas, pages, stat := m.mangaService.ListMangas(&input, common.GetOptionalUserId(ctx))
   resp.Conditional(ctx, stat, mangas, pages)
 }
 
 // @Summary		Search Manga
-// @Description	search manga by the body
+// @Description	search manga by the body, it only shows mangas allowed by user's content ratings preference (anonymous user only get safe mangas)
 // @Tags			manga
 // @Accept			json
 // @Produce		json
@@ -60,7 +60,7 @@ func (m MangaController) Search(ctx *gin.Context) {
     return
   }
 
-  mangas, page, stat := m.mangaService.SearchMangas(&input)
+  mangas, page, stat := m.mangaService.SearchMangas(&input, common.GetOptionalUserId(ctx))
   resp.Conditional(ctx, stat, mangas, page)
 }
 
@@ -112,8 +112,128 @@ func (m MangaController) EditMangaGenres(ctx *gin.Context) {
   resp.Conditional(ctx, stat, nil, nil)
 }
 
+// @Summary		Edit Manga Tags
+// @Description	Add or remove tags of specific manga
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID				true	"manga id"
+// @Param			input		body		dto.MangaTagEditInput	true	"manga's tags edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/tags [patch]
+func (m MangaController) EditMangaTags(ctx *gin.Context) {
+  input := mangaDto.MangaTagEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditMangaTags(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Manga Slug
+// @Description	Change slug of specific manga, the previous slug will still redirect to the manga
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID				true	"manga id"
+// @Param			input		body		dto.MangaSlugEditInput	true	"manga's slug edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/slug [patch]
+func (m MangaController) EditMangaSlug(ctx *gin.Context) {
+  input := mangaDto.MangaSlugEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditMangaSlug(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Manga Download
+// @Description	Allow or disallow chapters and volumes of specific manga to be downloaded
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID					true	"manga id"
+// @Param			input		body		dto.MangaDownloadEditInput	true	"manga's download edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/download [patch]
+func (m MangaController) EditMangaDownload(ctx *gin.Context) {
+  input := mangaDto.MangaDownloadEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditMangaDownload(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Manga Export
+// @Description	Allow or disallow chapters and volumes of specific manga to be exported as EPUB
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID					true	"manga id"
+// @Param			input		body		dto.MangaExportEditInput	true	"manga's export edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/export [patch]
+func (m MangaController) EditMangaExport(ctx *gin.Context) {
+  input := mangaDto.MangaExportEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditMangaExport(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Manga Staff
+// @Description	Add or remove people credited on specific manga
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID				true	"manga id"
+// @Param			input		body		dto.MangaStaffEditInput	true	"manga's staff edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/staff [patch]
+func (m MangaController) EditMangaStaff(ctx *gin.Context) {
+  input := mangaDto.MangaStaffEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditMangaStaff(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
 // @Summary		Random Manga
-// @Description	Get random manga with limit query
+// @Description	Get random manga with limit query, it only shows mangas allowed by user's content ratings preference (anonymous user only get safe mangas)
 // @Tags			manga
 // @Produce		json
 // @Param			limit	query		integer	false	"total response manga"
@@ -122,19 +242,19 @@ func (m MangaController) EditMangaGenres(ctx *gin.Context) {
 // @Router			/mangas [get]
 func (m MangaController) Random(ctx *gin.Context) {
   limit := util.GetDefaultedUintQuery(ctx, "limit", 1)
-  mangas, stat := m.mangaService.FindRandomMangas(limit)
+  mangas, stat := m.mangaService.FindRandomMangas(limit, common.GetOptionalUserId(ctx))
   resp.Conditional(ctx, stat, mangas, nil)
 }
 
-// @Summary	Find Manga By Id
-// @Description
-// @Tags		manga
-// @Produce	json
-// @Param		manga_id	path		uuid.UUID	true	"manga id"
-// @Success	200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.MangaResponse}}
-// @Failure	400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
-// @Failure	400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
-// @Router		/mangas/{manga_id} [get]
+// @Summary		Find Manga By Id
+// @Description	Find manga by the id or slug, previous slugs of the manga are still resolved
+// @Tags			manga
+// @Produce		json
+// @Param			manga_id	path		string	true	"manga id or slug"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.MangaResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id} [get]
 func (m MangaController) FindMangaById(ctx *gin.Context) {
   id := ctx.Param("manga_id")
   if len(id) == 0 {
@@ -143,8 +263,8 @@ func (m MangaController) FindMangaById(ctx *gin.Context) {
   }
 
   if !util.IsUUID(id) {
-    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
-      common.NewParameterError("manga_id", " should be uuid type"))
+    mangas, stat := m.mangaService.FindMangaBySlug(id)
+    resp.Conditional(ctx, stat, mangas, nil)
     return
   }
 
@@ -295,7 +415,7 @@ func (m MangaController) CreateManga(ctx *gin.Context) {
 // @Produce		json
 // @Param			manga_id	path		uuid.UUID	true	"manga id"
 // @Param			image		formData	file		true	"cover image"
-// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.UploadResponse}}
 // @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
 // @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
 // @Router			/mangas/{manga_id}/covers [patch]
@@ -309,8 +429,8 @@ func (m MangaController) UpdateMangaCover(ctx *gin.Context) {
     return
   }
 
-  stat = m.mangaService.UpdateMangaCover(&input)
-  resp.Conditional(ctx, stat, nil, nil)
+  result, stat := m.mangaService.UpdateMangaCover(&input)
+  resp.Conditional(ctx, stat, result, nil)
 }
 
 // @Summary		Create Volume
@@ -337,6 +457,55 @@ func (m MangaController) CreateVolume(ctx *gin.Context) {
   resp.Conditional(ctx, stat, nil, nil)
 }
 
+// @Summary		Edit Volume
+// @Description	edit volume title, description and number
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			volume_id	path		uuid.UUID			true	"volume id"
+// @Param			input		body		dto.VolumeEditInput	true	"volume edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/volumes/{volume_id} [put]
+func (m MangaController) EditVolume(ctx *gin.Context) {
+  input := mangaDto.VolumeEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.mangaService.EditVolume(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Update Volume Cover
+// @Description	upload or replace volume cover image
+// @Tags			manga
+// @Accept			mpfd
+// @Produce		json
+// @Param			volume_id	path		uuid.UUID	true	"volume id"
+// @Param			image		formData	file		true	"cover image"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.UploadResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/volumes/{volume_id}/covers [patch]
+func (m MangaController) UpdateVolumeCover(ctx *gin.Context) {
+  input := mangaDto.VolumeCoverUpdateInput{}
+  input.ConstructURI(ctx)
+
+  stat, fieldsErr := httputil.BindMultipartForm(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  result, stat := m.mangaService.UpdateVolumeCover(&input)
+  resp.Conditional(ctx, stat, result, nil)
+}
+
 // @Summary		Insert Manga Translation
 // @Description	create manga translation for specific manga
 // @Tags			manga
@@ -477,6 +646,154 @@ func (m MangaController) EditTranslation(ctx *gin.Context) {
   resp.Conditional(ctx, stat, nil, nil)
 }
 
+// @Summary		Insert Manga Alternative Titles
+// @Description	add alternative titles (romaji, abbreviation, synonym) for specific manga
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID								true	"manga id"
+// @Param			input		body		dto.MangaAlternativeTitleInsertInput	true	"manga alternative title insert input"
+// @Success		201			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/titles [post]
+func (m MangaController) InsertMangaAlternativeTitles(ctx *gin.Context) {
+  input := mangaDto.MangaAlternativeTitleInsertInput{}
+  input.ConstructURI(ctx)
+  stat, fieldErrors := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  stat = m.mangaService.InsertMangaAlternativeTitles(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Find Manga Alternative Titles
+// @Description	get all alternative titles of specific manga
+// @Tags			manga
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID	true	"manga id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.AlternativeTitleResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/titles [get]
+func (m MangaController) FindMangaAlternativeTitles(ctx *gin.Context) {
+  mangaId := ctx.Param("manga_id")
+  if len(mangaId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("manga_id"))
+    return
+  }
+
+  if !util.IsUUID(mangaId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("manga_id", " should be uuid type"))
+    return
+  }
+
+  titles, stat := m.mangaService.FindMangaAlternativeTitles(mangaId)
+  resp.Conditional(ctx, stat, titles, nil)
+}
+
+// @Summary		Delete Manga Alternative Titles
+// @Description	delete alternative titles of specific manga based on the id
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID								true	"manga id"
+// @Param			input		body		dto.MangaAlternativeTitleDeleteInput	true	"manga alternative title delete input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/titles [delete]
+func (m MangaController) DeleteMangaAlternativeTitles(ctx *gin.Context) {
+  input := mangaDto.MangaAlternativeTitleDeleteInput{}
+  input.ConstructURI(ctx)
+  stat, fieldErrors := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  stat = m.mangaService.DeleteMangaAlternativeTitles(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Create Manga Relation
+// @Description	link specific manga with another manga, the inverse relation is created too when the kind has it (sequel and prequel)
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID						true	"manga id"
+// @Param			input		body		dto.MangaRelationCreateInput	true	"manga relation create input"
+// @Success		201			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/relations [post]
+func (m MangaController) CreateMangaRelation(ctx *gin.Context) {
+  input := mangaDto.MangaRelationCreateInput{}
+  input.ConstructURI(ctx)
+  stat, fieldErrors := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  stat = m.mangaService.CreateMangaRelation(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Find Manga Relations
+// @Description	get all mangas related to specific manga
+// @Tags			manga
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID	true	"manga id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.MangaRelationResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/relations [get]
+func (m MangaController) FindMangaRelations(ctx *gin.Context) {
+  mangaId := ctx.Param("manga_id")
+  if len(mangaId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("manga_id"))
+    return
+  }
+
+  if !util.IsUUID(mangaId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("manga_id", " should be uuid type"))
+    return
+  }
+
+  relations, stat := m.mangaService.FindMangaRelations(mangaId)
+  resp.Conditional(ctx, stat, relations, nil)
+}
+
+// @Summary		Delete Manga Relation
+// @Description	unlink specific manga with another manga on both directions
+// @Tags			manga
+// @Accept			json
+// @Produce		json
+// @Param			manga_id	path		uuid.UUID						true	"manga id"
+// @Param			input		body		dto.MangaRelationDeleteInput	true	"manga relation delete input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/mangas/{manga_id}/relations [delete]
+func (m MangaController) DeleteMangaRelation(ctx *gin.Context) {
+  input := mangaDto.MangaRelationDeleteInput{}
+  input.ConstructURI(ctx)
+  stat, fieldErrors := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  stat = m.mangaService.DeleteMangaRelation(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
 // @Summary		Delete Volume
 // @Description	delete specific volumes on manga
 // @Tags			manga
@@ -0,0 +1,139 @@
+package mangas
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/service"
+  "manga-explorer/internal/util"
+  "manga-explorer/internal/util/httputil"
+  "manga-explorer/internal/util/httputil/resp"
+)
+
+func NewPersonController(personService service.IPerson) PersonController {
+  return PersonController{personService: personService}
+}
+
+type PersonController struct {
+  personService service.IPerson
+}
+
+// @Summary		Get All People
+// @Description	Get all registered people, filtered by name when it is provided
+// @Tags			manga, person
+// @Produce		json
+// @Param			paged	query		dto.PagedQueryInput	true	"pagination query"
+// @Param			name	query		string				false	"person name"
+// @Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.PersonResponse}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Router			/people [get]
+func (p PersonController) ListPeople(ctx *gin.Context) {
+  input := dto.PersonListInput{}
+  stat, fieldsErr := httputil.BindQuery(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  people, pages, stat := p.personService.ListPeople(&input)
+  resp.Conditional(ctx, stat, people, pages)
+}
+
+// @Summary		Find Person By Id
+// @Description	Get specific person with the mangas credited to
+// @Tags			manga, person
+// @Produce		json
+// @Param			person_id	path		uuid.UUID	true	"person id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.PersonResponse}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/people/{person_id} [get]
+func (p PersonController) FindPersonById(ctx *gin.Context) {
+  personId := ctx.Param("person_id")
+  if len(personId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("person_id"))
+    return
+  }
+
+  if !util.IsUUID(personId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("person_id", " should be uuid type"))
+    return
+  }
+
+  person, stat := p.personService.FindPersonById(personId)
+  resp.Conditional(ctx, stat, person, nil)
+}
+
+// @Summary		Create Person
+// @Description	Create new person which could be credited on mangas
+// @Tags			manga, person
+// @Accept			json
+// @Produce		json
+// @Param			input	body		dto.PersonCreateInput	true	"person create input"
+// @Success		201		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/people [post]
+func (p PersonController) CreatePerson(ctx *gin.Context) {
+  input := dto.PersonCreateInput{}
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = p.personService.CreatePerson(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Person
+// @Description	Edit specific person by id
+// @Tags			manga, person
+// @Accept			json
+// @Produce		json
+// @Param			person_id	path		uuid.UUID			true	"person id"
+// @Param			input		body		dto.PersonEditInput	true	"person edit input"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/people/{person_id} [put]
+func (p PersonController) EditPerson(ctx *gin.Context) {
+  input := dto.PersonEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldsErr := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = p.personService.UpdatePerson(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Delete Person
+// @Description	Delete specific person by id, the person will be removed from all credited mangas
+// @Tags			manga, person
+// @Produce		json
+// @Param			person_id	path		uuid.UUID	true	"person id"
+// @Success		200			{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=common.ParameterError}}
+// @Failure		400			{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/people/{person_id} [delete]
+func (p PersonController) DeletePerson(ctx *gin.Context) {
+  personId := ctx.Param("person_id")
+  if len(personId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("person_id"))
+    return
+  }
+
+  if !util.IsUUID(personId) {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR),
+      common.NewParameterError("person_id", " should be uuid type"))
+    return
+  }
+
+  stat := p.personService.DeletePerson(personId)
+  resp.Conditional(ctx, stat, nil, nil)
+}
@@ -0,0 +1,94 @@
+package mangas
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/service"
+  "manga-explorer/internal/util/httputil"
+  "manga-explorer/internal/util/httputil/resp"
+)
+
+func NewTagController(tagService service.ITag) TagController {
+  return TagController{tagService: tagService}
+}
+
+type TagController struct {
+  tagService service.ITag
+}
+
+// @Summary		Get All Tags
+// @Description	Get all registered tags
+// @Tags			manga, tag
+// @Produce		json
+// @Success		200	{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=[]dto.TagResponse}}
+// @Router			/tags [get]
+func (m TagController) ListTag(ctx *gin.Context) {
+  tags, stat := m.tagService.ListTag()
+  resp.Conditional(ctx, stat, tags, nil)
+}
+
+// @Summary		Create Tag
+// @Description	Create new tag
+// @Tags			manga, tag
+// @Accept			json
+// @Produce		json
+// @Param			input	body		dto.TagCreateInput	true	"tag create input"
+// @Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/tags [post]
+func (m TagController) CreateTag(ctx *gin.Context) {
+  tagInput := dto.TagCreateInput{}
+  stat, fieldsErr := httputil.BindJson(ctx, &tagInput)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldsErr)
+    return
+  }
+
+  stat = m.tagService.CreateTag(&tagInput)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Edit Tag
+// @Description	Edit specific tag by id
+// @Tags			manga, tag
+// @Accept			json
+// @Produce		json
+// @Param			input	body		dto.TagEditInput	true	"tag edit input"
+// @Param			tag_id	path		uuid.UUID			true	"tag id"
+// @Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
+// @Router			/tags/{tag_id} [put]
+func (m TagController) EditTag(ctx *gin.Context) {
+  input := dto.TagEditInput{}
+  input.ConstructURI(ctx)
+  stat, fieldErrors := httputil.BindJson(ctx, &input)
+  if stat.IsError() {
+    resp.ErrorDetailed(ctx, stat, fieldErrors)
+    return
+  }
+
+  stat = m.tagService.UpdateTag(&input)
+  resp.Conditional(ctx, stat, nil, nil)
+}
+
+// @Summary		Delete Tag
+// @Description	Delete specific tag by id
+// @Tags			manga, tag
+// @Produce		json
+// @Param			tag_id	path		uuid.UUID	true	"tag id"
+// @Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+// @Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
+// @Router			/tags/{tag_id} [delete]
+func (m TagController) DeleteTag(ctx *gin.Context) {
+  tagId := ctx.Param("tag_id")
+  if len(tagId) == 0 {
+    resp.ErrorDetailed(ctx, status.Error(status.BAD_PARAMETER_ERROR), common.NewNotPresentParameter("tag_id"))
+    return
+  }
+  stat := m.tagService.DeleteTag(tagId)
+  resp.Conditional(ctx, stat, nil, nil)
+}
@@ -277,7 +277,7 @@ func (u *UserController) EditUserProfileExtended(ctx *gin.Context) {
 //	@Accept			mpfd
 //	@Produce		json
 //	@Param			image	formData	file	true	"profile's image"
-//	@Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=nil}}
+//	@Success		200		{object}	dto.SuccessWrapper{success=dto.SuccessResponse{data=dto.UploadResponse}}
 //	@Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=[]common.FieldError}}
 //	@Failure		400		{object}	dto.ErrorWrapper{error=dto.ErrorResponse{details=nil}}
 //	@Router			/users/profiles/image [put]
@@ -298,8 +298,8 @@ func (u *UserController) UpdateProfileImage(ctx *gin.Context) {
 		return
 	}
 
-	stat = u.userService.UpdateProfileImage(&input)
-	resp.Conditional(ctx, stat, nil, nil)
+	result, stat := u.userService.UpdateProfileImage(&input)
+	resp.Conditional(ctx, stat, result, nil)
 }
 
 // @Summary		Delete Profile Image
@@ -15,6 +15,9 @@ func (m _mangaRoute) V1Route(config *Config, router gin.IRouter) {
 	m.MangaRoute(config, router)
 	m.ChapterRoute(config, router)
 	m.GenreRoute(config, router)
+	m.TagRoute(config, router)
+	m.PersonRoute(config, router)
+	m.GroupRoute(config, router)
 }
 
 func (m _mangaRoute) MangaRoute(config *Config, router gin.IRouter) {
@@ -23,13 +26,16 @@ func (m _mangaRoute) MangaRoute(config *Config, router gin.IRouter) {
 	mangaController := &config.Controller.Manga
 	chapterController := &config.Controller.MangaChapter
 
-	mangaRoute.GET("/", mangaController.ListManga)
-	mangaRoute.GET("/search", mangaController.Search)
-	mangaRoute.GET("/random", mangaController.Random)
+	mangaRoute.GET("/", config.Middleware.Authorization.Handle2, mangaController.ListManga)
+	mangaRoute.GET("/search", config.Middleware.Authorization.Handle2, mangaController.Search)
+	mangaRoute.GET("/random", config.Middleware.Authorization.Handle2, mangaController.Random)
 	mangaRoute.GET("/:manga_id", mangaController.FindMangaById)
 	mangaRoute.GET("/:manga_id/comments", mangaController.FindMangaComments)
 	mangaRoute.GET("/:manga_id/ratings", mangaController.FindMangaRatings)
 	mangaRoute.GET("/:manga_id/translates/*language", mangaController.FindMangaTranslations)
+	mangaRoute.GET("/:manga_id/titles", mangaController.FindMangaAlternativeTitles)
+	mangaRoute.GET("/:manga_id/relations", mangaController.FindMangaRelations)
+	mangaRoute.GET("/:manga_id/chapters", config.Middleware.Authorization.Handle2, chapterController.FindMangaChapters)
 	// Login user
 	mangaRoute.Use(config.Middleware.Authorization.Handle)
 	mangaRoute.POST("/:manga_id/comments", mangaController.CreateMangaComment)
@@ -47,20 +53,35 @@ func (m _mangaRoute) MangaRoute(config *Config, router gin.IRouter) {
 	mangaRoute.PUT("/translates/:translate_id", mangaController.EditTranslation)
 	mangaRoute.DELETE("/translates", mangaController.DeleteTranslations)
 
+	mangaRoute.POST("/:manga_id/titles", mangaController.InsertMangaAlternativeTitles)
+	mangaRoute.DELETE("/:manga_id/titles", mangaController.DeleteMangaAlternativeTitles)
+
+	mangaRoute.POST("/:manga_id/relations", mangaController.CreateMangaRelation)
+	mangaRoute.DELETE("/:manga_id/relations", mangaController.DeleteMangaRelation)
+
 	mangaRoute.POST("/", mangaController.CreateManga)
 	mangaRoute.PUT("/:manga_id", mangaController.EditManga)
 	mangaRoute.PATCH("/:manga_id/genres", mangaController.EditMangaGenres)
+	mangaRoute.PATCH("/:manga_id/tags", mangaController.EditMangaTags)
+	mangaRoute.PATCH("/:manga_id/slug", mangaController.EditMangaSlug)
+	mangaRoute.PATCH("/:manga_id/download", mangaController.EditMangaDownload)
+	mangaRoute.PATCH("/:manga_id/export", mangaController.EditMangaExport)
+	mangaRoute.PATCH("/:manga_id/staff", mangaController.EditMangaStaff)
 	mangaRoute.POST("/:manga_id/volumes", mangaController.CreateVolume)
 	mangaRoute.DELETE("/:manga_id/volumes", mangaController.DeleteVolume)
 	mangaRoute.POST("/:manga_id/chapters", chapterController.CreateChapter)
+	mangaRoute.PATCH("/:manga_id/chapters/volume", chapterController.MoveChapters)
 
 	mangaRoute.PATCH("/:manga_id/covers", mangaController.UpdateMangaCover)
 }
 func (m _mangaRoute) ChapterRoute(config *Config, router gin.IRouter) {
 	chapterController := &config.Controller.MangaChapter
+	mangaController := &config.Controller.Manga
 	chapterRoute := router.Group("/chapters")
 	chapterRoute.GET("/:chapter_id/comments", chapterController.FindChapterComments)
 	chapterRoute.GET("/:chapter_id", config.Middleware.Authorization.Handle2, chapterController.FindChapterDetails)
+	chapterRoute.GET("/:chapter_id/download", chapterController.DownloadChapter)
+	chapterRoute.GET("/:chapter_id/epub", chapterController.ExportChapter)
 
 	// Login user
 	chapterRoute.Use(config.Middleware.Authorization.Handle)
@@ -69,9 +90,13 @@ func (m _mangaRoute) ChapterRoute(config *Config, router gin.IRouter) {
 	// Admin
 	chapterRoute.Use(config.Middleware.AdminRestrict.Handle)
 	chapterRoute.PUT("/:chapter_id", chapterController.EditChapter)
+	chapterRoute.PATCH("/:chapter_id/groups", chapterController.EditChapterGroups)
 	chapterRoute.DELETE("/:chapter_id", chapterController.DeleteChapter)
 
 	chapterRoute.POST("/:chapter_id/pages", chapterController.InsertChapterPage)
+	chapterRoute.POST("/:chapter_id/pages/archive", chapterController.InsertChapterPageArchive)
+	chapterRoute.PATCH("/:chapter_id/pages/order", chapterController.ReorderChapterPages)
+	chapterRoute.PUT("/:chapter_id/pages/:number", chapterController.ReplaceChapterPage)
 	chapterRoute.DELETE("/:chapter_id/pages", chapterController.DeleteChapterPages)
 
 	// Page IRoute
@@ -83,6 +108,13 @@ func (m _mangaRoute) ChapterRoute(config *Config, router gin.IRouter) {
 
 	volumeRoute := router.Group("/volumes")
 	volumeRoute.GET("/:volume_id", chapterController.FindVolumeDetails)
+	volumeRoute.GET("/:volume_id/download", chapterController.DownloadVolume)
+	volumeRoute.GET("/:volume_id/epub", chapterController.ExportVolume)
+
+	// Admin
+	volumeRoute.Use(config.Middleware.Authorization.Handle, config.Middleware.AdminRestrict.Handle)
+	volumeRoute.PUT("/:volume_id", mangaController.EditVolume)
+	volumeRoute.PATCH("/:volume_id/covers", mangaController.UpdateVolumeCover)
 }
 func (m _mangaRoute) GenreRoute(config *Config, router gin.IRouter) {
 	genreController := &config.Controller.MangaGenre
@@ -97,3 +129,46 @@ func (m _mangaRoute) GenreRoute(config *Config, router gin.IRouter) {
 	genreRoute.POST("/", genreController.CreateGenre)
 	genreRoute.DELETE("/:genre_id", genreController.DeleteGenre)
 }
+func (m _mangaRoute) TagRoute(config *Config, router gin.IRouter) {
+	tagController := &config.Controller.MangaTag
+
+	tagRoute := router.Group("/tags")
+
+	tagRoute.GET("/", tagController.ListTag)
+
+	// Admin
+	tagRoute.Use(config.Middleware.Authorization.Handle, config.Middleware.AdminRestrict.Handle)
+	tagRoute.PUT("/:tag_id", tagController.EditTag)
+	tagRoute.POST("/", tagController.CreateTag)
+	tagRoute.DELETE("/:tag_id", tagController.DeleteTag)
+}
+func (m _mangaRoute) PersonRoute(config *Config, router gin.IRouter) {
+	personController := &config.Controller.MangaPerson
+
+	personRoute := router.Group("/people")
+
+	personRoute.GET("/", personController.ListPeople)
+	personRoute.GET("/:person_id", personController.FindPersonById)
+
+	// Admin
+	personRoute.Use(config.Middleware.Authorization.Handle, config.Middleware.AdminRestrict.Handle)
+	personRoute.POST("/", personController.CreatePerson)
+	personRoute.PUT("/:person_id", personController.EditPerson)
+	personRoute.DELETE("/:person_id", personController.DeletePerson)
+}
+func (m _mangaRoute) GroupRoute(config *Config, router gin.IRouter) {
+	groupController := &config.Controller.MangaGroup
+
+	groupRoute := router.Group("/groups")
+
+	groupRoute.GET("/", groupController.ListGroups)
+	groupRoute.GET("/:group_id", groupController.FindGroupById)
+	groupRoute.GET("/:group_id/chapters", groupController.FindGroupReleases)
+
+	// Admin
+	groupRoute.Use(config.Middleware.Authorization.Handle, config.Middleware.AdminRestrict.Handle)
+	groupRoute.POST("/", groupController.CreateGroup)
+	groupRoute.PUT("/:group_id", groupController.EditGroup)
+	groupRoute.PATCH("/:group_id/members", groupController.EditGroupMembers)
+	groupRoute.DELETE("/:group_id", groupController.DeleteGroup)
+}
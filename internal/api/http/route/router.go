@@ -13,6 +13,9 @@ type ConfigController struct {
 	Manga        mangas.MangaController
 	MangaChapter mangas.ChapterController
 	MangaGenre   mangas.GenreController
+	MangaTag     mangas.TagController
+	MangaPerson  mangas.PersonController
+	MangaGroup   mangas.GroupController
 }
 
 type ConfigMiddleware struct {
@@ -1,6 +1,7 @@
 package service
 
 import (
+  "errors"
   "manga-explorer/internal/common"
   commonDto "manga-explorer/internal/common/dto"
   appMapper "manga-explorer/internal/common/mapper"
@@ -10,6 +11,7 @@ import (
   "manga-explorer/internal/domain/mangas/mapper"
   "manga-explorer/internal/domain/mangas/repository"
   "manga-explorer/internal/domain/mangas/service"
+  userRepository "manga-explorer/internal/domain/users/repository"
   "manga-explorer/internal/infrastructure/file"
   fileService "manga-explorer/internal/infrastructure/file/service"
   "manga-explorer/internal/util/containers"
@@ -17,13 +19,15 @@ import (
   "time"
 )
 
-func NewMangaService(fileService fileService.IFile, mangaRepo repository.IManga, translation repository.ITranslation, commentRepo repository.IComment, rateRepo repository.IRate) service.IManga {
+func NewMangaService(fileService fileService.IFile, mangaRepo repository.IManga, translation repository.ITranslation, altTitleRepo repository.IAlternativeTitle, commentRepo repository.IComment, rateRepo repository.IRate, userRepo userRepository.IUser) service.IManga {
   return &mangaService{
     fileService:     fileService,
+    userRepo:        userRepo,
     mangaRepo:       mangaRepo,
     commentRepo:     commentRepo,
     rateRepo:        rateRepo,
     translationRepo: translation,
+    altTitleRepo:    altTitleRepo,
   }
 }
 
@@ -32,8 +36,29 @@ type mangaService struct {
 
   mangaRepo       repository.IManga
   translationRepo repository.ITranslation
+  altTitleRepo    repository.IAlternativeTitle
   commentRepo     repository.IComment
   rateRepo        repository.IRate
+  userRepo        userRepository.IUser
+}
+
+// contentRatings get allowed content ratings of the user, it will use the default ratings when the user is anonymous
+// or has no preference
+func (m mangaService) contentRatings(userId opt.Optional[string]) []mangas.ContentRating {
+  if !userId.HasValue() {
+    return mangas.DefaultContentRatings
+  }
+
+  profile, err := m.userRepo.FindUserProfiles(*userId.Value())
+  if err != nil {
+    return mangas.DefaultContentRatings
+  }
+
+  ratings := mangas.NewContentRatings(profile.AllowedRatings)
+  if len(ratings) == 0 {
+    return mangas.DefaultContentRatings
+  }
+  return ratings
 }
 
 func (m mangaService) CreateVolume(input *mangaDto.VolumeCreateInput) status.Object {
@@ -48,6 +73,39 @@ func (m mangaService) DeleteVolume(input *mangaDto.VolumeDeleteInput) status.Obj
   return status.ConditionalRepository(err, status.DELETED, opt.New(status.VOLUME_DELETE_FAILED))
 }
 
+func (m mangaService) EditVolume(input *mangaDto.VolumeEditInput) status.Object {
+  volume := mapper.MapVolumeEditInput(input)
+
+  err := m.mangaRepo.EditVolume(&volume)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.VOLUME_NOT_FOUND), opt.New(status.VOLUME_ALREADY_EXISTS))
+}
+
+func (m mangaService) UpdateVolumeCover(input *mangaDto.VolumeCoverUpdateInput) (commonDto.UploadResponse, status.Object) {
+  volume, err := m.mangaRepo.FindVolumeById(input.VolumeId)
+  if err != nil {
+    return commonDto.UploadResponse{}, status.RepositoryError(err, opt.New(status.VOLUME_NOT_FOUND))
+  }
+
+  // Upload new cover image
+  uploaded, stat := m.fileService.Upload(file.VolumeAsset, input.Image)
+  if stat.IsError() {
+    return commonDto.UploadResponse{}, stat
+  }
+
+  // Delete current cover image
+  if len(volume.CoverURL) != 0 {
+    stat = m.fileService.Delete(file.VolumeAsset, volume.CoverURL)
+    if stat.IsError() {
+      return commonDto.UploadResponse{}, stat
+    }
+  }
+
+  // Update metadata
+  editedVolume := mangas.Volume{Id: volume.Id, CoverURL: uploaded.Name}
+  err = m.mangaRepo.PatchVolume(&editedVolume)
+  return appMapper.ToUploadResponse(uploaded), status.ConditionalRepository(err, status.UPDATED, opt.New(status.VOLUME_UPDATE_FAILED))
+}
+
 func (m mangaService) CreateComments(input *mangaDto.MangaCommentCreateInput) status.Object {
   comment := mapper.MapMangaCommentCreateInput(input)
   if input.HasParent() {
@@ -71,15 +129,16 @@ func (m mangaService) UpsertMangaRating(input *mangaDto.RateUpsertInput) status.
   return status.ConditionalRepository(err, status.SUCCESS, opt.New(status.RATING_NOT_FOUND))
 }
 
-func (m mangaService) ListMangas(query *commonDto.PagedQueryInput) ([]mangaDto.MinimalMangaResponse, *commonDto.ResponsePage, status.Object) {
-  result, err := m.mangaRepo.ListMangas(query.ToQueryParam())
+func (m mangaService) ListMangas(query *commonDto.PagedQueryInput, userId opt.Optional[string]) ([]mangaDto.MinimalMangaResponse, *commonDto.ResponsePage, status.Object) {
+  result, err := m.mangaRepo.ListMangas(m.contentRatings(userId), query.ToQueryParam())
   mangaResponses := containers.CastSlicePtr1(result.Data, m.fileService, mapper.ToMinimalMangaResponse)
   responsePage := appMapper.NewResponsePage(mangaResponses, result.Total, query)
   return mangaResponses, &responsePage, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
 }
 
-func (m mangaService) SearchMangas(query *mangaDto.MangaSearchQuery) ([]mangaDto.MinimalMangaResponse, *commonDto.ResponsePage, status.Object) {
+func (m mangaService) SearchMangas(query *mangaDto.MangaSearchQuery, userId opt.Optional[string]) ([]mangaDto.MinimalMangaResponse, *commonDto.ResponsePage, status.Object) {
   filter := mapper.MapMangaSearchQuery(query)
+  filter.ContentRatings = m.contentRatings(userId)
   res, err := m.mangaRepo.FindMangasByFilter(&filter, query.ToQueryParam())
   mangaResponses := containers.CastSlicePtr1(res.Data, m.fileService, mapper.ToMinimalMangaResponse)
   responsePage := appMapper.NewResponsePage(mangaResponses, res.Total, &query.PagedQueryInput)
@@ -92,8 +151,16 @@ func (m mangaService) FindMangaByIds(mangaIds ...string) ([]mangaDto.MangaRespon
   return responses, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
 }
 
-func (m mangaService) FindRandomMangas(limit uint64) ([]mangaDto.MinimalMangaResponse, status.Object) {
-  mangaList, err := m.mangaRepo.FindRandomMangas(limit)
+func (m mangaService) FindMangaBySlug(slug string) ([]mangaDto.MangaResponse, status.Object) {
+  mangaId, err := m.mangaRepo.FindMangaIdBySlug(slug)
+  if err != nil {
+    return nil, status.RepositoryError(err, opt.New(status.MANGA_NOT_FOUND))
+  }
+  return m.FindMangaByIds(mangaId)
+}
+
+func (m mangaService) FindRandomMangas(limit uint64, userId opt.Optional[string]) ([]mangaDto.MinimalMangaResponse, status.Object) {
+  mangaList, err := m.mangaRepo.FindRandomMangas(limit, m.contentRatings(userId))
   responses := containers.CastSlicePtr1(mangaList, m.fileService, mapper.ToMinimalMangaResponse)
   return responses, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
 }
@@ -116,34 +183,59 @@ func (m mangaService) CreateManga(input *mangaDto.MangaCreateInput) status.Objec
     return status.Error(status.BAD_REQUEST_ERROR)
   }
 
+  // Append number suffix when the slug is already used
+  used, err := m.mangaRepo.FindSimilarSlugs(model.Slug)
+  if err != nil {
+    return status.Error(status.INTERNAL_SERVER_ERROR)
+  }
+  model.Slug = mangas.NextAvailableSlug(model.Slug, used)
+
   err = m.mangaRepo.CreateManga(&model, genres)
   return status.ConditionalRepository(err, status.CREATED, opt.New(status.MANGA_CREATE_ALREADY_EXIST))
 }
 
-func (m mangaService) UpdateMangaCover(input *mangaDto.MangaCoverUpdateInput) status.Object {
+func (m mangaService) EditMangaDownload(input *mangaDto.MangaDownloadEditInput) status.Object {
+  err := m.mangaRepo.EditMangaDownload(input.MangaId, *input.Allowed)
+  return status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_NOT_FOUND))
+}
+
+func (m mangaService) EditMangaExport(input *mangaDto.MangaExportEditInput) status.Object {
+  err := m.mangaRepo.EditMangaExport(input.MangaId, *input.Allowed)
+  return status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_NOT_FOUND))
+}
+
+func (m mangaService) EditMangaSlug(input *mangaDto.MangaSlugEditInput) status.Object {
+  err := m.mangaRepo.UpdateMangaSlug(input.MangaId, input.Slug)
+  if errors.Is(err, mangas.ErrSlugAlreadyUsed) {
+    return status.Error(status.MANGA_SLUG_ALREADY_EXIST)
+  }
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.MANGA_NOT_FOUND), opt.New(status.MANGA_SLUG_ALREADY_EXIST))
+}
+
+func (m mangaService) UpdateMangaCover(input *mangaDto.MangaCoverUpdateInput) (commonDto.UploadResponse, status.Object) {
   manga, err := m.mangaRepo.FindMinimalMangaById(input.MangaId)
   if err != nil {
-    return status.RepositoryError(err, opt.New(status.MANGA_NOT_FOUND))
+    return commonDto.UploadResponse{}, status.RepositoryError(err, opt.New(status.MANGA_NOT_FOUND))
   }
 
   // Upload new cover image
-  filename, stat := m.fileService.Upload(file.CoverAsset, input.Image)
+  uploaded, stat := m.fileService.Upload(file.CoverAsset, input.Image)
   if stat.IsError() {
-    return stat
+    return commonDto.UploadResponse{}, stat
   }
 
   // Delete current cover image
   if len(manga.CoverURL) != 0 {
     stat = m.fileService.Delete(file.CoverAsset, manga.CoverURL)
     if stat.IsError() {
-      return stat
+      return commonDto.UploadResponse{}, stat
     }
   }
 
   // Update metadata
-  editedManga := mangas.Manga{Id: manga.Id, CoverURL: filename, UpdatedAt: time.Now()}
+  editedManga := mangas.Manga{Id: manga.Id, CoverURL: uploaded.Name, UpdatedAt: time.Now()}
   err = m.mangaRepo.PatchManga(&editedManga)
-  return status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_UPDATE_FAILED))
+  return appMapper.ToUploadResponse(uploaded), status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_UPDATE_FAILED))
 }
 
 func (m mangaService) EditManga(input *mangaDto.MangaEditInput) status.Object {
@@ -152,6 +244,15 @@ func (m mangaService) EditManga(input *mangaDto.MangaEditInput) status.Object {
     return status.Error(status.BAD_REQUEST_ERROR)
   }
 
+  // Keep the current content rating when it is not sent
+  if len(input.ContentRating) == 0 {
+    manga, err := m.mangaRepo.FindMinimalMangaById(input.MangaId)
+    if err != nil {
+      return status.RepositoryError(err, opt.New(status.MANGA_NOT_FOUND))
+    }
+    model.ContentRating = manga.ContentRating
+  }
+
   err = m.mangaRepo.EditManga(&model)
   return status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_UPDATE_FAILED))
 }
@@ -163,6 +264,23 @@ func (m mangaService) EditMangaGenres(input *mangaDto.MangaGenreEditInput) statu
   return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.MANGA_UPDATE_FAILED), opt.New(status.MANGA_UPDATE_FAILED))
 }
 
+func (m mangaService) EditMangaTags(input *mangaDto.MangaTagEditInput) status.Object {
+  additionals, removes := mapper.MapMangaTagEditInput(input)
+
+  err := m.mangaRepo.EditMangaTags(additionals, removes)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.MANGA_UPDATE_FAILED), opt.New(status.MANGA_UPDATE_FAILED))
+}
+
+func (m mangaService) EditMangaStaff(input *mangaDto.MangaStaffEditInput) status.Object {
+  additionals, removes, err := mapper.MapMangaStaffEditInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+
+  err = m.mangaRepo.EditMangaStaff(additionals, removes)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.MANGA_STAFF_UPDATE_FAILED), opt.New(status.MANGA_STAFF_UPDATE_FAILED))
+}
+
 func (m mangaService) InsertMangaTranslations(input *mangaDto.MangaTranslationInsertInput) status.Object {
   translates := mapper.MapInsertTranslateInput(input)
 
@@ -203,8 +321,53 @@ func (m mangaService) UpdateTranslation(input *mangaDto.TranslationEditInput) st
   return status.ConditionalRepository(err, status.UPDATED, opt.New(status.MANGA_TRANSLATION_UPDATE_FAILED))
 }
 
+func (m mangaService) InsertMangaAlternativeTitles(input *mangaDto.MangaAlternativeTitleInsertInput) status.Object {
+  titles, err := mapper.MapAlternativeTitleInsertInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+
+  err = m.altTitleRepo.Create(titles)
+  return status.ConditionalRepositoryE(err, status.CREATED, opt.New(status.MANGA_ALT_TITLE_ALREADY_EXIST), opt.New(status.MANGA_ALT_TITLE_CREATE_FAILED))
+}
+
+func (m mangaService) FindMangaAlternativeTitles(mangaId string) ([]mangaDto.AlternativeTitleResponse, status.Object) {
+  titles, err := m.altTitleRepo.FindByMangaId(mangaId)
+  responses := containers.CastSlicePtr(titles, mapper.ToAlternativeTitleResponse)
+  return responses, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
+
+func (m mangaService) DeleteMangaAlternativeTitles(input *mangaDto.MangaAlternativeTitleDeleteInput) status.Object {
+  err := m.altTitleRepo.DeleteMangaSpecific(input.MangaId, input.TitleIds)
+  return status.ConditionalRepository(err, status.DELETED, opt.New(status.MANGA_ALT_TITLE_NOT_FOUND))
+}
+
+func (m mangaService) CreateMangaRelation(input *mangaDto.MangaRelationCreateInput) status.Object {
+  relation, err := mapper.MapMangaRelationCreateInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+  if relation.IsSelfRelation() {
+    return status.Error(status.MANGA_RELATION_SELF_REFERENCE)
+  }
+
+  err = m.mangaRepo.CreateMangaRelation(&relation)
+  return status.ConditionalRepositoryE(err, status.CREATED, opt.New(status.MANGA_RELATION_CREATE_FAILED), opt.New(status.MANGA_RELATION_CREATE_FAILED))
+}
+
+func (m mangaService) DeleteMangaRelation(input *mangaDto.MangaRelationDeleteInput) status.Object {
+  err := m.mangaRepo.DeleteMangaRelation(input.MangaId, input.RelatedId)
+  return status.ConditionalRepository(err, status.DELETED, opt.New(status.MANGA_RELATION_NOT_FOUND))
+}
+
+func (m mangaService) FindMangaRelations(mangaId string) ([]mangaDto.MangaRelationResponse, status.Object) {
+  relations, err := m.mangaRepo.FindMangaRelations(mangaId)
+  responses := containers.CastSlicePtr1(relations, m.fileService, mapper.ToMangaRelationResponse)
+  return responses, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
+
 func (m mangaService) FindMangaHistories(userId string, query *commonDto.PagedQueryInput) ([]mangaDto.MangaHistoryResponse, *commonDto.ResponsePage, status.Object) {
-  res, err := m.mangaRepo.FindMangaHistories(userId, query.ToQueryParam())
+  res, err := m.mangaRepo.FindMangaHistories(userId, m.contentRatings(opt.New(userId)), query.ToQueryParam())
 
   responses := containers.CastSlicePtr1(res.Data, m.fileService, mapper.ToMangaHistoryResponse)
   pages := appMapper.NewResponsePage(responses, res.Total, query)
@@ -212,7 +375,7 @@ func (m mangaService) FindMangaHistories(userId string, query *commonDto.PagedQu
 }
 
 func (m mangaService) FindMangaFavorites(userId string, query *commonDto.PagedQueryInput) ([]mangaDto.MangaFavoriteResponse, *commonDto.ResponsePage, status.Object) {
-  res, err := m.mangaRepo.FindMangaFavorites(userId, query.ToQueryParam())
+  res, err := m.mangaRepo.FindMangaFavorites(userId, m.contentRatings(opt.New(userId)), query.ToQueryParam())
 
   responses := containers.CastSlicePtr1(res.Data, m.fileService, mapper.ToMangaFavoriteResponse)
   pages := appMapper.NewResponsePage(responses, res.Total, query)
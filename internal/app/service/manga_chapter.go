@@ -1,8 +1,14 @@
 package service
 
 import (
+	"bytes"
 	"database/sql"
 	"errors"
+	"fmt"
+	"github.com/uptrace/bun"
+	"io"
+	"log"
+	"manga-explorer/internal/common"
 	commonDto "manga-explorer/internal/common/dto"
 	commonMapper "manga-explorer/internal/common/mapper"
 	"manga-explorer/internal/common/status"
@@ -16,8 +22,14 @@ import (
 	repo "manga-explorer/internal/infrastructure/repository"
 	"manga-explorer/internal/util/containers"
 	"manga-explorer/internal/util/opt"
+	"mime/multipart"
+	"slices"
+	"strconv"
 )
 
+// errPageImageCommit the staged page images failed to be committed, the pages are rolled back
+var errPageImageCommit = errors.New("page images failed to be committed")
+
 func NewChapterService(fileService fileService.IFile, chapterRepo repository.IChapter, commentRepo repository.IComment) service.IChapter {
 	return &mangaChapterService{
 		fileService: fileService,
@@ -57,6 +69,13 @@ func (m mangaChapterService) DeleteChapter(chapterId string) status.Object {
 func (m mangaChapterService) FindChapterDetails(chapterId string, userId opt.Optional[string]) (dto.ChapterResponse, status.Object) {
 	chapter, err := m.chapterRepo.FindChapter(chapterId)
 	responses := mapper.ToChapterResponse(chapter, m.fileService)
+	// Navigation
+	if err == nil {
+		var prev, next *mangas.Chapter
+		prev, next, err = m.chapterRepo.FindSiblingChapters(chapter)
+		responses.Prev = mapper.ToChapterReferenceResponse(prev)
+		responses.Next = mapper.ToChapterReferenceResponse(next)
+	}
 	// Add chapter history
 	if userId.HasValue() && err == nil {
 		chapterHistory := mangas.NewChapterHistory(*userId.Value(), chapterId, opt.NullTime)
@@ -69,68 +88,494 @@ func (m mangaChapterService) FindChapterDetails(chapterId string, userId opt.Opt
 }
 
 func (m mangaChapterService) FindMangaChapterHistories(input *dto.MangaChapterHistoriesFindInput) ([]dto.ChapterResponse, *commonDto.ResponsePage, status.Object) {
-	chapterHistories, err := m.chapterRepo.FindMangaChapterHistories(input.UserId, input.MangaId, repo.QueryParameter{input.Offset(), input.Element})
+	chapterHistories, err := m.chapterRepo.FindMangaChapterHistories(input.UserId, input.MangaId, repo.QueryParameter{Offset: input.Offset(), Limit: input.Element})
 	page := commonMapper.NewResponsePage(chapterHistories.Data, chapterHistories.Total, &input.PagedQueryInput)
 	responses := containers.CastSlicePtr(chapterHistories.Data, mapper.ToMinimalChapterResponse)
 	return responses, &page, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
 }
 
+func (m mangaChapterService) FindMangaChapters(input *dto.MangaChaptersFindInput) ([]dto.ChapterResponse, *commonDto.ResponsePage, status.Object) {
+	filter, err := mapper.MapMangaChaptersFindInput(input)
+	if err != nil {
+		return nil, nil, status.Error(status.BAD_REQUEST_ERROR)
+	}
+	chapters, err := m.chapterRepo.FindMangaChapters(&filter, input.ToQueryParam())
+	page := commonMapper.NewResponsePage(chapters.Data, chapters.Total, &input.PagedQueryInput)
+	responses := containers.CastSlicePtr(chapters.Data, mapper.ToMinimalChapterResponse)
+	return responses, &page, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
+
 func (m mangaChapterService) CreateChapter(input *dto.ChapterCreateInput) status.Object {
-	chapter := mapper.MapChapterCreateInput(input)
-	err := m.chapterRepo.CreateChapter(&chapter)
-	return status.ConditionalRepository(err, status.CREATED, opt.New(status.CHAPTER_ALREADY_EXIST))
-}
-
-//func (m mangaChapterService) InsertChapterPage(input *dto.PageCreateInput) status.Object {
-//	fileHeaders := containers.CastSlicePtr(input.Page, func(current *dto.InternalPage) multipart.FileHeader {
-//		return *current.Image
-//	})
-//	filenames, stat := m.fileService.Uploads(file.MangaAsset, fileHeaders)
-//	if stat.IsError() {
-//		return stat
-//	}
-//
-//	pages := mapper.MapPageCreateInput(input, filenames)
-//	if pages == nil {
-//		return status.InternalError()
-//	}
-//
-//	err := m.chapterRepo.InsertChapterPages(pages)
-//	return status.ConditionalRepository(err, status.UPDATED, opt.New(status.PAGE_INSERT_FAILED))
-//}
-
-func (m mangaChapterService) InsertChapterPage(input *dto.PageCreateInput) (status.Object, []uint16) {
-	errorPages := []uint16{}
+	chapter, groups, err := mapper.MapChapterCreateInput(input)
+	if err != nil {
+		return status.Error(status.BAD_REQUEST_ERROR)
+	}
+	err = m.chapterRepo.CreateChapter(&chapter, groups)
+	if isChapterDuplicate(err) {
+		return status.Error(status.CHAPTER_ALREADY_EXIST)
+	}
+	return status.ConditionalRepositoryE(err, status.CREATED, opt.New(status.VOLUME_NOT_FOUND), opt.New(status.CHAPTER_ALREADY_EXIST))
+}
+
+// isChapterDuplicate check if the chapter already exists for the credited groups or as chapter without group
+func isChapterDuplicate(err error) bool {
+	return errors.Is(err, mangas.ErrGroupChapterDuplicate) || errors.Is(err, mangas.ErrChapterDuplicate)
+}
 
+func (m mangaChapterService) InsertChapterPage(input *dto.PageCreateInput) ([]dto.PageUploadResponse, []uint16, status.Object) {
+	pageNumbers := containers.CastSlicePtr(input.Pages, func(current *dto.InternalPage) uint16 {
+		return current.Number
+	})
+
+	// Read all images first, so nothing is uploaded when one of them is invalid
+	infos := make([]file.ImageInfo, 0, len(input.Pages))
+	errorPages := []uint16{}
 	for _, page := range input.Pages {
-		// Upload image
-		filename, stat := m.fileService.Upload(file.MangaAsset, page.Image)
-		if stat.IsError() {
+		_, _, info, err := readPageImage(page.Image)
+		if err != nil {
 			errorPages = append(errorPages, page.Number)
 			continue
 		}
+		infos = append(infos, info)
+	}
+	if len(errorPages) != 0 {
+		return nil, errorPages, status.Error(status.PAGE_INSERT_FAILED)
+	}
+
+	// Upload images, all of them are staged or none
+	fileHeaders := containers.CastSlicePtr(input.Pages, func(current *dto.InternalPage) multipart.FileHeader {
+		return *current.Image
+	})
+	staged, stat := m.fileService.Uploads(file.MangaAsset, fileHeaders)
+	if stat.IsError() {
+		return nil, pageNumbers, stat
+	}
+
+	uploads := staged.Infos()
+	pages := make([]mangas.Page, 0, len(input.Pages))
+	responses := make([]dto.PageUploadResponse, 0, len(input.Pages))
+	for i, current := range input.Pages {
+		page := mangas.NewPage(input.ChapterId, uploads[i].Name, current.Number)
+		page.SetImageInfo(infos[i])
+		page.SetUploadInfo(uploads[i])
+		pages = append(pages, page)
+		responses = append(responses, mapper.ToPageUploadResponse(page.Number, uploads[i]))
+	}
 
-		pages := mangas.NewPage(input.ChapterId, filename, page.Number)
+	stat = m.savePages(input.ChapterId, staged, func(commit func(tx bun.IDB) error) error {
+		return m.chapterRepo.InsertChapterPages(pages, commit)
+	}, func(err error) status.Object {
+		return status.RepositoryErrorE(err, opt.New(status.CHAPTER_NOT_FOUND), opt.New(status.PAGE_INSERT_FAILED))
+	})
+	if stat.IsError() {
+		return nil, pageNumbers, stat
+	}
+	return responses, nil, status.Success()
+}
 
-		err := m.chapterRepo.InsertChapterPages([]mangas.Page{pages})
+func (m mangaChapterService) InsertChapterArchive(input *dto.PageArchiveCreateInput) ([]dto.PageUploadResponse, status.Object) {
+	archive, err := file.OpenArchive(input.Archive)
+	if err != nil {
+		return nil, status.Error(status.PAGE_ARCHIVE_INVALID, err.Error())
+	}
+	defer archive.Close()
+
+	// Read all images first, so nothing is uploaded when one of them is invalid
+	sources := make([]file.Source, 0, len(archive.Images))
+	infos := make([]file.ImageInfo, 0, len(archive.Images))
+	for _, entry := range archive.Images {
+		data, format, err := archive.ReadImage(entry)
 		if err != nil {
-			m.fileService.Delete(file.MangaAsset, filename)
-			errorPages = append(errorPages, page.Number)
+			return nil, status.Error(status.PAGE_IMAGE_INVALID, entry.Name+": "+err.Error())
+		}
+		info, err := file.ReadImageInfo(data)
+		if err != nil {
+			return nil, status.Error(status.PAGE_IMAGE_INVALID, entry.Name+": "+err.Error())
+		}
+		sources = append(sources, file.Source{Format: format, Reader: bytes.NewReader(data)})
+		infos = append(infos, info)
+	}
+
+	staged, stat := m.fileService.UploadFiles(file.MangaAsset, sources)
+	if stat.IsError() {
+		return nil, stat
+	}
+
+	uploads := staged.Infos()
+	pages := make([]mangas.Page, 0, len(uploads))
+	responses := make([]dto.PageUploadResponse, 0, len(uploads))
+	for i, uploaded := range uploads {
+		page := mangas.NewPage(input.ChapterId, uploaded.Name, uint16(i+1))
+		page.SetImageInfo(infos[i])
+		page.SetUploadInfo(uploaded)
+		pages = append(pages, page)
+		responses = append(responses, mapper.ToPageUploadResponse(page.Number, uploaded))
+	}
+
+	stat = m.savePages(input.ChapterId, staged, func(commit func(tx bun.IDB) error) error {
+		return m.chapterRepo.InsertChapterPages(pages, commit)
+	}, func(err error) status.Object {
+		return status.RepositoryErrorE(err, opt.New(status.CHAPTER_NOT_FOUND), opt.New(status.PAGE_INSERT_FAILED))
+	})
+	if stat.IsError() {
+		return nil, stat
+	}
+	return responses, status.Created()
+}
+
+// savePages call save with the callback which commits the staged images inside the transaction of the pages, so the
+// pages are rolled back when the images failed to be committed, the pages can't be shown without the images. The
+// staged images are rolled back when the pages failed before the images are committed, the error is mapped by
+// repositoryError.
+func (m mangaChapterService) savePages(chapterId string, staged file.IStaged, save func(commit func(tx bun.IDB) error) error, repositoryError func(err error) status.Object) status.Object {
+	committed := false
+	stat := status.Success()
+	err := save(func(tx bun.IDB) error {
+		committed = true
+		stat = staged.Commit(tx)
+		if stat.IsError() {
+			return errPageImageCommit
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+		return status.Success()
+	case !committed:
+		staged.Rollback()
+		return repositoryError(err)
+	case stat.IsError():
+		return stat
+	}
+
+	// The references of the images are rolled back with the pages, the images left are collected later by gc
+	log.Printf("Failed to commit pages of chapter %s: %s\n", chapterId, err)
+	return status.InternalError()
+}
+
+// readPageImage read the uploaded page image and extract the metadata, the format is taken from the filename
+func readPageImage(header *multipart.FileHeader) ([]byte, file.Format, file.ImageInfo, error) {
+	format, err := file.ParseFileFormat(header.Filename)
+	if err != nil {
+		return nil, file.FormatUnknown, file.ImageInfo{}, err
+	}
+
+	src, err := header.Open()
+	if err != nil {
+		return nil, file.FormatUnknown, file.ImageInfo{}, err
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, file.FormatUnknown, file.ImageInfo{}, err
+	}
+	info, err := file.ReadImageInfo(data)
+	return data, format, info, err
+}
+
+// deletePages delete the images of the pages, used to clean up the images which are no longer used by the pages. The
+// images failed to be deleted are only logged, they are collected later by gc
+func (m mangaChapterService) deletePages(pages []mangas.Page) {
+	for _, page := range pages {
+		if stat := m.fileService.Delete(file.MangaAsset, page.ImageURL); stat.IsError() {
+			log.Printf("Failed to delete page image %s: %s\n", page.ImageURL, stat.ErrorMessage())
+		}
+	}
+}
+
+func (m mangaChapterService) DownloadChapter(chapterId string) (commonDto.FileResponse, status.Object) {
+	chapter, err := m.chapterRepo.FindChapterArchive(chapterId)
+	if err != nil {
+		return commonDto.FileResponse{}, status.RepositoryError(err, opt.New(status.CHAPTER_NOT_FOUND))
+	}
+	if !chapter.Manga.AllowDownload {
+		return commonDto.FileResponse{}, status.Error(status.DOWNLOAD_DISABLED)
+	}
+
+	return commonDto.FileResponse{
+		Filename:    chapterArchiveName(chapter) + ".cbz",
+		ContentType: "application/vnd.comicbook+zip",
+		Write: func(writer io.Writer) error {
+			return m.writeChapterArchive(writer, chapter)
+		},
+	}, status.Success()
+}
+
+func (m mangaChapterService) DownloadVolume(volumeId string) (commonDto.FileResponse, status.Object) {
+	volume, err := m.chapterRepo.FindVolumeArchive(volumeId)
+	if err != nil {
+		return commonDto.FileResponse{}, status.RepositoryError(err, opt.New(status.VOLUME_NOT_FOUND))
+	}
+	if !volume.Manga.AllowDownload {
+		return commonDto.FileResponse{}, status.Error(status.DOWNLOAD_DISABLED)
+	}
+
+	return commonDto.FileResponse{
+		Filename:    file.SanitizeFilename(fmt.Sprintf("%s - Vol. %d", volume.Manga.OriginalTitle, volume.Number)) + ".zip",
+		ContentType: "application/zip",
+		Write: func(writer io.Writer) error {
+			archive := file.NewArchiveWriter(writer)
+			usedNames := map[string]int{}
+			for i := range volume.Chapters {
+				chapter := &volume.Chapters[i]
+				chapter.Manga = volume.Manga
+				chapter.Volume = volume
+
+				// The same chapter could be translated into different languages or by different translators
+				name := chapterArchiveName(chapter)
+				usedNames[name]++
+				if count := usedNames[name]; count > 1 {
+					name = fmt.Sprintf("%s (%d)", name, count)
+				}
+
+				entry, err := archive.Create(name + ".cbz")
+				if err != nil {
+					return err
+				}
+				if err = m.writeChapterArchive(entry, chapter); err != nil {
+					return err
+				}
+			}
+			return archive.Close()
+		},
+	}, status.Success()
+}
+
+// writeChapterArchive write CBZ archive of the chapter, the pages are named by the number, so they are sorted correctly
+func (m mangaChapterService) writeChapterArchive(writer io.Writer, chapter *mangas.Chapter) error {
+	archive := file.NewArchiveWriter(writer)
+
+	info := mangas.NewComicInfo(chapter)
+	data, err := info.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = archive.Add(mangas.ComicInfoFilename, bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	width := max(3, len(strconv.Itoa(len(chapter.Pages))))
+	for _, page := range chapter.Pages {
+		src, stat := m.fileService.Open(file.MangaAsset, page.ImageURL)
+		if stat.IsError() {
+			return errors.New(stat.ErrorMessage())
+		}
+
+		format, _ := file.ParseFileFormat(page.ImageURL.String())
+		name := format.Filename(fmt.Sprintf("%0*d", width, page.Number))
+		err = archive.Add(name.String(), src)
+		src.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// chapterArchiveName create filename for the chapter archive without the extension, e.g. "Title - Ch. 10.5 [eng]"
+func chapterArchiveName(chapter *mangas.Chapter) string {
+	number := "Ch. " + chapter.NumberString()
+	if len(chapter.Label) != 0 {
+		number = chapter.Label
+	}
+
+	title := ""
+	if chapter.Manga != nil {
+		title = chapter.Manga.OriginalTitle + " - "
+	}
+	return file.SanitizeFilename(fmt.Sprintf("%s%s [%s]", title, number, chapter.Language))
+}
+
+func (m mangaChapterService) ExportChapter(input *dto.ChapterExportInput) (commonDto.FileResponse, status.Object) {
+	chapter, err := m.chapterRepo.FindChapterArchive(input.ChapterId)
+	if err != nil {
+		return commonDto.FileResponse{}, status.RepositoryError(err, opt.New(status.CHAPTER_NOT_FOUND))
+	}
+	if !chapter.Manga.AllowExport {
+		return commonDto.FileResponse{}, status.Error(status.EXPORT_DISABLED)
+	}
+
+	lang := chapter.Language
+	if len(input.Language) != 0 {
+		lang = input.Language.ParseLang()
+	}
+	number := "Ch. " + chapter.NumberString()
+	if len(chapter.Label) != 0 {
+		number = chapter.Label
+	}
+	metadata := epubMetadata(chapter.Manga, chapter.Id, lang, number)
+
+	return commonDto.FileResponse{
+		Filename:    file.SanitizeFilename(metadata.Title) + ".epub",
+		ContentType: "application/epub+zip",
+		Write: func(writer io.Writer) error {
+			epub, err := file.NewEpubWriter(writer, metadata)
+			if err != nil {
+				return err
+			}
+			if err = m.writeEpubCover(epub, chapter.Manga); err != nil {
+				return err
+			}
+			if err = m.writeEpubPages(epub, chapter); err != nil {
+				return err
+			}
+			return epub.Close()
+		},
+	}, status.Success()
+}
+
+func (m mangaChapterService) ExportVolume(input *dto.VolumeExportInput) (commonDto.FileResponse, status.Object) {
+	volume, err := m.chapterRepo.FindVolumeArchive(input.VolumeId)
+	if err != nil {
+		return commonDto.FileResponse{}, status.RepositoryError(err, opt.New(status.VOLUME_NOT_FOUND))
+	}
+	if !volume.Manga.AllowExport {
+		return commonDto.FileResponse{}, status.Error(status.EXPORT_DISABLED)
+	}
+
+	// Only use single chapter for each number, because the same chapter could be translated into different languages
+	// or by different translators. The chapters are already sorted by the number and creation time.
+	lang := input.Language.ParseLang()
+	chapters := make([]*mangas.Chapter, 0, len(volume.Chapters))
+	for i := range volume.Chapters {
+		chapter := &volume.Chapters[i]
+		if len(lang) != 0 && chapter.Language != lang {
+			continue
+		}
+		if len(chapters) != 0 && chapters[len(chapters)-1].Number == chapter.Number {
+			continue
 		}
+		chapters = append(chapters, chapter)
 	}
+	if len(chapters) == 0 {
+		return commonDto.FileResponse{}, status.Error(status.CHAPTER_NOT_FOUND)
+	}
+	if len(lang) == 0 {
+		lang = chapters[0].Language
+	}
+	metadata := epubMetadata(volume.Manga, volume.Id, lang, fmt.Sprintf("Vol. %d", volume.Number))
+
+	return commonDto.FileResponse{
+		Filename:    file.SanitizeFilename(metadata.Title) + ".epub",
+		ContentType: "application/epub+zip",
+		Write: func(writer io.Writer) error {
+			epub, err := file.NewEpubWriter(writer, metadata)
+			if err != nil {
+				return err
+			}
+			if err = m.writeEpubCover(epub, volume.Manga); err != nil {
+				return err
+			}
+			for _, chapter := range chapters {
+				title := "Chapter " + chapter.NumberString()
+				if len(chapter.Title) != 0 {
+					title += ": " + chapter.Title
+				}
+				epub.AddSection(title)
+				if err = m.writeEpubPages(epub, chapter); err != nil {
+					return err
+				}
+			}
+			return epub.Close()
+		},
+	}, status.Success()
+}
 
-	if len(errorPages) == 0 {
-		return status.Success(), nil
+// writeEpubCover add the manga cover into the book, manga without cover is ignored
+func (m mangaChapterService) writeEpubCover(epub *file.EpubWriter, manga *mangas.Manga) error {
+	if len(manga.CoverURL) == 0 {
+		return nil
+	}
+	src, stat := m.fileService.Open(file.CoverAsset, manga.CoverURL)
+	if stat.IsError() {
+		// The book is still usable without the cover
+		return nil
 	}
-	return status.RepositoryError(errors.New("failed to insert all of pages"), opt.New(status.PAGE_INSERT_FAILED)), errorPages
+	defer src.Close()
+
+	format, _ := file.ParseFileFormat(manga.CoverURL.String())
+	return epub.SetCover(format, src)
+}
+
+func (m mangaChapterService) writeEpubPages(epub *file.EpubWriter, chapter *mangas.Chapter) error {
+	for _, page := range chapter.Pages {
+		src, stat := m.fileService.Open(file.MangaAsset, page.ImageURL)
+		if stat.IsError() {
+			return errors.New(stat.ErrorMessage())
+		}
+
+		format, _ := file.ParseFileFormat(page.ImageURL.String())
+		err := epub.AddPage(format, src)
+		src.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// epubMetadata create the book metadata, the title uses the manga translation in the language when it is available,
+// e.g. "Title - Vol. 2"
+func epubMetadata(manga *mangas.Manga, id string, lang common.Language, suffix string) file.EpubMetadata {
+	title := manga.OriginalTitle
+	description := manga.OriginalDescription
+	if translation := manga.FindTranslation(lang); translation != nil {
+		title = translation.Title
+		description = translation.Description
+	}
+
+	metadata := file.EpubMetadata{
+		Id:          id,
+		Title:       title + " - " + suffix,
+		Language:    lang.Short(),
+		Description: description,
+	}
+	for _, staff := range manga.Staff {
+		if staff.Person == nil || slices.Contains(metadata.Creators, staff.Person.Name) {
+			continue
+		}
+		switch staff.Role {
+		case mangas.StaffRoleStory, mangas.StaffRoleArt, mangas.StaffRoleOriginalCreator:
+			metadata.Creators = append(metadata.Creators, staff.Person.Name)
+		}
+	}
+	for _, genre := range manga.Genres {
+		metadata.Subjects = append(metadata.Subjects, genre.Name)
+	}
+	return metadata
 }
 
 func (m mangaChapterService) EditChapter(input *dto.ChapterEditInput) status.Object {
-	chapter := mapper.MapChapterEditInput(input)
-	err := m.chapterRepo.EditChapter(&chapter)
+	chapter, err := mapper.MapChapterEditInput(input)
+	if err != nil {
+		return status.Error(status.BAD_REQUEST_ERROR)
+	}
+	err = m.chapterRepo.EditChapter(&chapter)
+	if isChapterDuplicate(err) {
+		return status.Error(status.CHAPTER_ALREADY_EXIST)
+	}
 	return status.ConditionalRepository(err, status.UPDATED, opt.New(status.CHAPTER_UPDATE_FAILED))
 }
 
+func (m mangaChapterService) EditChapterGroups(input *dto.ChapterGroupEditInput) status.Object {
+	additionals, removes := mapper.MapChapterGroupEditInput(input)
+
+	err := m.chapterRepo.EditChapterGroups(additionals, removes)
+	if isChapterDuplicate(err) {
+		return status.Error(status.CHAPTER_ALREADY_EXIST)
+	}
+	return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.CHAPTER_UPDATE_FAILED), opt.New(status.CHAPTER_UPDATE_FAILED))
+}
+
+func (m mangaChapterService) MoveChapters(input *dto.ChapterMoveInput) status.Object {
+	err := m.chapterRepo.MoveChapters(input.MangaId, input.VolumeId, input.ChapterIds)
+	if isChapterDuplicate(err) {
+		return status.Error(status.CHAPTER_ALREADY_EXIST)
+	}
+	return status.ConditionalRepository(err, status.UPDATED, opt.New(status.CHAPTER_MOVE_FAILED))
+}
+
 func (m mangaChapterService) CreateChapterComment(input *dto.ChapterCommentCreateInput) status.Object {
 	comment := mapper.MapChapterCommentCreateInput(input)
 	if input.HasParent() {
@@ -190,6 +635,46 @@ func (m mangaChapterService) DeleteChapterPages(input *dto.PageDeleteInput) stat
 	return status.ConditionalRepository(err, status.DELETED, opt.New(status.PAGE_NOT_FOUND))
 }
 
+func (m mangaChapterService) ReorderChapterPages(input *dto.PageReorderInput) status.Object {
+	err := m.chapterRepo.ReorderChapterPages(input.ChapterId, input.PageIds)
+	if errors.Is(err, mangas.ErrPageOrderMismatch) {
+		return status.Error(status.PAGE_ORDER_INVALID)
+	}
+	return status.ConditionalRepository(err, status.UPDATED, opt.New(status.PAGE_NOT_FOUND))
+}
+
+func (m mangaChapterService) ReplaceChapterPage(input *dto.PageReplaceInput) (dto.PageUploadResponse, status.Object) {
+	data, format, info, err := readPageImage(input.Image)
+	if err != nil {
+		return dto.PageUploadResponse{}, status.Error(status.PAGE_IMAGE_INVALID, err.Error())
+	}
+
+	staged, stat := m.fileService.UploadFiles(file.MangaAsset, []file.Source{{Format: format, Reader: bytes.NewReader(data)}})
+	if stat.IsError() {
+		return dto.PageUploadResponse{}, stat
+	}
+
+	uploaded := staged.Infos()[0]
+	page := mangas.NewPage(input.ChapterId, uploaded.Name, input.Number)
+	page.SetImageInfo(info)
+	page.SetUploadInfo(uploaded)
+	var previous *mangas.Page
+	stat = m.savePages(input.ChapterId, staged, func(commit func(tx bun.IDB) error) error {
+		var err error
+		previous, err = m.chapterRepo.ReplaceChapterPage(&page, commit)
+		return err
+	}, func(err error) status.Object {
+		return status.RepositoryError(err, opt.New(status.PAGE_NOT_FOUND))
+	})
+	if stat.IsError() {
+		return dto.PageUploadResponse{}, stat
+	}
+
+	// The previous image is no longer used
+	m.deletePages([]mangas.Page{*previous})
+	return mapper.ToPageUploadResponse(page.Number, uploaded), status.Updated()
+}
+
 func (m mangaChapterService) FindVolumeDetails(volumeId string) (dto.VolumeResponse, status.Object) {
 	chapters, err := m.chapterRepo.FindVolumeDetails(volumeId)
 	if err != nil {
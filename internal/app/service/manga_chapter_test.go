@@ -0,0 +1,214 @@
+package service
+
+import (
+  "bytes"
+  "database/sql"
+  "errors"
+  "fmt"
+  "github.com/google/uuid"
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/mock"
+  "github.com/stretchr/testify/require"
+  "github.com/uptrace/bun"
+  "image"
+  "image/png"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+  chapterRepoMock "manga-explorer/internal/domain/mangas/repository/mocks"
+  "manga-explorer/internal/infrastructure/file"
+  fileServiceMock "manga-explorer/internal/infrastructure/file/service/mocks"
+  "mime/multipart"
+  "testing"
+)
+
+// fakeStaged staged files which records whether it is committed or rolled back
+type fakeStaged struct {
+  infos      []file.UploadInfo
+  commitStat status.Object
+  committed  bool
+  rolledBack bool
+}
+
+func (f *fakeStaged) Infos() []file.UploadInfo {
+  return f.infos
+}
+
+func (f *fakeStaged) Commit(tx bun.IDB) status.Object {
+  f.committed = true
+  return f.commitStat
+}
+
+func (f *fakeStaged) Rollback() {
+  if !f.committed {
+    f.rolledBack = true
+  }
+}
+
+func testPageInput(t *testing.T, chapterId string, count int) (*dto.PageCreateInput, []file.UploadInfo) {
+  body := &bytes.Buffer{}
+  writer := multipart.NewWriter(body)
+  for i := 0; i < count; i++ {
+    part, err := writer.CreateFormFile("image", fmt.Sprintf("%d.png", i+1))
+    require.NoError(t, err)
+    require.NoError(t, png.Encode(part, image.NewGray(image.Rect(0, 0, 4, 6))))
+  }
+  require.NoError(t, writer.Close())
+  form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+  require.NoError(t, err)
+  t.Cleanup(func() { form.RemoveAll() })
+
+  input := &dto.PageCreateInput{ChapterId: chapterId}
+  infos := make([]file.UploadInfo, 0, count)
+  for i, header := range form.File["image"] {
+    input.Pages = append(input.Pages, dto.InternalPage{Number: uint16(i + 1), Image: header})
+    infos = append(infos, file.UploadInfo{Name: file.Name(fmt.Sprintf("%d.png", i+1)), Format: file.FormatPNG})
+  }
+  return input, infos
+}
+
+func Test_mangaChapterService_InsertChapterPage(t *testing.T) {
+  errCommit := errors.New("commit failed")
+
+  tests := []struct {
+    name string
+    // insert simulate the repository, commit is the callback of the service
+    insert       func(commit func(tx bun.IDB) error) error
+    commitStat   status.Object
+    wantStat     status.Object
+    wantCommit   bool
+    wantRollback bool
+  }{
+    {
+      name: "Normal",
+      insert: func(commit func(tx bun.IDB) error) error {
+        return commit(nil)
+      },
+      commitStat: status.Created(),
+      wantStat:   status.Success(),
+      wantCommit: true,
+    },
+    {
+      name: "Chapter not found",
+      insert: func(commit func(tx bun.IDB) error) error {
+        return sql.ErrNoRows
+      },
+      commitStat:   status.Created(),
+      wantStat:     status.Error(status.CHAPTER_NOT_FOUND),
+      wantRollback: true,
+    },
+    {
+      name: "Image commit failed",
+      insert: func(commit func(tx bun.IDB) error) error {
+        // The transaction is rolled back with the error of the callback
+        return commit(nil)
+      },
+      commitStat: status.InternalError(),
+      wantStat:   status.InternalError(),
+      wantCommit: true,
+    },
+    {
+      name: "Database commit failed",
+      insert: func(commit func(tx bun.IDB) error) error {
+        if err := commit(nil); err != nil {
+          return err
+        }
+        return errCommit
+      },
+      // The references of the images are rolled back with the pages
+      commitStat: status.Created(),
+      wantStat:   status.InternalError(),
+      wantCommit: true,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      input, infos := testPageInput(t, uuid.NewString(), 2)
+      staged := &fakeStaged{infos: infos, commitStat: tt.commitStat}
+
+      mockedFileService := fileServiceMock.NewFileMock(t)
+      mockedFileService.EXPECT().Uploads(file.MangaAsset, mock.Anything).Return(staged, status.Success())
+
+      mockedChapterRepo := chapterRepoMock.NewChapterMock(t)
+      mockedChapterRepo.EXPECT().InsertChapterPages(mock.Anything, mock.Anything).
+        RunAndReturn(func(pages []mangas.Page, commit func(tx bun.IDB) error) error {
+          require.Len(t, pages, len(infos))
+          return tt.insert(commit)
+        })
+
+      m := NewChapterService(mockedFileService, mockedChapterRepo, nil)
+      responses, errorPages, stat := m.InsertChapterPage(input)
+      assert.Equal(t, tt.wantStat.Code, stat.Code)
+      assert.Equal(t, tt.wantCommit, staged.committed)
+      assert.Equal(t, tt.wantRollback, staged.rolledBack)
+      if tt.wantStat.IsError() {
+        assert.Nil(t, responses)
+        assert.Equal(t, []uint16{1, 2}, errorPages)
+      } else {
+        assert.Len(t, responses, len(infos))
+        assert.Empty(t, errorPages)
+      }
+    })
+  }
+}
+
+func Test_mangaChapterService_ReplaceChapterPage(t *testing.T) {
+  previous := &mangas.Page{ImageURL: "previous.png"}
+
+  tests := []struct {
+    name string
+    // replace simulate the repository, commit is the callback of the service
+    replace      func(commit func(tx bun.IDB) error) (*mangas.Page, error)
+    wantStat     status.Object
+    wantCommit   bool
+    wantRollback bool
+    wantDeleted  bool
+  }{
+    {
+      name: "Normal",
+      replace: func(commit func(tx bun.IDB) error) (*mangas.Page, error) {
+        return previous, commit(nil)
+      },
+      wantStat:    status.Updated(),
+      wantCommit:  true,
+      wantDeleted: true,
+    },
+    {
+      name: "Page not found",
+      replace: func(commit func(tx bun.IDB) error) (*mangas.Page, error) {
+        return nil, sql.ErrNoRows
+      },
+      wantStat:     status.Error(status.PAGE_NOT_FOUND),
+      wantRollback: true,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      input, infos := testPageInput(t, uuid.NewString(), 1)
+      staged := &fakeStaged{infos: infos, commitStat: status.Created()}
+
+      mockedFileService := fileServiceMock.NewFileMock(t)
+      mockedFileService.EXPECT().UploadFiles(file.MangaAsset, mock.Anything).Return(staged, status.Success())
+      if tt.wantDeleted {
+        mockedFileService.EXPECT().Delete(file.MangaAsset, previous.ImageURL).Return(status.Deleted()).Once()
+      }
+
+      mockedChapterRepo := chapterRepoMock.NewChapterMock(t)
+      mockedChapterRepo.EXPECT().ReplaceChapterPage(mock.Anything, mock.Anything).
+        RunAndReturn(func(page *mangas.Page, commit func(tx bun.IDB) error) (*mangas.Page, error) {
+          assert.Equal(t, infos[0].Name, page.ImageURL)
+          return tt.replace(commit)
+        })
+
+      m := NewChapterService(mockedFileService, mockedChapterRepo, nil)
+      _, stat := m.ReplaceChapterPage(&dto.PageReplaceInput{
+        ChapterId: input.ChapterId,
+        Number:    1,
+        Image:     input.Pages[0].Image,
+      })
+      assert.Equal(t, tt.wantStat.Code, stat.Code)
+      assert.Equal(t, tt.wantCommit, staged.committed)
+      assert.Equal(t, tt.wantRollback, staged.rolledBack)
+    })
+  }
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+  commonDto "manga-explorer/internal/common/dto"
+  appMapper "manga-explorer/internal/common/mapper"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/mapper"
+  "manga-explorer/internal/domain/mangas/repository"
+  "manga-explorer/internal/domain/mangas/service"
+  fileService "manga-explorer/internal/infrastructure/file/service"
+  "manga-explorer/internal/util/containers"
+  "manga-explorer/internal/util/opt"
+)
+
+func NewGroupService(fileService fileService.IFile, groupRepo repository.IGroup) service.IGroup {
+  return &mangaGroupService{fileService: fileService, groupRepo: groupRepo}
+}
+
+type mangaGroupService struct {
+  fileService fileService.IFile
+  groupRepo   repository.IGroup
+}
+
+func (m mangaGroupService) CreateGroup(input *dto.GroupCreateInput) status.Object {
+  group := mapper.MapGroupCreateInput(input)
+  err := m.groupRepo.CreateGroup(&group)
+  return status.ConditionalRepositoryE(err, status.CREATED, opt.New(status.GROUP_UPDATE_FAILED), opt.New(status.GROUP_ALREADY_EXIST))
+}
+
+func (m mangaGroupService) UpdateGroup(input *dto.GroupEditInput) status.Object {
+  group := mapper.MapGroupEditInput(input)
+  err := m.groupRepo.UpdateGroup(&group)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.GROUP_NOT_FOUND), opt.New(status.GROUP_ALREADY_EXIST))
+}
+
+func (m mangaGroupService) DeleteGroup(groupId string) status.Object {
+  err := m.groupRepo.DeleteGroupById(groupId)
+  return status.ConditionalRepository(err, status.DELETED, opt.New(status.GROUP_NOT_FOUND))
+}
+
+func (m mangaGroupService) FindGroupById(groupId string) (dto.GroupResponse, status.Object) {
+  group, err := m.groupRepo.FindGroupById(groupId)
+  if err != nil {
+    return dto.GroupResponse{}, status.RepositoryError(err, opt.New(status.GROUP_NOT_FOUND))
+  }
+  return mapper.ToGroupResponse(group), status.Success()
+}
+
+func (m mangaGroupService) ListGroups(input *dto.GroupListInput) ([]dto.GroupResponse, *commonDto.ResponsePage, status.Object) {
+  result, err := m.groupRepo.ListGroups(input.Name, input.ToQueryParam())
+  responses := containers.CastSlicePtr(result.Data, mapper.ToGroupResponse)
+  responsePage := appMapper.NewResponsePage(responses, result.Total, &input.PagedQueryInput)
+  return responses, &responsePage, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
+
+func (m mangaGroupService) EditGroupMembers(input *dto.GroupMemberEditInput) status.Object {
+  additionals, removes, err := mapper.MapGroupMemberEditInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+
+  err = m.groupRepo.EditGroupMembers(additionals, removes)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.GROUP_UPDATE_FAILED), opt.New(status.GROUP_UPDATE_FAILED))
+}
+
+func (m mangaGroupService) FindGroupReleases(input *dto.GroupReleaseInput) ([]dto.ChapterResponse, *commonDto.ResponsePage, status.Object) {
+  result, err := m.groupRepo.FindGroupChapters(input.GroupId, input.ToQueryParam())
+  responses := containers.CastSlicePtr1(result.Data, m.fileService, mapper.ToChapterResponse)
+  responsePage := appMapper.NewResponsePage(responses, result.Total, &input.PagedQueryInput)
+  return responses, &responsePage, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
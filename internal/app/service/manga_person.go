@@ -0,0 +1,53 @@
+package service
+
+import (
+  commonDto "manga-explorer/internal/common/dto"
+  appMapper "manga-explorer/internal/common/mapper"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/mapper"
+  "manga-explorer/internal/domain/mangas/repository"
+  "manga-explorer/internal/domain/mangas/service"
+  "manga-explorer/internal/util/containers"
+  "manga-explorer/internal/util/opt"
+)
+
+func NewPersonService(personRepo repository.IPerson) service.IPerson {
+  return &mangaPersonService{personRepo: personRepo}
+}
+
+type mangaPersonService struct {
+  personRepo repository.IPerson
+}
+
+func (m mangaPersonService) CreatePerson(input *dto.PersonCreateInput) status.Object {
+  person := mapper.MapPersonCreateInput(input)
+  err := m.personRepo.CreatePerson(&person)
+  return status.ConditionalRepository(err, status.CREATED, opt.New(status.PERSON_UPDATE_FAILED))
+}
+
+func (m mangaPersonService) UpdatePerson(input *dto.PersonEditInput) status.Object {
+  person := mapper.MapPersonEditInput(input)
+  err := m.personRepo.UpdatePerson(&person)
+  return status.ConditionalRepository(err, status.UPDATED, opt.New(status.PERSON_NOT_FOUND))
+}
+
+func (m mangaPersonService) DeletePerson(personId string) status.Object {
+  err := m.personRepo.DeletePersonById(personId)
+  return status.ConditionalRepository(err, status.DELETED, opt.New(status.PERSON_NOT_FOUND))
+}
+
+func (m mangaPersonService) FindPersonById(personId string) (dto.PersonResponse, status.Object) {
+  person, err := m.personRepo.FindPersonById(personId)
+  if err != nil {
+    return dto.PersonResponse{}, status.RepositoryError(err, opt.New(status.PERSON_NOT_FOUND))
+  }
+  return mapper.ToPersonResponse(person), status.Success()
+}
+
+func (m mangaPersonService) ListPeople(input *dto.PersonListInput) ([]dto.PersonResponse, *commonDto.ResponsePage, status.Object) {
+  result, err := m.personRepo.ListPeople(input.Name, input.ToQueryParam())
+  responses := containers.CastSlicePtr(result.Data, mapper.ToPersonResponse)
+  responsePage := appMapper.NewResponsePage(responses, result.Total, &input.PagedQueryInput)
+  return responses, &responsePage, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/domain/mangas/mapper"
+  "manga-explorer/internal/domain/mangas/repository"
+  "manga-explorer/internal/domain/mangas/service"
+  "manga-explorer/internal/util/containers"
+  "manga-explorer/internal/util/opt"
+)
+
+func NewTagService(tagRepo repository.ITag) service.ITag {
+  return &mangaTagService{tagRepo: tagRepo}
+}
+
+type mangaTagService struct {
+  tagRepo repository.ITag
+}
+
+func (m mangaTagService) CreateTag(input *dto.TagCreateInput) status.Object {
+  tag, err := mapper.MapTagCreateInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+
+  err = m.tagRepo.CreateTag(&tag)
+  return status.ConditionalRepository(err, status.CREATED, opt.New(status.TAG_ALREADY_EXIST))
+}
+
+func (m mangaTagService) DeleteTag(tagId string) status.Object {
+  err := m.tagRepo.DeleteTagById(tagId)
+  return status.ConditionalRepository(err, status.DELETED, opt.New(status.TAG_NOT_FOUND))
+}
+
+func (m mangaTagService) UpdateTag(input *dto.TagEditInput) status.Object {
+  tag, err := mapper.MapTagUpdateInput(input)
+  if err != nil {
+    return status.Error(status.BAD_REQUEST_ERROR)
+  }
+
+  err = m.tagRepo.UpdateTag(&tag)
+  return status.ConditionalRepositoryE(err, status.UPDATED, opt.New(status.TAG_NOT_FOUND), opt.New(status.TAG_ALREADY_EXIST))
+}
+
+func (m mangaTagService) ListTag() ([]dto.TagResponse, status.Object) {
+  tags, err := m.tagRepo.ListTags()
+  tagResponses := containers.CastSlicePtr(tags, mapper.ToTagResponse)
+  return tagResponses, status.ConditionalRepository(err, status.SUCCESS, opt.New(status.SUCCESS))
+}
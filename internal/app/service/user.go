@@ -4,6 +4,8 @@ import (
   "fmt"
   "log"
   "manga-explorer/internal/common"
+  commonDto "manga-explorer/internal/common/dto"
+  commonMapper "manga-explorer/internal/common/mapper"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/domain/users"
   "manga-explorer/internal/domain/users/dto"
@@ -33,36 +35,36 @@ type userService struct {
   fileService  fileService.IFile
 }
 
-func (u userService) UpdateProfileImage(input *dto.ProfileImageUpdateInput) status.Object {
+func (u userService) UpdateProfileImage(input *dto.ProfileImageUpdateInput) (commonDto.UploadResponse, status.Object) {
   // Get user profile
   profiles, err := u.repo.FindUserProfiles(input.UserId)
   if err != nil {
-    return status.RepositoryError(err, opt.New(status.PROFILE_NOT_FOUND))
+    return commonDto.UploadResponse{}, status.RepositoryError(err, opt.New(status.PROFILE_NOT_FOUND))
   }
 
   // Upload new image
-  filename, stat := u.fileService.Upload(file.ProfileAsset, input.Image)
+  uploaded, stat := u.fileService.Upload(file.ProfileAsset, input.Image)
   if stat.IsError() {
-    return stat
+    return commonDto.UploadResponse{}, stat
   }
 
   // Delete old image
   if len(profiles.PhotoURL) > 0 {
     stat = u.fileService.Delete(file.ProfileAsset, profiles.PhotoURL)
     if stat.IsError() {
-      u.fileService.Delete(file.ProfileAsset, filename) // Delete uploaded image due to deletion error
-      return stat
+      u.fileService.Delete(file.ProfileAsset, uploaded.Name) // Delete uploaded image due to deletion error
+      return commonDto.UploadResponse{}, stat
     }
   }
 
   // Set metadata
-  profile := users.Profile{Id: profiles.Id, PhotoURL: filename, UpdatedAt: time.Now()}
+  profile := users.Profile{Id: profiles.Id, PhotoURL: uploaded.Name, UpdatedAt: time.Now()}
   err = u.repo.UpdateProfile(&profile)
   if err != nil {
-    u.fileService.Delete(file.ProfileAsset, filename)
-    return status.RepositoryError(err, opt.New(status.PROFILE_UPDATE_FAILED))
+    u.fileService.Delete(file.ProfileAsset, uploaded.Name)
+    return commonDto.UploadResponse{}, status.RepositoryError(err, opt.New(status.PROFILE_UPDATE_FAILED))
   }
-  return status.Updated()
+  return commonMapper.ToUploadResponse(uploaded), status.Updated()
 }
 
 func (u userService) DeleteProfileImage(userId string) status.Object {
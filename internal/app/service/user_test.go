@@ -580,7 +580,7 @@ func Test_userService_RegisterUser(t *testing.T) {
   mockedAuthService := userServiceMock.NewAuthenticationMock(t)
   mockedMailService := mailServiceMock.NewMailMock(t)
 
-  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(nil)
+  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(status.Success())
 
   mockedFileService := fileServiceMock.NewFileMock(t)
 
@@ -644,7 +644,7 @@ func Test_userService_RequestEmailVerification(t *testing.T) {
 
   mockedAuthService := userServiceMock.NewAuthenticationMock(t)
   mockedMailService := mailServiceMock.NewMailMock(t)
-  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(nil)
+  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(status.Success())
 
   mockedFileService := fileServiceMock.NewFileMock(t)
 
@@ -709,7 +709,7 @@ func Test_userService_RequestResetPassword(t *testing.T) {
 
   mockedAuthService := userServiceMock.NewAuthenticationMock(t)
   mockedMailService := mailServiceMock.NewMailMock(t)
-  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(nil)
+  mockedMailService.EXPECT().SendEmail(mock.Anything).Return(status.Success())
 
   mockedFileService := fileServiceMock.NewFileMock(t)
 
@@ -930,7 +930,7 @@ func Test_userService_UpdateProfileImage(t *testing.T) {
   mockedMailService := mailServiceMock.NewMailMock(t)
   mockedFileService := fileServiceMock.NewFileMock(t)
 
-  mockedFileService.EXPECT().Upload(file.ProfileAsset, mock.Anything).Return("something", status.Success())
+  mockedFileService.EXPECT().Upload(file.ProfileAsset, mock.Anything).Return(file.UploadInfo{Name: "something"}, status.Success())
   mockedFileService.EXPECT().Delete(file.ProfileAsset, mock.Anything).Return(status.Success()).Once()
   mockedFileService.EXPECT().Delete(mock.Anything, mock.Anything).Return(status.InternalError()).Once()
 
@@ -974,7 +974,7 @@ func Test_userService_UpdateProfileImage(t *testing.T) {
   }
   for _, tt := range tests {
     t.Run(tt.name, func(t *testing.T) {
-      if got := u.UpdateProfileImage(tt.args.input); !reflect.DeepEqual(got, tt.want) {
+      if _, got := u.UpdateProfileImage(tt.args.input); !reflect.DeepEqual(got, tt.want) {
         t.Errorf("UpdateProfileImage() = %v, want %v", got, tt.want)
       }
     })
@@ -1116,8 +1116,7 @@ func Test_userService_VerifyEmail(t *testing.T) {
       name: "Normal",
       args: args{
         input: &dto.VerifyEmailInput{
-          UserId: userId,
-          Token:  token,
+          Token: token,
         },
       },
       want: status.Updated(),
@@ -1126,8 +1125,7 @@ func Test_userService_VerifyEmail(t *testing.T) {
       name: "Token not found",
       args: args{
         input: &dto.VerifyEmailInput{
-          UserId: userId,
-          Token:  badToken,
+          Token: badToken,
         },
       },
       want: status.Error(status.VERIFICATION_TOKEN_NOT_FOUND),
@@ -4,6 +4,7 @@ import (
   "database/sql"
   "github.com/google/uuid"
   "github.com/stretchr/testify/mock"
+  "manga-explorer/internal/common"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/domain/users"
   "manga-explorer/internal/domain/users/dto"
@@ -25,10 +26,10 @@ func Test_verificationService_Request(t *testing.T) {
     User:           nil,
   }
   verifMock := verifRepoMock.NewVerificationMock(t)
-  verifMock.EXPECT().Create(&verif).Return(nil)
-  verifMock.EXPECT().Create(mock.AnythingOfType("*users.Verification")).Return(simpleError)
+  verifMock.EXPECT().Upsert(&verif).Return(nil)
+  verifMock.EXPECT().Upsert(mock.AnythingOfType("*users.Verification")).Return(simpleError)
 
-  v := NewVerification(verifMock)
+  v := NewVerification(&common.Config{VerificationTokenDuration: time.Hour}, verifMock)
 
   type args struct {
     userId string
@@ -98,7 +99,7 @@ func Test_verificationService_Response(t *testing.T) {
   verifMock.EXPECT().Remove(verif.Token).Return(nil)
   verifMock.EXPECT().Remove(mock.AnythingOfType("string")).Return(sql.ErrNoRows)
 
-  v := NewVerification(verifMock)
+  v := NewVerification(&common.Config{VerificationTokenDuration: time.Hour}, verifMock)
   type args struct {
     token string
     usage users.Usage
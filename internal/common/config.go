@@ -1,9 +1,12 @@
 package common
 
 import (
+  "crypto/sha256"
   "fmt"
   "github.com/caarlos0/env/v10"
   "github.com/joho/godotenv"
+  "golang.org/x/crypto/hkdf"
+  "io"
   "log"
   "strconv"
   "time"
@@ -40,6 +43,45 @@ type Config struct {
   DbPort     uint16 `env:"DB_PORT,notEmpty"`
   DbName     string `env:"DB_NAME,notEmpty"`
   DbParam    string `env:"DB_PARAM"`
+
+  // File storage, either local or s3
+  Storage string `env:"STORAGE" envDefault:"local"`
+
+  // S3-compatible storage, the files are served from the public url like CDN or the bucket url when it is empty.
+  // Covers and profiles are stored on the public bucket, while the pages are stored on the private bucket which
+  // should not allow public read and are only served through presigned url
+  S3Endpoint      string `env:"S3_ENDPOINT"`
+  S3Region        string `env:"S3_REGION" envDefault:"us-east-1"`
+  S3Bucket        string `env:"S3_BUCKET"`
+  S3PrivateBucket string `env:"S3_PRIVATE_BUCKET"`
+  S3AccessKey     string `env:"S3_ACCESS_KEY"`
+  S3SecretKey     string `env:"S3_SECRET_KEY"`
+  S3PathStyle     bool   `env:"S3_PATH_STYLE" envDefault:"false"` // MinIO needs path style url
+  S3PublicURL     string `env:"S3_PUBLIC_URL"`
+
+  // Page images are only accessible through signed url which expires after the duration
+  FileSigningKey  string        `env:"FILE_SIGNING_KEY"` // JWT secret key is used when it is empty
+  FileURLDuration time.Duration `env:"FILE_URL_DURATION" envDefault:"1h"`
+
+  // Upload size limit of each asset type in MB
+  MaxPageSize    int64 `env:"MAX_PAGE_SIZE" envDefault:"20"`
+  MaxCoverSize   int64 `env:"MAX_COVER_SIZE" envDefault:"10"`
+  MaxVolumeSize  int64 `env:"MAX_VOLUME_SIZE" envDefault:"10"`
+  MaxProfileSize int64 `env:"MAX_PROFILE_SIZE" envDefault:"5"`
+
+  // Image processing of each asset type, the metadata is always stripped
+  PageProcessing    ImageProcessing `envPrefix:"PAGE_"`
+  CoverProcessing   ImageProcessing `envPrefix:"COVER_"`
+  VolumeProcessing  ImageProcessing `envPrefix:"VOLUME_"`
+  ProfileProcessing ImageProcessing `envPrefix:"PROFILE_"`
+}
+
+// ImageProcessing re-encoding option of the uploaded image
+type ImageProcessing struct {
+  // lossless, near-lossless or empty to keep the original format. Both produce lossless WebP, near-lossless only
+  // reduces the color precision based on the quality, there is no lossy WebP encoder
+  WebP        string `env:"WEBP"`
+  WebPQuality int    `env:"WEBP_QUALITY" envDefault:"90"`
 }
 
 var conf = new(Config)
@@ -74,6 +116,22 @@ func (c *Config) ApiDNS(version uint) string {
   return fmt.Sprintf("%s/api/v%d", c.DNS(), version)
 }
 
+// URLSigningKey get the key used to sign the url of private files. When the key is not set, it is derived from the JWT
+// secret, so the url signature is never made with the same key as the tokens
+func (c *Config) URLSigningKey() []byte {
+  if len(c.FileSigningKey) != 0 {
+    return []byte(c.FileSigningKey)
+  }
+
+  log.Println("FILE_SIGNING_KEY is not set, the key will be derived from JWT_SECRET_KEY")
+  key := make([]byte, sha256.Size)
+  _, err := io.ReadFull(hkdf.New(sha256.New, []byte(c.JWTSecretKey), nil, []byte("file-url")), key)
+  if err != nil {
+    panic(err)
+  }
+  return key
+}
+
 func (c *Config) SigningMethod() jwt.SigningMethod {
   return c.signingMethod
 }
@@ -1,6 +1,7 @@
 package dto
 
 import (
+  "io"
   "manga-explorer/internal/common/status"
 )
 
@@ -59,3 +60,18 @@ type ResponsePage struct {
   TotalElements uint64 `json:"total_elements"`
   TotalPage     uint64 `json:"total_page,omitempty"`
 }
+
+// UploadResponse size of the uploaded file before and after it is processed, the mime type could be different than
+// the uploaded file when the image is re-encoded
+type UploadResponse struct {
+  OriginalSize int64  `json:"original_size"` // In bytes
+  StoredSize   int64  `json:"stored_size"`   // In bytes
+  MimeType     string `json:"mime_type"`
+}
+
+// FileResponse used to stream file as response instead of json, Write will be called after the headers are sent
+type FileResponse struct {
+  Filename    string
+  ContentType string
+  Write       func(writer io.Writer) error
+}
@@ -37,3 +37,13 @@ func (l Language) ParseLang() Language {
 func NewLanguage(lang string) Language {
   return Language(lang).ParseLang()
 }
+
+// Short convert the language into the shortest code, 2 letters code (ISO 639-1) is used when it is available
+func (l Language) Short() string {
+  tag, err := language.Parse(string(l))
+  if err != nil {
+    return string(l)
+  }
+  base, _ := tag.Base()
+  return base.String()
+}
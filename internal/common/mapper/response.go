@@ -2,6 +2,7 @@ package mapper
 
 import (
   "manga-explorer/internal/common/dto"
+  "manga-explorer/internal/infrastructure/file"
   "math"
 )
 
@@ -19,3 +20,11 @@ func NewResponsePage[T any](result []T, total uint64, query *dto.PagedQueryInput
     CurrentPage:   (query.Offset() / query.Element) + 1,
   }
 }
+
+func ToUploadResponse(info file.UploadInfo) dto.UploadResponse {
+  return dto.UploadResponse{
+    OriginalSize: info.OriginalSize,
+    StoredSize:   info.Size,
+    MimeType:     info.Format.MimeType(),
+  }
+}
@@ -101,6 +101,58 @@ const (
   COMMENT_PARENT_NOT_FOUND
   COMMENT_PARENT_DIFFERENT_SCOPE
   COMMENT_CREATE_FAILED
+
+  // Person
+  PERSON_NOT_FOUND
+  PERSON_UPDATE_FAILED
+  MANGA_STAFF_UPDATE_FAILED
+
+  // Alternative Title
+  MANGA_ALT_TITLE_ALREADY_EXIST
+  MANGA_ALT_TITLE_NOT_FOUND
+  MANGA_ALT_TITLE_CREATE_FAILED
+
+  // Relation
+  MANGA_RELATION_SELF_REFERENCE
+  MANGA_RELATION_NOT_FOUND
+  MANGA_RELATION_CREATE_FAILED
+
+  // Tag
+  TAG_ALREADY_EXIST
+  TAG_NOT_FOUND
+
+  // Volume
+  VOLUME_NOT_FOUND
+  VOLUME_UPDATE_FAILED
+
+  // Chapter
+  CHAPTER_MOVE_FAILED
+
+  // Group
+  GROUP_NOT_FOUND
+  GROUP_ALREADY_EXIST
+  GROUP_UPDATE_FAILED
+
+  // Slug
+  MANGA_SLUG_ALREADY_EXIST
+
+  // Page
+  PAGE_ARCHIVE_INVALID
+  PAGE_IMAGE_INVALID
+
+  // Download
+  DOWNLOAD_DISABLED
+
+  // Export
+  EXPORT_DISABLED
+
+  // Page
+  PAGE_ORDER_INVALID
+
+  // File
+  FILE_FORMAT_UNSUPPORTED
+  FILE_FORMAT_MISMATCH
+  FILE_TOO_LARGE
 )
 
 var messages = map[Code]string{
@@ -186,4 +238,42 @@ var messages = map[Code]string{
   COMMENT_PARENT_NOT_FOUND:       "Parent comment is not found",
   COMMENT_PARENT_DIFFERENT_SCOPE: "You are trying to reply comment from different scope",
   COMMENT_CREATE_FAILED:          "Failed to create comment",
+
+  PERSON_NOT_FOUND:          "Person doesn't exist",
+  PERSON_UPDATE_FAILED:      "Could not update person",
+  MANGA_STAFF_UPDATE_FAILED: "Could not update manga staff, make sure the person exists and is not credited twice",
+
+  MANGA_ALT_TITLE_ALREADY_EXIST: "Manga alternative title is already exist",
+  MANGA_ALT_TITLE_NOT_FOUND:     "Manga alternative title not found",
+  MANGA_ALT_TITLE_CREATE_FAILED: "Could not create manga alternative title",
+
+  MANGA_RELATION_SELF_REFERENCE: "Manga could not be related to itself",
+  MANGA_RELATION_NOT_FOUND:      "Manga relation not found",
+  MANGA_RELATION_CREATE_FAILED:  "Could not create manga relation, make sure the related manga exists and the relation is not duplicated",
+
+  TAG_ALREADY_EXIST: "Manga tag already exist",
+  TAG_NOT_FOUND:     "Manga tag doesn't exist",
+
+  VOLUME_NOT_FOUND:     "Volume is not found",
+  VOLUME_UPDATE_FAILED: "Failed to update volume",
+
+  CHAPTER_MOVE_FAILED: "Failed to move chapters, make sure all chapters and the volume are belonged to the manga",
+
+  GROUP_NOT_FOUND:     "Group doesn't exist",
+  GROUP_ALREADY_EXIST: "Group with the same name already exist",
+  GROUP_UPDATE_FAILED: "Could not update group, make sure the users exist",
+
+  MANGA_SLUG_ALREADY_EXIST: "Slug is already used by other manga",
+
+  PAGE_ARCHIVE_INVALID: "Archive is invalid, make sure it is CBZ or ZIP file containing images",
+  PAGE_IMAGE_INVALID:   "Page image is invalid",
+
+  DOWNLOAD_DISABLED: "Download is disabled for this manga",
+  EXPORT_DISABLED:   "Export is disabled for this manga",
+
+  PAGE_ORDER_INVALID: "Page order should contain all pages of the chapter exactly once",
+
+  FILE_FORMAT_UNSUPPORTED: "File is not supported image, it should be JPEG, PNG, GIF, WebP or AVIF",
+  FILE_FORMAT_MISMATCH:    "File extension doesn't match the content",
+  FILE_TOO_LARGE:          "File is too large",
 }
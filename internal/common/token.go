@@ -7,6 +7,7 @@ import (
   "manga-explorer/internal/common/constant"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/util"
+  "manga-explorer/internal/util/opt"
 )
 
 type AccessTokenClaims struct {
@@ -27,3 +28,13 @@ func GetClaims(ctx *gin.Context) (*AccessTokenClaims, status.Object) {
   }
   return value, status.Success()
 }
+
+// GetOptionalUserId get user id from the claims, it will return null when the user is not logged in.
+// Used on route which has optional authorization
+func GetOptionalUserId(ctx *gin.Context) opt.Optional[string] {
+  claims, stat := GetClaims(ctx)
+  if stat.IsError() {
+    return opt.NullStr
+  }
+  return opt.New(claims.UserId)
+}
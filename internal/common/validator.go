@@ -2,8 +2,12 @@ package common
 
 import (
   "github.com/go-playground/validator/v10"
+  "manga-explorer/internal/util"
+  "regexp"
 )
 
+var slugRegex = regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$")
+
 func RegisterValidationTags(validate *validator.Validate) {
   //err := validate.RegisterValidation("uuid", func(fl validator.FieldLevel) bool {
   //	if fl.Field().IsNil() {
@@ -22,5 +26,21 @@ func RegisterValidationTags(validate *validator.Validate) {
   //}
   validate.RegisterAlias("language", "bcp47_language_tag")
 
+  // Slug shaped like UUID is rejected, because manga could be found by either of them
+  err := validate.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+    return slugRegex.MatchString(fl.Field().String()) && !util.IsUUID(fl.Field().String())
+  })
+  if err != nil {
+    panic(err)
+  }
+
   validate.RegisterAlias("manga_status", "oneof=completed ongoing drafted dropped hiatus")
+  validate.RegisterAlias("content_rating", "oneof=safe suggestive erotica pornographic")
+  validate.RegisterAlias("demographic", "oneof=none shounen shoujo seinen josei")
+  validate.RegisterAlias("tag_group", "oneof=theme format content_warning")
+  validate.RegisterAlias("chapter_kind", "oneof=regular extra prologue oneshot")
+  validate.RegisterAlias("group_role", "oneof=leader member")
+  validate.RegisterAlias("staff_role", "oneof=story art original_creator editor")
+  validate.RegisterAlias("title_kind", "oneof=romaji abbreviation synonym")
+  validate.RegisterAlias("relation_kind", "oneof=sequel prequel spin_off side_story alternate_version shares_universe")
 }
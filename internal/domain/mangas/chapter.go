@@ -6,6 +6,7 @@ import (
   "github.com/uptrace/bun"
   "manga-explorer/internal/common"
   "manga-explorer/internal/domain/users"
+  "strconv"
   "time"
 )
 
@@ -13,26 +14,33 @@ type Chapter struct {
   bun.BaseModel `bun:"table:chapters"`
 
   Id           string `bun:",pk,type:uuid,pk"`
-  VolumeId     string `bun:",nullzero,notnull,type:uuid,unique:chapter_lang_idx"`
-  TranslatorId string `bun:",nullzero,notnull,type:uuid,unique:chapter_lang_idx"`
+  MangaId      string `bun:",nullzero,notnull,type:uuid"`
+  VolumeId     string `bun:",nullzero,type:uuid"` // Chapter could be not belonged to any volume yet
+  TranslatorId string `bun:",nullzero,notnull,type:uuid"`
 
-  Language    common.Language `bun:",notnull,unique:chapter_lang_idx,type:varchar(3)"`
+  // Uniqueness of the number and language is checked per credited group, see ChapterGroupConstraint
+  Language    common.Language `bun:",notnull,type:varchar(3)"`
   Title       string          `bun:",nullzero"`
-  Number      uint64          `bun:",nullzero,notnull,unique:chapter_lang_idx"`
+  Number      float64         `bun:",notnull,type:numeric(10,2)"` // Decimal to allow chapter like 10.5
+  Label       string          `bun:",nullzero"`                   // Displayed instead of number when exists, e.g. "Extra 2"
+  Kind        ChapterKind     `bun:",notnull,default:0"`
   PublishDate time.Time       `bun:",nullzero,type:date"`
 
   TotalComment uint64 `bun:",scanonly"`
+  IsRead       *bool  `bun:",scanonly"` // Only selected when the reader is known
 
   CreatedAt time.Time `bun:",notnull"`
   UpdatedAt time.Time `bun:",notnull"`
 
   Comments   []Comment   `bun:"rel:has-many,join:id=object_id,join:type=object_type,polymorphic"`
   Pages      []Page      `bun:"rel:has-many,join:id=chapter_id"`
+  Groups     []Group     `bun:"m2m:chapter_groups,join:Chapter=Group"`
   Translator *users.User `bun:"rel:belongs-to,join:translator_id=id,on_delete:SET DEFAULT"`
-  Volume     *Volume     `bun:"rel:belongs-to,join:volume_id=id,on_delete:CASCADE"`
+  Manga      *Manga      `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+  Volume     *Volume     `bun:"rel:belongs-to,join:volume_id=id,on_delete:SET NULL"`
 }
 
-func NewChapter(volumeId, translatorId, title string, lang countries.CountryCode, number uint64, publishDate time.Time) Chapter {
+func NewChapter(volumeId, translatorId, title string, lang countries.CountryCode, number float64, publishDate time.Time) Chapter {
   currentTime := time.Now()
   return Chapter{
     Id:           uuid.NewString(),
@@ -41,8 +49,14 @@ func NewChapter(volumeId, translatorId, title string, lang countries.CountryCode
     Language:     common.Language(lang.Alpha3()),
     Title:        title,
     Number:       number,
+    Kind:         ChapterKindRegular,
     PublishDate:  publishDate,
     CreatedAt:    currentTime,
     UpdatedAt:    currentTime,
   }
 }
+
+// NumberString the chapter number without trailing zeros, e.g. 10.5 or 11
+func (c *Chapter) NumberString() string {
+  return strconv.FormatFloat(c.Number, 'f', -1, 64)
+}
@@ -0,0 +1,102 @@
+package mangas
+
+import (
+  "encoding/xml"
+  "strings"
+)
+
+const ComicInfoFilename = "ComicInfo.xml"
+
+// ComicInfo metadata embedded on CBZ archive, used by comic readers to show the chapter information.
+// See https://anansi-project.github.io/docs/comicinfo/schemas/v2.1
+type ComicInfo struct {
+  XMLName         xml.Name `xml:"ComicInfo"`
+  Title           string   `xml:"Title,omitempty"`
+  Series          string   `xml:"Series,omitempty"`
+  Number          string   `xml:"Number,omitempty"`
+  Volume          uint32   `xml:"Volume,omitempty"`
+  Summary         string   `xml:"Summary,omitempty"`
+  Year            uint16   `xml:"Year,omitempty"`
+  Writer          string   `xml:"Writer,omitempty"`
+  Penciller       string   `xml:"Penciller,omitempty"`
+  Editor          string   `xml:"Editor,omitempty"`
+  Translator      string   `xml:"Translator,omitempty"`
+  Genre           string   `xml:"Genre,omitempty"`
+  Tags            string   `xml:"Tags,omitempty"`
+  LanguageISO     string   `xml:"LanguageISO,omitempty"`
+  ScanInformation string   `xml:"ScanInformation,omitempty"`
+  PageCount       int      `xml:"PageCount"`
+  AgeRating       string   `xml:"AgeRating,omitempty"`
+  Manga           string   `xml:"Manga"`
+}
+
+// NewComicInfo create metadata based on the chapter, the manga, volume, translator, groups and pages of the chapter
+// should be already loaded. The genres, tags and staff of the manga are used when they are loaded.
+func NewComicInfo(chapter *Chapter) ComicInfo {
+  info := ComicInfo{
+    Title:       chapter.Title,
+    Number:      chapter.NumberString(),
+    LanguageISO: chapter.Language.Short(),
+    PageCount:   len(chapter.Pages),
+    Manga:       "YesAndRightToLeft",
+  }
+
+  if chapter.Translator != nil {
+    info.Translator = chapter.Translator.Username
+  }
+  if chapter.Volume != nil {
+    info.Volume = chapter.Volume.Number
+  }
+
+  groups := make([]string, 0, len(chapter.Groups))
+  for _, group := range chapter.Groups {
+    groups = append(groups, group.Name)
+  }
+  info.ScanInformation = strings.Join(groups, ", ")
+
+  manga := chapter.Manga
+  if manga == nil {
+    return info
+  }
+  info.Series = manga.OriginalTitle
+  info.Summary = manga.OriginalDescription
+  info.Year = manga.PublicationYear
+  info.AgeRating = manga.ContentRating.AgeRating()
+
+  var writers, pencillers, editors, genres, tags []string
+  for _, staff := range manga.Staff {
+    if staff.Person == nil {
+      continue
+    }
+    switch staff.Role {
+    case StaffRoleStory, StaffRoleOriginalCreator:
+      writers = append(writers, staff.Person.Name)
+    case StaffRoleArt:
+      pencillers = append(pencillers, staff.Person.Name)
+    case StaffRoleEditor:
+      editors = append(editors, staff.Person.Name)
+    }
+  }
+  for _, genre := range manga.Genres {
+    genres = append(genres, genre.Name)
+  }
+  for _, tag := range manga.Tags {
+    tags = append(tags, tag.Name)
+  }
+
+  info.Writer = strings.Join(writers, ", ")
+  info.Penciller = strings.Join(pencillers, ", ")
+  info.Editor = strings.Join(editors, ", ")
+  info.Genre = strings.Join(genres, ", ")
+  info.Tags = strings.Join(tags, ", ")
+  return info
+}
+
+// Marshal encode the metadata into xml document
+func (c *ComicInfo) Marshal() ([]byte, error) {
+  data, err := xml.MarshalIndent(c, "", "  ")
+  if err != nil {
+    return nil, err
+  }
+  return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common"
+)
+
+type InternalAlternativeTitle struct {
+  Lang  common.Language `json:"lang" binding:"required,bcp47_language_tag"`
+  Kind  string          `json:"kind" binding:"required,title_kind"`
+  Title string          `json:"title" binding:"required"`
+}
+
+type MangaAlternativeTitleInsertInput struct {
+  MangaId string                     `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  Titles  []InternalAlternativeTitle `json:"titles" binding:"min=1,dive"`
+}
+
+func (i *MangaAlternativeTitleInsertInput) ConstructURI(ctx *gin.Context) {
+  i.MangaId = ctx.Param("manga_id")
+}
+
+type MangaAlternativeTitleDeleteInput struct {
+  MangaId  string   `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  TitleIds []string `json:"ids" binding:"required,dive,uuid4"`
+}
+
+func (i *MangaAlternativeTitleDeleteInput) ConstructURI(ctx *gin.Context) {
+  i.MangaId = ctx.Param("manga_id")
+}
+
+type AlternativeTitleResponse struct {
+  Id    string          `json:"id"`
+  Lang  common.Language `json:"lang"`
+  Kind  string          `json:"kind"`
+  Title string          `json:"title"`
+}
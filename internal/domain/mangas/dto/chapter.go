@@ -3,30 +3,52 @@ package dto
 import (
   "github.com/gin-gonic/gin"
   "manga-explorer/internal/common"
+  commonDto "manga-explorer/internal/common/dto"
   "manga-explorer/internal/domain/users/dto"
   "time"
 )
 
 type ChapterResponse struct {
   Id           string          `json:"id"`
+  MangaId      string          `json:"manga_id,omitempty"`
+  MangaTitle   string          `json:"manga_title,omitempty"`
   Language     common.Language `json:"language"`
-  Chapter      uint64          `json:"chapter"`
+  Chapter      float64         `json:"chapter"`
+  Label        string          `json:"label,omitempty"`
+  Kind         string          `json:"kind"`
   Title        string          `json:"title"`
   CreatedAt    time.Time       `json:"created_at"`
   TotalComment *uint64         `json:"total_comment,omitempty"`
+  Read         *bool           `json:"read,omitempty"`
 
   Comments   []CommentResponse `json:"comments,omitempty"`
   Pages      []PageResponse    `json:"pages,omitempty"`
   Translator dto.UserResponse  `json:"translator,omitempty"`
+  Groups     []GroupResponse   `json:"groups,omitempty"`
+
+  Prev *ChapterReferenceResponse `json:"prev,omitempty"`
+  Next *ChapterReferenceResponse `json:"next,omitempty"`
+}
+
+// ChapterReferenceResponse minimal chapter used for navigating between chapters
+type ChapterReferenceResponse struct {
+  Id       string          `json:"id"`
+  Language common.Language `json:"language"`
+  Chapter  float64         `json:"chapter"`
+  Label    string          `json:"label,omitempty"`
+  Title    string          `json:"title"`
 }
 
 type ChapterCreateInput struct {
   MangaId      string          `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
-  VolumeId     string          `json:"volume_id" binding:"required,uuid4"`
+  VolumeId     string          `json:"volume_id" binding:"omitempty,uuid4"`
   Language     common.Language `json:"language" binding:"required,language"`
   Title        string          `json:"title" binding:"required"`
-  Chapter      uint64          `json:"chapter" binding:"required,min=1"`
+  Chapter      float64         `json:"chapter" binding:"gte=0"`
+  Label        string          `json:"label"`
+  Kind         string          `json:"kind" binding:"omitempty,chapter_kind"`
   PublishDate  time.Time       `json:"publish_date"`
+  Groups       []string        `json:"groups" binding:"omitempty,unique,dive,uuid4"`
   TranslatorId string          `json:"-" swaggerignore:"true"`
 }
 
@@ -36,10 +58,12 @@ func (c *ChapterCreateInput) ConstructURI(ctx *gin.Context) {
 
 type ChapterEditInput struct {
   ChapterId   string          `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
-  VolumeId    string          `json:"volume_id" binding:"required,uuid4"`
+  VolumeId    string          `json:"volume_id" binding:"omitempty,uuid4"`
   Title       string          `json:"title" binding:"required"`
   Language    common.Language `json:"language" binding:"required,language"`
-  Chapter     uint64          `json:"chapter" binding:"required"`
+  Chapter     float64         `json:"chapter" binding:"gte=0"`
+  Label       string          `json:"label"`
+  Kind        string          `json:"kind" binding:"required,chapter_kind"`
   PublishDate time.Time       `json:"publish_date"`
 }
 
@@ -47,3 +71,46 @@ func (e *ChapterEditInput) ConstructURI(ctx *gin.Context) {
   res := ctx.Param("chapter_id")
   e.ChapterId = res
 }
+
+type ChapterMoveInput struct {
+  MangaId    string   `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  ChapterIds []string `json:"chapter_ids" binding:"required,min=1,unique,dive,uuid4"`
+  VolumeId   string   `json:"volume_id" binding:"omitempty,uuid4"` // Leave empty to detach the chapters from the volume
+}
+
+func (m *ChapterMoveInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
+
+type ChapterExportInput struct {
+  ChapterId string          `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
+  Language  common.Language `form:"language" binding:"omitempty,language"` // Used for the title, defaulted to the chapter language
+}
+
+func (c *ChapterExportInput) ConstructURI(ctx *gin.Context) {
+  c.ChapterId = ctx.Param("chapter_id")
+}
+
+type VolumeExportInput struct {
+  VolumeId string          `uri:"volume_id" binding:"required,uuid4" swaggerignore:"true"`
+  Language common.Language `form:"language" binding:"omitempty,language"` // Only chapters in the language are exported when it is present
+}
+
+func (v *VolumeExportInput) ConstructURI(ctx *gin.Context) {
+  v.VolumeId = ctx.Param("volume_id")
+}
+
+type MangaChaptersFindInput struct {
+  commonDto.PagedQueryInput
+  MangaId      string          `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  UserId       string          `json:"-" swaggerignore:"true"`
+  Language     common.Language `form:"language" binding:"omitempty,language"`
+  VolumeId     string          `form:"volume_id" binding:"omitempty,uuid4"`
+  TranslatorId string          `form:"translator_id" binding:"omitempty,uuid4"`
+  Order        string          `form:"order" binding:"omitempty,oneof=number publish_date"`
+  Direction    string          `form:"direction" binding:"omitempty,oneof=asc desc"`
+}
+
+func (c *MangaChaptersFindInput) ConstructURI(ctx *gin.Context) {
+  c.MangaId = ctx.Param("manga_id")
+}
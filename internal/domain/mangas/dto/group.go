@@ -0,0 +1,77 @@
+package dto
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common/dto"
+)
+
+type GroupResponse struct {
+  Id          string `json:"id"`
+  Name        string `json:"name"`
+  Website     string `json:"website,omitempty"`
+  Description string `json:"desc,omitempty"`
+
+  Members []GroupMemberResponse `json:"members,omitempty"`
+}
+
+type GroupMemberResponse struct {
+  UserId   string `json:"user_id"`
+  Username string `json:"username"`
+  Role     string `json:"role"`
+}
+
+type GroupCreateInput struct {
+  Name        string `json:"name" binding:"required"`
+  Website     string `json:"website" binding:"omitempty,url"`
+  Description string `json:"desc"`
+}
+
+type GroupEditInput struct {
+  Id          string `uri:"group_id" binding:"required,uuid4" swaggerignore:"true"`
+  Name        string `json:"name" binding:"required"`
+  Website     string `json:"website" binding:"omitempty,url"`
+  Description string `json:"desc"`
+}
+
+func (g *GroupEditInput) ConstructURI(ctx *gin.Context) {
+  g.Id = ctx.Param("group_id")
+}
+
+type GroupListInput struct {
+  dto.PagedQueryInput
+  Name string `form:"name"`
+}
+
+type GroupReleaseInput struct {
+  dto.PagedQueryInput
+  GroupId string `uri:"group_id" binding:"required,uuid4" swaggerignore:"true"`
+}
+
+func (g *GroupReleaseInput) ConstructURI(ctx *gin.Context) {
+  g.GroupId = ctx.Param("group_id")
+}
+
+type InternalGroupMember struct {
+  UserId string `json:"user_id" binding:"required,uuid4"`
+  Role   string `json:"role" binding:"required,group_role"`
+}
+
+type GroupMemberEditInput struct {
+  GroupId        string                `uri:"group_id" binding:"required,uuid4" swaggerignore:"true"`
+  AddMembers     []InternalGroupMember `json:"adds" binding:"omitempty,dive"`
+  RemovedMembers []string              `json:"removes" binding:"omitempty,dive,uuid4"`
+}
+
+func (g *GroupMemberEditInput) ConstructURI(ctx *gin.Context) {
+  g.GroupId = ctx.Param("group_id")
+}
+
+type ChapterGroupEditInput struct {
+  ChapterId     string   `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
+  AddGroups     []string `json:"adds" binding:"omitempty,unique,dive,uuid4"`
+  RemovedGroups []string `json:"removes" binding:"omitempty,unique,dive,uuid4"`
+}
+
+func (c *ChapterGroupEditInput) ConstructURI(ctx *gin.Context) {
+  c.ChapterId = ctx.Param("chapter_id")
+}
@@ -10,35 +10,50 @@ import (
 
 type MangaResponse struct {
   Id              string         `json:"id"`
+  Slug            string         `json:"slug"`
   Title           string         `json:"title"`
   Description     string         `json:"desc"`
   Status          string         `json:"status"`
+  ContentRating   string         `json:"content_rating"`
+  Demographic     string         `json:"demographic"`
   Origin          common.Country `json:"origin"`
   PublicationYear uint16         `json:"year"`
   CoverURL        string         `json:"cover_url"`
+  AllowDownload   bool           `json:"allow_download"`
+  AllowExport     bool           `json:"allow_export"`
 
   Rate         float32 `json:"rate"`
   TotalRater   uint64  `json:"total_rater"`
   TotalComment uint64  `json:"total_comment"`
   //Comments     []CommentResponse     `json:"comments,omitempty"`
   //Ratings      []RateResponse        `json:"ratings,omitempty"`
-  Translations []TranslationResponse `json:"translations,omitempty"`
-  Volumes      []VolumeResponse      `json:"volumes,omitempty"`
-  Genres       []GenreResponse       `json:"genres"`
+  Translations      []TranslationResponse      `json:"translations,omitempty"`
+  AlternativeTitles []AlternativeTitleResponse `json:"alt_titles,omitempty"`
+  Volumes           []VolumeResponse           `json:"volumes,omitempty"`
+  Chapters          []ChapterResponse          `json:"chapters,omitempty"` // Chapters which are not belonged to any volume
+  Genres            []GenreResponse            `json:"genres"`
+  Tags              []TagResponse              `json:"tags"`
+  Staff             []StaffResponse            `json:"staff"`
+  Relations         []MangaRelationResponse    `json:"relations"`
 }
 
 type MinimalMangaResponse struct {
-  Id              string          `json:"id"`
-  Title           string          `json:"title"`
-  Description     string          `json:"desc"`
-  Status          string          `json:"status"`
-  Origin          common.Country  `json:"origin"`
-  PublicationYear uint16          `json:"year"`
-  CoverURL        string          `json:"cover_url"`
-  Rate            float32         `json:"rate"`
-  TotalRater      uint64          `json:"total_rater"`
-  TotalComment    uint64          `json:"total_comment"`
-  Genres          []GenreResponse `json:"genres"`
+  Id                string          `json:"id"`
+  Slug              string          `json:"slug"`
+  Title             string          `json:"title"`
+  Description       string          `json:"desc"`
+  Status            string          `json:"status"`
+  ContentRating     string          `json:"content_rating"`
+  Demographic       string          `json:"demographic"`
+  Origin            common.Country  `json:"origin"`
+  PublicationYear   uint16          `json:"year"`
+  CoverURL          string          `json:"cover_url"`
+  CoverThumbnailURL string          `json:"cover_thumbnail_url"`
+  Rate              float32         `json:"rate"`
+  TotalRater        uint64          `json:"total_rater"`
+  TotalComment      uint64          `json:"total_comment"`
+  Genres            []GenreResponse `json:"genres"`
+  Tags              []TagResponse   `json:"tags"`
 }
 
 type MangaHistoryResponse struct {
@@ -55,11 +70,40 @@ type MangaCreateInput struct {
   Title           string         `json:"title" binding:"required"`
   Description     string         `json:"desc" binding:"required"`
   Status          string         `json:"status" binding:"required,manga_status"`
+  ContentRating   string         `json:"content_rating" binding:"omitempty,content_rating"`
+  Demographic     string         `json:"demographic" binding:"omitempty,demographic"`
   Origin          common.Country `json:"origin" binding:"required,iso3166_1_alpha3|iso3166_1_alpha2"`
   PublicationYear uint16         `json:"publication_year" binding:"required"`
   Genres          []string       `json:"genres" binding:"required,dive,uuid4"`
 }
 
+type MangaSlugEditInput struct {
+  MangaId string `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  Slug    string `json:"slug" binding:"required,max=255,slug"`
+}
+
+func (m *MangaSlugEditInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
+
+type MangaDownloadEditInput struct {
+  MangaId string `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  Allowed *bool  `json:"allowed" binding:"required"`
+}
+
+func (m *MangaDownloadEditInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
+
+type MangaExportEditInput struct {
+  MangaId string `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  Allowed *bool  `json:"allowed" binding:"required"`
+}
+
+func (m *MangaExportEditInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
+
 type MangaCoverUpdateInput struct {
   MangaId string                `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
   Image   *multipart.FileHeader `form:"image" binding:"required" swaggerignore:"true"`
@@ -72,6 +116,8 @@ func (c *MangaCoverUpdateInput) ConstructURI(ctx *gin.Context) {
 type MangaEditInput struct {
   MangaId         string         `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
   Status          string         `json:"status" binding:"required,manga_status"`
+  ContentRating   string         `json:"content_rating" binding:"omitempty,content_rating"`
+  Demographic     string         `json:"demographic" binding:"omitempty,demographic"`
   Origin          common.Country `json:"origin" binding:"required,iso3166_1_alpha3|iso3166_1_alpha2"`
   Title           string         `json:"title" binding:"required,min=1"`
   Description     string         `json:"description"`
@@ -88,6 +134,16 @@ func (m *MangaGenreEditInput) ConstructURI(ctx *gin.Context) {
   m.MangaId = ctx.Param("manga_id")
 }
 
+type MangaTagEditInput struct {
+  MangaId     string   `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  AddTags     []string `json:"adds" binding:"omitempty,dive,uuid4"`
+  RemovedTags []string `json:"removes" binding:"omitempty,dive,uuid4"`
+}
+
+func (m *MangaTagEditInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
+
 func (e *MangaEditInput) ConstructURI(ctx *gin.Context) {
   e.MangaId = ctx.Param("manga_id")
 }
@@ -95,7 +151,9 @@ func (e *MangaEditInput) ConstructURI(ctx *gin.Context) {
 type MangaSearchQuery struct {
   dto.PagedQueryInput
   Title  string                              `json:"title"`
+  Staff  string                              `json:"staff"`
   Genres common.CriterionOption[string]      `json:"genre"`
+  Tags   common.CriterionOption[string]      `json:"tag"`
   Origin common.IncludeArray[common.Country] `json:"origin"`
 }
 
@@ -3,13 +3,26 @@ package dto
 import (
 	"errors"
 	"github.com/gin-gonic/gin"
+	commonDto "manga-explorer/internal/common/dto"
 	"mime/multipart"
 	"strconv"
 )
 
+// PageUploadResponse size of the uploaded page image before and after it is processed
+type PageUploadResponse struct {
+	Page uint16 `json:"page"`
+	commonDto.UploadResponse
+}
+
 type PageResponse struct {
+	Id       string `json:"id"`
 	Page     uint16 `json:"page"`
 	ImageURL string `json:"image_url"` // Can be returning image bytes
+	Width    uint32 `json:"width,omitempty"`
+	Height   uint32 `json:"height,omitempty"`
+	Size     int64  `json:"size,omitempty"` // In bytes
+	MimeType string `json:"mime_type,omitempty"`
+	BlurHash string `json:"blurhash,omitempty"`
 }
 
 type InternalPage struct {
@@ -49,6 +62,37 @@ func (p *PageCreateInput) ParseMultipart(ctx *gin.Context) error {
 	return nil
 }
 
+type PageArchiveCreateInput struct {
+	ChapterId string                `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
+	Archive   *multipart.FileHeader `form:"archive" binding:"required" swaggerignore:"true"`
+}
+
+func (p *PageArchiveCreateInput) ConstructURI(ctx *gin.Context) {
+	p.ChapterId = ctx.Param("chapter_id")
+}
+
+type PageReorderInput struct {
+	ChapterId string   `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
+	PageIds   []string `json:"page_ids" binding:"required,min=1,unique,dive,uuid4"` // All page ids of the chapter in the new order
+}
+
+func (p *PageReorderInput) ConstructURI(ctx *gin.Context) {
+	p.ChapterId = ctx.Param("chapter_id")
+}
+
+type PageReplaceInput struct {
+	ChapterId string                `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
+	Number    uint16                `uri:"number" binding:"required,gte=1" swaggerignore:"true"`
+	Image     *multipart.FileHeader `form:"image" binding:"required" swaggerignore:"true"`
+}
+
+func (p *PageReplaceInput) ConstructURI(ctx *gin.Context) {
+	p.ChapterId = ctx.Param("chapter_id")
+	// Invalid number will be zero and rejected by the validation
+	number, _ := strconv.ParseUint(ctx.Param("number"), 10, 16)
+	p.Number = uint16(number)
+}
+
 type PageDeleteInput struct {
 	ChapterId string   `uri:"chapter_id" binding:"required,uuid4" swaggerignore:"true"`
 	Pages     []uint16 `json:"pages" binding:"required"`
@@ -0,0 +1,64 @@
+package dto
+
+import (
+  "github.com/gin-gonic/gin"
+  "manga-explorer/internal/common/dto"
+)
+
+type PersonResponse struct {
+  Id          string `json:"id"`
+  Name        string `json:"name"`
+  NativeName  string `json:"native_name,omitempty"`
+  Description string `json:"desc,omitempty"`
+
+  Works []PersonWorkResponse `json:"works,omitempty"`
+}
+
+type PersonWorkResponse struct {
+  MangaId string `json:"manga_id"`
+  Title   string `json:"title"`
+  Role    string `json:"role"`
+}
+
+type StaffResponse struct {
+  PersonId string `json:"person_id"`
+  Name     string `json:"name"`
+  Role     string `json:"role"`
+}
+
+type PersonCreateInput struct {
+  Name        string `json:"name" binding:"required"`
+  NativeName  string `json:"native_name"`
+  Description string `json:"desc"`
+}
+
+type PersonEditInput struct {
+  Id          string `uri:"person_id" binding:"required,uuid4" swaggerignore:"true"`
+  Name        string `json:"name" binding:"required"`
+  NativeName  string `json:"native_name"`
+  Description string `json:"desc"`
+}
+
+func (p *PersonEditInput) ConstructURI(ctx *gin.Context) {
+  p.Id = ctx.Param("person_id")
+}
+
+type PersonListInput struct {
+  dto.PagedQueryInput
+  Name string `form:"name"`
+}
+
+type InternalStaff struct {
+  PersonId string `json:"person_id" binding:"required,uuid4"`
+  Role     string `json:"role" binding:"required,staff_role"`
+}
+
+type MangaStaffEditInput struct {
+  MangaId      string          `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  AddStaff     []InternalStaff `json:"adds" binding:"omitempty,dive"`
+  RemovedStaff []InternalStaff `json:"removes" binding:"omitempty,dive"`
+}
+
+func (m *MangaStaffEditInput) ConstructURI(ctx *gin.Context) {
+  m.MangaId = ctx.Param("manga_id")
+}
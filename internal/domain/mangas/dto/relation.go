@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/gin-gonic/gin"
+
+type MangaRelationCreateInput struct {
+  MangaId   string `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  RelatedId string `json:"related_id" binding:"required,uuid4"`
+  Kind      string `json:"kind" binding:"required,relation_kind"`
+}
+
+func (i *MangaRelationCreateInput) ConstructURI(ctx *gin.Context) {
+  i.MangaId = ctx.Param("manga_id")
+}
+
+type MangaRelationDeleteInput struct {
+  MangaId   string `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
+  RelatedId string `json:"related_id" binding:"required,uuid4"`
+}
+
+func (i *MangaRelationDeleteInput) ConstructURI(ctx *gin.Context) {
+  i.MangaId = ctx.Param("manga_id")
+}
+
+type MangaRelationResponse struct {
+  MangaId  string `json:"manga_id"`
+  Title    string `json:"title"`
+  CoverURL string `json:"cover_url"`
+  Kind     string `json:"kind"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/gin-gonic/gin"
+
+type TagResponse struct {
+  Id    string `json:"id"`
+  Name  string `json:"name"`
+  Group string `json:"group"`
+}
+
+type TagCreateInput struct {
+  Name  string `json:"name" binding:"required"`
+  Group string `json:"group" binding:"required,tag_group"`
+}
+
+type TagEditInput struct {
+  Id    string `uri:"tag_id" binding:"required,uuid4" swaggerignore:"true"`
+  Name  string `json:"new_name" binding:"required"`
+  Group string `json:"group" binding:"required,tag_group"`
+}
+
+func (t *TagEditInput) ConstructURI(ctx *gin.Context) {
+  t.Id = ctx.Param("tag_id")
+}
@@ -1,12 +1,17 @@
 package dto
 
-import "github.com/gin-gonic/gin"
+import (
+  "github.com/gin-gonic/gin"
+  "mime/multipart"
+)
 
 type VolumeResponse struct {
-  Id       string            `json:"id"`
-  Title    string            `json:"title"`
-  Number   uint32            `json:"number"`
-  Chapters []ChapterResponse `json:"chapters,omitempty"`
+  Id          string            `json:"id"`
+  Title       string            `json:"title"`
+  Description string            `json:"desc"`
+  Number      uint32            `json:"number"`
+  CoverURL    string            `json:"cover_url"`
+  Chapters    []ChapterResponse `json:"chapters,omitempty"`
 }
 
 type VolumeCreateInput struct {
@@ -24,3 +29,23 @@ type VolumeDeleteInput struct {
   MangaId string   `uri:"manga_id" binding:"required,uuid4" swaggerignore:"true"`
   Volume  []uint32 `json:"volumes" binding:"required"`
 }
+
+type VolumeEditInput struct {
+  VolumeId    string `uri:"volume_id" binding:"required,uuid4" swaggerignore:"true"`
+  Title       string `json:"title"`
+  Description string `json:"desc"`
+  Number      uint32 `json:"number" binding:"required"`
+}
+
+func (c *VolumeEditInput) ConstructURI(ctx *gin.Context) {
+  c.VolumeId = ctx.Param("volume_id")
+}
+
+type VolumeCoverUpdateInput struct {
+  VolumeId string                `uri:"volume_id" binding:"required,uuid4" swaggerignore:"true"`
+  Image    *multipart.FileHeader `form:"image" binding:"required" swaggerignore:"true"`
+}
+
+func (c *VolumeCoverUpdateInput) ConstructURI(ctx *gin.Context) {
+  c.VolumeId = ctx.Param("volume_id")
+}
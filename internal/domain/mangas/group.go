@@ -0,0 +1,88 @@
+package mangas
+
+import (
+  "errors"
+  "github.com/google/uuid"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/domain/users"
+  "time"
+)
+
+var ErrGroupChapterDuplicate = errors.New("group already credited on the same chapter")
+var ErrChapterDuplicate = errors.New("chapter without group already exists")
+
+// ChapterGroupConstraint name of the database constraint which makes sure each group only has single chapter with the
+// same number and language on each volume
+const ChapterGroupConstraint = "chapter_group_lang_idx"
+
+// ChapterConstraint name of the deferred database constraint which makes sure there is only single chapter without
+// credited group with the same number and language on each volume
+const ChapterConstraint = "chapter_ungrouped_lang_idx"
+
+// Group used for scanlation or translator groups which release the chapters
+type Group struct {
+  bun.BaseModel `bun:"table:groups,alias:grp"` // group is a reserved keyword
+
+  Id          string `bun:",pk,type:uuid"`
+  Name        string `bun:",nullzero,notnull,unique"`
+  Website     string `bun:",nullzero"`
+  Description string `bun:",nullzero,type:text"`
+
+  UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+  CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+
+  Members  []GroupMember `bun:"rel:has-many,join:id=group_id"`
+  Chapters []Chapter     `bun:"m2m:chapter_groups,join:Group=Chapter"`
+}
+
+func NewGroup(name, website, desc string) Group {
+  currentTime := time.Now()
+  return Group{
+    Id:          uuid.NewString(),
+    Name:        name,
+    Website:     website,
+    Description: desc,
+    UpdatedAt:   currentTime,
+    CreatedAt:   currentTime,
+  }
+}
+
+type GroupMember struct {
+  bun.BaseModel `bun:"table:group_members"`
+
+  GroupId string    `bun:",pk,type:uuid"`
+  UserId  string    `bun:",pk,type:uuid"`
+  Role    GroupRole `bun:",notnull,default:1"`
+
+  CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+
+  Group *Group      `bun:"rel:belongs-to,join:group_id=id,on_delete:CASCADE"`
+  User  *users.User `bun:"rel:belongs-to,join:user_id=id,on_delete:CASCADE"`
+}
+
+func NewGroupMember(groupId, userId string, role GroupRole) GroupMember {
+  return GroupMember{
+    GroupId:   groupId,
+    UserId:    userId,
+    Role:      role,
+    CreatedAt: time.Now(),
+  }
+}
+
+// ChapterGroup used for groups credited on each chapter
+type ChapterGroup struct {
+  bun.BaseModel `bun:"table:chapter_groups"`
+
+  ChapterId string `bun:",pk,type:uuid"`
+  GroupId   string `bun:",pk,type:uuid"`
+
+  Chapter *Chapter `bun:"rel:belongs-to,join:chapter_id=id,on_delete:CASCADE"`
+  Group   *Group   `bun:"rel:belongs-to,join:group_id=id,on_delete:CASCADE"`
+}
+
+func NewChapterGroup(chapterId, groupId string) ChapterGroup {
+  return ChapterGroup{
+    ChapterId: chapterId,
+    GroupId:   groupId,
+  }
+}
@@ -13,11 +13,16 @@ type Manga struct {
   bun.BaseModel       `bun:"table:mangas"`
   Id                  string         `bun:",pk,type:uuid"`
   Status              Status         `bun:",notnull"`
+  ContentRating       ContentRating  `bun:",notnull,default:0"`
+  Demographic         Demographic    `bun:",notnull,default:0"`
   Origin              common.Country `bun:",nullzero,notnull,type:varchar(2)"`
   OriginalTitle       string         `bun:",notnull,nullzero,unique,type:text"`
+  Slug                string         `bun:",nullzero,unique"`
   OriginalDescription string         `bun:",notnull,nullzero,type:text"`
   PublicationYear     uint16         `bun:",notnull,nullzero"`
   CoverURL            file.Name      `bun:",nullzero"`
+  AllowDownload       bool           `bun:",notnull,default:false"` // Allow chapters and volumes to be downloaded as archive
+  AllowExport         bool           `bun:",notnull,default:true"`  // Allow chapters and volumes to be exported as EPUB
   UpdatedAt           time.Time      `bun:",nullzero,notnull,default:current_timestamp"`
   CreatedAt           time.Time      `bun:",nullzero,notnull,default:current_timestamp"`
 
@@ -25,11 +30,16 @@ type Manga struct {
   TotalRater   uint64  `bun:",scanonly"`
   TotalComment uint64  `bun:",scanonly"`
 
-  Comments     []Comment     `bun:"rel:has-many,join:id=object_id,join:type=object_type,polymorphic"`
-  Ratings      []Rate        `bun:"rel:has-many,join:id=manga_id"`
-  Translations []Translation `bun:"rel:has-many,join:id=manga_id"`
-  Volumes      []Volume      `bun:"rel:has-many,join:id=manga_id"`
-  Genres       []Genre       `bun:"m2m:manga_genres,join:Manga=Genre"`
+  Comments          []Comment          `bun:"rel:has-many,join:id=object_id,join:type=object_type,polymorphic"`
+  Ratings           []Rate             `bun:"rel:has-many,join:id=manga_id"`
+  Translations      []Translation      `bun:"rel:has-many,join:id=manga_id"`
+  AlternativeTitles []AlternativeTitle `bun:"rel:has-many,join:id=manga_id"`
+  Volumes           []Volume           `bun:"rel:has-many,join:id=manga_id"`
+  Chapters          []Chapter          `bun:"rel:has-many,join:id=manga_id"`
+  Genres            []Genre            `bun:"m2m:manga_genres,join:Manga=Genre"`
+  Tags              []Tag              `bun:"m2m:manga_tags,join:Manga=Tag"`
+  Staff             []MangaStaff       `bun:"rel:has-many,join:id=manga_id"`
+  Relations         []MangaRelation    `bun:"rel:has-many,join:id=manga_id"`
 }
 
 func NewManga(title, desc, coverUrl string, year uint16, status Status, region countries.CountryCode) Manga {
@@ -39,6 +49,7 @@ func NewManga(title, desc, coverUrl string, year uint16, status Status, region c
     Status:              status,
     Origin:              common.Country(region.Alpha2()),
     OriginalTitle:       title,
+    Slug:                NewSlug(title),
     OriginalDescription: desc,
     PublicationYear:     year,
     CoverURL:            file.Name(coverUrl),
@@ -46,3 +57,14 @@ func NewManga(title, desc, coverUrl string, year uint16, status Status, region c
     CreatedAt:           currentTime,
   }
 }
+
+// FindTranslation find the translation of the manga in the language, it will return nil when the translations are not
+// loaded or there is no such translation
+func (m *Manga) FindTranslation(lang common.Language) *Translation {
+  for i := range m.Translations {
+    if m.Translations[i].Language == lang {
+      return &m.Translations[i]
+    }
+  }
+  return nil
+}
@@ -0,0 +1,29 @@
+package mangas
+
+import (
+  "github.com/google/uuid"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/common"
+)
+
+type AlternativeTitle struct {
+  bun.BaseModel `bun:"table:manga_alternative_titles"`
+
+  Id       string          `bun:",pk,type:uuid"`
+  MangaId  string          `bun:",nullzero,notnull,type:uuid,unique:alt_title_idx"`
+  Language common.Language `bun:",notnull,type:varchar(3)"`
+  Kind     TitleKind       `bun:",notnull"`
+  Title    string          `bun:",nullzero,notnull,unique:alt_title_idx"`
+
+  Manga *Manga `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+}
+
+func NewAlternativeTitle(mangaId, title string, lang common.Language, kind TitleKind) AlternativeTitle {
+  return AlternativeTitle{
+    Id:       uuid.NewString(),
+    MangaId:  mangaId,
+    Language: lang,
+    Kind:     kind,
+    Title:    title,
+  }
+}
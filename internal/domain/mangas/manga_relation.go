@@ -0,0 +1,42 @@
+package mangas
+
+import (
+  "github.com/uptrace/bun"
+  "time"
+)
+
+// MangaRelation used as directed edge between two mangas, the kind is read as "Related is <kind> of Manga"
+type MangaRelation struct {
+  bun.BaseModel `bun:"table:manga_relations"`
+
+  MangaId   string       `bun:",pk,type:uuid"`
+  RelatedId string       `bun:",pk,type:uuid"`
+  Kind      RelationKind `bun:",notnull"`
+  CreatedAt time.Time    `bun:",nullzero,notnull,default:current_timestamp"`
+
+  Manga   *Manga `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+  Related *Manga `bun:"rel:belongs-to,join:related_id=id,on_delete:CASCADE"`
+}
+
+func NewMangaRelation(mangaId, relatedId string, kind RelationKind) MangaRelation {
+  return MangaRelation{
+    MangaId:   mangaId,
+    RelatedId: relatedId,
+    Kind:      kind,
+    CreatedAt: time.Now(),
+  }
+}
+
+func (m *MangaRelation) IsSelfRelation() bool {
+  return m.MangaId == m.RelatedId
+}
+
+// Inverse get the relation from the related manga point of view, it will return false when the
+// relation kind has no meaningful inverse
+func (m *MangaRelation) Inverse() (MangaRelation, bool) {
+  kind, ok := m.Kind.Inverse()
+  if !ok {
+    return MangaRelation{}, false
+  }
+  return NewMangaRelation(m.RelatedId, m.MangaId, kind), true
+}
@@ -0,0 +1,55 @@
+package mangas
+
+import (
+  "errors"
+  "fmt"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/util"
+  "slices"
+  "time"
+)
+
+var ErrSlugAlreadyUsed = errors.New("slug is already used by other manga")
+
+// DefaultSlug used when the title has no alphanumeric characters
+const DefaultSlug = "manga"
+
+// MangaSlug used to keep the previous slugs of the manga, so the old links are still resolvable
+type MangaSlug struct {
+  bun.BaseModel `bun:"table:manga_slugs"`
+
+  Slug      string    `bun:",pk"`
+  MangaId   string    `bun:",nullzero,notnull,type:uuid"`
+  CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+
+  Manga *Manga `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+}
+
+func NewMangaSlug(mangaId, slug string) MangaSlug {
+  return MangaSlug{
+    Slug:      slug,
+    MangaId:   mangaId,
+    CreatedAt: time.Now(),
+  }
+}
+
+// NewSlug Create slug based on the title, the slug never looks like UUID, so it won't be mistaken for manga id
+func NewSlug(title string) string {
+  slug := util.Slugify(title)
+  if len(slug) == 0 {
+    return DefaultSlug
+  }
+  if util.IsUUID(slug) {
+    return fmt.Sprintf("%s-%s", DefaultSlug, slug)
+  }
+  return slug
+}
+
+// NextAvailableSlug Append number suffix on the slug when it is already used, e.g. slug-2, slug-3
+func NextAvailableSlug(slug string, used []string) string {
+  result := slug
+  for i := 2; slices.Contains(used, result); i++ {
+    result = fmt.Sprintf("%s-%d", slug, i)
+  }
+  return result
+}
@@ -0,0 +1,29 @@
+package mapper
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+)
+
+func ToAlternativeTitleResponse(title *mangas.AlternativeTitle) dto.AlternativeTitleResponse {
+  return dto.AlternativeTitleResponse{
+    Id:    title.Id,
+    Lang:  title.Language,
+    Kind:  title.Kind.String(),
+    Title: title.Title,
+  }
+}
+
+func MapAlternativeTitleInsertInput(input *dto.MangaAlternativeTitleInsertInput) ([]mangas.AlternativeTitle, error) {
+  result := make([]mangas.AlternativeTitle, 0, len(input.Titles))
+
+  for i := 0; i < len(input.Titles); i++ {
+    current := &input.Titles[i]
+    kind, err := mangas.NewTitleKind(current.Kind)
+    if err != nil {
+      return nil, err
+    }
+    result = append(result, mangas.NewAlternativeTitle(input.MangaId, current.Title, current.Lang.ParseLang(), kind))
+  }
+  return result, nil
+}
@@ -11,16 +11,39 @@ import (
 )
 
 func ToChapterResponse(chapter *mangas.Chapter, fs fileService.IFile) dto.ChapterResponse {
-  return dto.ChapterResponse{
+  response := dto.ChapterResponse{
     Id:           chapter.Id,
+    MangaId:      chapter.MangaId,
     Language:     chapter.Language.ParseLang(),
     Chapter:      chapter.Number,
+    Label:        chapter.Label,
+    Kind:         chapter.Kind.String(),
     Title:        chapter.Title,
     TotalComment: &chapter.TotalComment,
+    Read:         chapter.IsRead,
     CreatedAt:    chapter.CreatedAt,
     Comments:     containers.CastSlicePtr(chapter.Comments, toCommentResponse),
     Pages:        containers.CastSlicePtr1(chapter.Pages, fs, ToPageResponse),
     Translator:   mapper.ToUserResponse(chapter.Translator),
+    Groups:       containers.CastSlicePtr(chapter.Groups, ToGroupResponse),
+  }
+  if chapter.Manga != nil {
+    response.MangaTitle = chapter.Manga.OriginalTitle
+  }
+  return response
+}
+
+// ToChapterReferenceResponse map the chapter into navigation reference, it will return nil when the chapter is nil
+func ToChapterReferenceResponse(chapter *mangas.Chapter) *dto.ChapterReferenceResponse {
+  if chapter == nil {
+    return nil
+  }
+  return &dto.ChapterReferenceResponse{
+    Id:       chapter.Id,
+    Language: chapter.Language.ParseLang(),
+    Chapter:  chapter.Number,
+    Label:    chapter.Label,
+    Title:    chapter.Title,
   }
 }
 
@@ -29,38 +52,94 @@ func ToMinimalChapterResponse(chapter *mangas.Chapter) dto.ChapterResponse {
     Id:         chapter.Id,
     Language:   chapter.Language.ParseLang(),
     Chapter:    chapter.Number,
+    Label:      chapter.Label,
+    Kind:       chapter.Kind.String(),
     Title:      chapter.Title,
+    Read:       chapter.IsRead,
     CreatedAt:  chapter.CreatedAt,
     Comments:   containers.CastSlicePtr(chapter.Comments, toCommentResponse),
     Translator: mapper.ToUserResponse(chapter.Translator),
+    Groups:     containers.CastSlicePtr(chapter.Groups, ToGroupResponse),
   }
 }
 
-func MapChapterCreateInput(input *dto.ChapterCreateInput) mangas.Chapter {
+func MapMangaChaptersFindInput(input *dto.MangaChaptersFindInput) (mangas.ChapterFilter, error) {
+  // Ordered by the chapter number ascending by default
+  order := mangas.ChapterOrderNumber
+  if len(input.Order) != 0 {
+    var err error
+    order, err = mangas.NewChapterOrder(input.Order)
+    if err != nil {
+      return mangas.ChapterFilter{}, err
+    }
+  }
+
+  switch input.Direction {
+  case "", "asc", "desc":
+  default:
+    return mangas.ChapterFilter{}, mangas.ErrUnknownOrderDirection
+  }
+
+  filter := mangas.ChapterFilter{
+    MangaId:      input.MangaId,
+    UserId:       input.UserId,
+    VolumeId:     input.VolumeId,
+    TranslatorId: input.TranslatorId,
+    Order:        order,
+    IsDescending: input.Direction == "desc",
+  }
+  if len(input.Language) != 0 {
+    filter.Language = input.Language.ParseLang()
+  }
+  return filter, nil
+}
+
+func MapChapterCreateInput(input *dto.ChapterCreateInput) (mangas.Chapter, []mangas.ChapterGroup, error) {
+  // Kind is optional and will be defaulted to regular
+  kind := mangas.ChapterKindRegular
+  if len(input.Kind) != 0 {
+    var err error
+    kind, err = mangas.NewChapterKind(input.Kind)
+    if err != nil {
+      return mangas.Chapter{}, nil, err
+    }
+  }
+
   now := time.Now()
   chapter := mangas.Chapter{
     Id:           uuid.NewString(),
+    MangaId:      input.MangaId,
     VolumeId:     input.VolumeId,
     Language:     input.Language.ParseLang(),
     Title:        input.Title,
     TranslatorId: input.TranslatorId,
     PublishDate:  input.PublishDate,
     Number:       input.Chapter,
+    Label:        input.Label,
+    Kind:         kind,
     CreatedAt:    now,
     UpdatedAt:    now,
   }
 
-  return chapter
+  groups := []mangas.ChapterGroup{}
+  for _, v := range input.Groups {
+    groups = append(groups, mangas.NewChapterGroup(chapter.Id, v))
+  }
+
+  return chapter, groups, nil
 }
 
-func MapChapterEditInput(input *dto.ChapterEditInput) mangas.Chapter {
+func MapChapterEditInput(input *dto.ChapterEditInput) (mangas.Chapter, error) {
+  kind, err := mangas.NewChapterKind(input.Kind)
   return mangas.Chapter{
     Id:          input.ChapterId,
     VolumeId:    input.VolumeId,
     Language:    input.Language.ParseLang(),
     Title:       input.Title,
     Number:      input.Chapter,
+    Label:       input.Label,
+    Kind:        kind,
     PublishDate: input.PublishDate,
     UpdatedAt:   time.Now(),
-  }
+  }, err
 }
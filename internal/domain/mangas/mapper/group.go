@@ -0,0 +1,69 @@
+package mapper
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/util/containers"
+  "time"
+)
+
+func ToGroupResponse(group *mangas.Group) dto.GroupResponse {
+  return dto.GroupResponse{
+    Id:          group.Id,
+    Name:        group.Name,
+    Website:     group.Website,
+    Description: group.Description,
+    Members:     containers.CastSlicePtr(group.Members, toGroupMemberResponse),
+  }
+}
+
+func toGroupMemberResponse(member *mangas.GroupMember) dto.GroupMemberResponse {
+  response := dto.GroupMemberResponse{
+    UserId: member.UserId,
+    Role:   member.Role.String(),
+  }
+  if member.User != nil {
+    response.Username = member.User.Username
+  }
+  return response
+}
+
+func MapGroupCreateInput(input *dto.GroupCreateInput) mangas.Group {
+  return mangas.NewGroup(input.Name, input.Website, input.Description)
+}
+
+func MapGroupEditInput(input *dto.GroupEditInput) mangas.Group {
+  return mangas.Group{
+    Id:          input.Id,
+    Name:        input.Name,
+    Website:     input.Website,
+    Description: input.Description,
+    UpdatedAt:   time.Now(),
+  }
+}
+
+func MapGroupMemberEditInput(input *dto.GroupMemberEditInput) (additionals []mangas.GroupMember, removes []mangas.GroupMember, err error) {
+  for _, v := range input.AddMembers {
+    role, err := mangas.NewGroupRole(v.Role)
+    if err != nil {
+      return nil, nil, err
+    }
+    additionals = append(additionals, mangas.NewGroupMember(input.GroupId, v.UserId, role))
+  }
+
+  for _, v := range input.RemovedMembers {
+    removes = append(removes, mangas.GroupMember{GroupId: input.GroupId, UserId: v})
+  }
+  return additionals, removes, nil
+}
+
+func MapChapterGroupEditInput(input *dto.ChapterGroupEditInput) (additionals []mangas.ChapterGroup, removes []mangas.ChapterGroup) {
+  for _, v := range input.AddGroups {
+    additionals = append(additionals, mangas.NewChapterGroup(input.ChapterId, v))
+  }
+
+  for _, v := range input.RemovedGroups {
+    removes = append(removes, mangas.NewChapterGroup(input.ChapterId, v))
+  }
+  return additionals, removes
+}
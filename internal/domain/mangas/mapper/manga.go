@@ -12,35 +12,50 @@ import (
 
 func ToMangaResponse(manga *mangas.Manga, fs fileService.IFile) dto.MangaResponse {
   return dto.MangaResponse{
-    Id:              manga.Id,
-    Title:           manga.OriginalTitle,
-    Description:     manga.OriginalDescription,
-    Status:          manga.Status.String(),
-    Origin:          manga.Origin,
-    PublicationYear: manga.PublicationYear,
-    CoverURL:        fs.GetFullpath(file.CoverAsset, manga.CoverURL),
-    Rate:            manga.AverageRate,
-    TotalRater:      manga.TotalRater,
-    TotalComment:    manga.TotalComment,
-    Translations:    containers.CastSlicePtr(manga.Translations, ToTranslationResponse),
-    Volumes:         containers.CastSlicePtr1(manga.Volumes, fs, ToVolumeResponse),
-    Genres:          containers.CastSlicePtr(manga.Genres, ToGenreResponse),
+    Id:                manga.Id,
+    Slug:              manga.Slug,
+    Title:             manga.OriginalTitle,
+    Description:       manga.OriginalDescription,
+    Status:            manga.Status.String(),
+    ContentRating:     manga.ContentRating.String(),
+    Demographic:       manga.Demographic.String(),
+    Origin:            manga.Origin,
+    PublicationYear:   manga.PublicationYear,
+    CoverURL:          fs.GetFullpath(file.CoverAsset, manga.CoverURL),
+    AllowDownload:     manga.AllowDownload,
+    AllowExport:       manga.AllowExport,
+    Rate:              manga.AverageRate,
+    TotalRater:        manga.TotalRater,
+    TotalComment:      manga.TotalComment,
+    Translations:      containers.CastSlicePtr(manga.Translations, ToTranslationResponse),
+    AlternativeTitles: containers.CastSlicePtr(manga.AlternativeTitles, ToAlternativeTitleResponse),
+    Volumes:           containers.CastSlicePtr1(manga.Volumes, fs, ToVolumeResponse),
+    Chapters:          containers.CastSlicePtr1(manga.Chapters, fs, ToChapterResponse),
+    Genres:            containers.CastSlicePtr(manga.Genres, ToGenreResponse),
+    Tags:              containers.CastSlicePtr(manga.Tags, ToTagResponse),
+    Staff:             containers.CastSlicePtr(manga.Staff, ToStaffResponse),
+    Relations:         containers.CastSlicePtr1(manga.Relations, fs, ToMangaRelationResponse),
   }
 }
 
 func ToMinimalMangaResponse(manga *mangas.Manga, iFile fileService.IFile) dto.MinimalMangaResponse {
   return dto.MinimalMangaResponse{
-    Id:              manga.Id,
-    Title:           manga.OriginalTitle,
-    Description:     manga.OriginalDescription,
-    Status:          manga.Status.String(),
-    Origin:          manga.Origin,
-    PublicationYear: manga.PublicationYear,
-    CoverURL:        iFile.GetFullpath(file.MangaAsset, manga.CoverURL),
-    Rate:            manga.AverageRate,
-    TotalRater:      manga.TotalRater,
-    TotalComment:    manga.TotalComment,
-    Genres:          containers.CastSlicePtr(manga.Genres, ToGenreResponse),
+    Id:                manga.Id,
+    Slug:              manga.Slug,
+    Title:             manga.OriginalTitle,
+    Description:       manga.OriginalDescription,
+    Status:            manga.Status.String(),
+    ContentRating:     manga.ContentRating.String(),
+    Demographic:       manga.Demographic.String(),
+    Origin:            manga.Origin,
+    PublicationYear:   manga.PublicationYear,
+    CoverURL:          iFile.GetFullpath(file.CoverAsset, manga.CoverURL),
+    CoverThumbnailURL: iFile.GetVariantFullpath(file.CoverAsset, manga.CoverURL, file.VariantMedium),
+    Rate:              manga.AverageRate,
+    TotalRater:        manga.TotalRater,
+    TotalComment:      manga.TotalComment,
+    Genres:            containers.CastSlicePtr(manga.Genres, ToGenreResponse),
+    Tags:              containers.CastSlicePtr(manga.Tags, ToTagResponse),
   }
 }
 
@@ -60,28 +75,65 @@ func ToMangaFavoriteResponse(favorite *mangas.MangaFavorite, fl fileService.IFil
 
 func MapMangaCreateInput(input *dto.MangaCreateInput) (mangas.Manga, []mangas.MangaGenre, error) {
   status, err := mangas.NewStatus(input.Status)
+  if err != nil {
+    return mangas.Manga{}, nil, err
+  }
   manga := mangas.NewManga(input.Title, input.Description, "", input.PublicationYear,
     status, countries.ByName(string(input.Origin)))
 
+  // Content rating and demographic are optional and will be defaulted to safe and none
+  if len(input.ContentRating) != 0 {
+    manga.ContentRating, err = mangas.NewContentRating(input.ContentRating)
+    if err != nil {
+      return mangas.Manga{}, nil, err
+    }
+  }
+  if len(input.Demographic) != 0 {
+    manga.Demographic, err = mangas.NewDemographic(input.Demographic)
+    if err != nil {
+      return mangas.Manga{}, nil, err
+    }
+  }
+
   genres := []mangas.MangaGenre{}
   for _, v := range input.Genres {
     genres = append(genres, mangas.NewMangaGenre(manga.Id, v))
   }
 
-  return manga, genres, err
+  return manga, genres, nil
 }
 
 func MapMangaEditInput(input *dto.MangaEditInput) (mangas.Manga, error) {
   status, err := mangas.NewStatus(input.Status)
+  if err != nil {
+    return mangas.Manga{}, err
+  }
+  // Content rating is optional, the caller should keep the current one when it is empty
+  var rating mangas.ContentRating
+  if len(input.ContentRating) != 0 {
+    rating, err = mangas.NewContentRating(input.ContentRating)
+    if err != nil {
+      return mangas.Manga{}, err
+    }
+  }
+  demographic := mangas.DemographicNone
+  if len(input.Demographic) != 0 {
+    demographic, err = mangas.NewDemographic(input.Demographic)
+    if err != nil {
+      return mangas.Manga{}, err
+    }
+  }
   return mangas.Manga{
     Id:                  input.MangaId,
     Status:              status,
+    ContentRating:       rating,
+    Demographic:         demographic,
     Origin:              input.Origin,
     OriginalTitle:       input.Title,
     OriginalDescription: input.Description,
     PublicationYear:     input.PublicationYear,
     UpdatedAt:           time.Now(),
-  }, err
+  }, nil
 }
 
 func MapMangaGenreEditInput(input *dto.MangaGenreEditInput) (additionals []mangas.MangaGenre, removes []mangas.MangaGenre) {
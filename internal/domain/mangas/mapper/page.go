@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	commonMapper "manga-explorer/internal/common/mapper"
 	"manga-explorer/internal/domain/mangas"
 	"manga-explorer/internal/domain/mangas/dto"
 	"manga-explorer/internal/infrastructure/file"
@@ -9,8 +10,14 @@ import (
 
 func ToPageResponse(page *mangas.Page, fs fileService.IFile) dto.PageResponse {
 	return dto.PageResponse{
+		Id:       page.Id,
 		Page:     page.Number,
 		ImageURL: fs.GetFullpath(file.MangaAsset, page.ImageURL),
+		Width:    page.Width,
+		Height:   page.Height,
+		Size:     page.Size,
+		MimeType: page.MimeType,
+		BlurHash: page.BlurHash,
 	}
 }
 
@@ -28,3 +35,10 @@ func ToPageResponse(page *mangas.Page, fs fileService.IFile) dto.PageResponse {
 //func MapPageCreateInput(chapterId string , input *dto.InternalPage, filename file.Name) mangas.Page {
 //  return mangas.NewPage(chapterId, filename, input.Number)
 //}
+
+func ToPageUploadResponse(number uint16, info file.UploadInfo) dto.PageUploadResponse {
+	return dto.PageUploadResponse{
+		Page:           number,
+		UploadResponse: commonMapper.ToUploadResponse(info),
+	}
+}
@@ -0,0 +1,73 @@
+package mapper
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/util/containers"
+  "time"
+)
+
+func ToPersonResponse(person *mangas.Person) dto.PersonResponse {
+  return dto.PersonResponse{
+    Id:          person.Id,
+    Name:        person.Name,
+    NativeName:  person.NativeName,
+    Description: person.Description,
+    Works:       containers.CastSlicePtr(person.Works, toPersonWorkResponse),
+  }
+}
+
+func toPersonWorkResponse(staff *mangas.MangaStaff) dto.PersonWorkResponse {
+  response := dto.PersonWorkResponse{
+    MangaId: staff.MangaId,
+    Role:    staff.Role.String(),
+  }
+  if staff.Manga != nil {
+    response.Title = staff.Manga.OriginalTitle
+  }
+  return response
+}
+
+func ToStaffResponse(staff *mangas.MangaStaff) dto.StaffResponse {
+  response := dto.StaffResponse{
+    PersonId: staff.PersonId,
+    Role:     staff.Role.String(),
+  }
+  if staff.Person != nil {
+    response.Name = staff.Person.Name
+  }
+  return response
+}
+
+func MapPersonCreateInput(input *dto.PersonCreateInput) mangas.Person {
+  return mangas.NewPerson(input.Name, input.NativeName, input.Description)
+}
+
+func MapPersonEditInput(input *dto.PersonEditInput) mangas.Person {
+  return mangas.Person{
+    Id:          input.Id,
+    Name:        input.Name,
+    NativeName:  input.NativeName,
+    Description: input.Description,
+    UpdatedAt:   time.Now(),
+  }
+}
+
+func MapMangaStaffEditInput(input *dto.MangaStaffEditInput) (additionals []mangas.MangaStaff, removes []mangas.MangaStaff, err error) {
+  for _, v := range input.AddStaff {
+    role, err := mangas.NewStaffRole(v.Role)
+    if err != nil {
+      return nil, nil, err
+    }
+    additionals = append(additionals, mangas.NewMangaStaff(input.MangaId, v.PersonId, role))
+  }
+
+  for _, v := range input.RemovedStaff {
+    role, err := mangas.NewStaffRole(v.Role)
+    if err != nil {
+      return nil, nil, err
+    }
+    removes = append(removes, mangas.NewMangaStaff(input.MangaId, v.PersonId, role))
+  }
+  return additionals, removes, nil
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/infrastructure/file"
+  fileService "manga-explorer/internal/infrastructure/file/service"
+)
+
+func ToMangaRelationResponse(relation *mangas.MangaRelation, fs fileService.IFile) dto.MangaRelationResponse {
+  response := dto.MangaRelationResponse{
+    MangaId: relation.RelatedId,
+    Kind:    relation.Kind.String(),
+  }
+  if relation.Related != nil {
+    response.Title = relation.Related.OriginalTitle
+    response.CoverURL = fs.GetFullpath(file.CoverAsset, relation.Related.CoverURL)
+  }
+  return response
+}
+
+func MapMangaRelationCreateInput(input *dto.MangaRelationCreateInput) (mangas.MangaRelation, error) {
+  kind, err := mangas.NewRelationKind(input.Kind)
+  if err != nil {
+    return mangas.MangaRelation{}, err
+  }
+  return mangas.NewMangaRelation(input.MangaId, input.RelatedId, kind), nil
+}
@@ -8,7 +8,9 @@ import (
 func MapMangaSearchQuery(query *dto.MangaSearchQuery) mangas.SearchFilter {
   return mangas.SearchFilter{
     Title:           query.Title,
+    Staff:           query.Staff,
     Genres:          query.Genres,
+    Tags:            query.Tags,
     Origins:         query.Origin.Values,
     IsOriginInclude: query.Origin.IsInclude,
   }
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/dto"
+  "time"
+)
+
+func ToTagResponse(tag *mangas.Tag) dto.TagResponse {
+  return dto.TagResponse{
+    Id:    tag.Id,
+    Name:  tag.Name,
+    Group: tag.Group.String(),
+  }
+}
+
+func MapTagCreateInput(input *dto.TagCreateInput) (mangas.Tag, error) {
+  group, err := mangas.NewTagGroup(input.Group)
+  if err != nil {
+    return mangas.Tag{}, err
+  }
+  return mangas.NewTag(input.Name, group), nil
+}
+
+func MapTagUpdateInput(input *dto.TagEditInput) (mangas.Tag, error) {
+  group, err := mangas.NewTagGroup(input.Group)
+  return mangas.Tag{
+    Id:        input.Id,
+    Name:      input.Name,
+    Group:     group,
+    UpdatedAt: time.Now(),
+  }, err
+}
+
+func MapMangaTagEditInput(input *dto.MangaTagEditInput) (additionals []mangas.MangaTag, removes []mangas.MangaTag) {
+  for _, v := range input.AddTags {
+    additionals = append(additionals, mangas.NewMangaTag(input.MangaId, v))
+  }
+
+  for _, v := range input.RemovedTags {
+    removes = append(removes, mangas.NewMangaTag(input.MangaId, v))
+  }
+  return additionals, removes
+}
@@ -4,16 +4,19 @@ import (
   "github.com/google/uuid"
   "manga-explorer/internal/domain/mangas"
   "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/infrastructure/file"
   fileService "manga-explorer/internal/infrastructure/file/service"
   "manga-explorer/internal/util/containers"
 )
 
 func ToVolumeResponse(volume *mangas.Volume, fs fileService.IFile) dto.VolumeResponse {
   return dto.VolumeResponse{
-    Id:       volume.Id,
-    Title:    volume.Title,
-    Number:   volume.Number,
-    Chapters: containers.CastSlicePtr1(volume.Chapters, fs, ToChapterResponse),
+    Id:          volume.Id,
+    Title:       volume.Title,
+    Description: volume.Description,
+    Number:      volume.Number,
+    CoverURL:    fs.GetFullpath(file.VolumeAsset, volume.CoverURL),
+    Chapters:    containers.CastSlicePtr1(volume.Chapters, fs, ToChapterResponse),
   }
 }
 
@@ -26,3 +29,12 @@ func MapVolumeCreateInput(input *dto.VolumeCreateInput) mangas.Volume {
     Number:      input.Number,
   }
 }
+
+func MapVolumeEditInput(input *dto.VolumeEditInput) mangas.Volume {
+  return mangas.Volume{
+    Id:          input.VolumeId,
+    Title:       input.Title,
+    Description: input.Description,
+    Number:      input.Number,
+  }
+}
@@ -1,15 +1,23 @@
 package mangas
 
 import (
+  "errors"
   "github.com/google/uuid"
   "manga-explorer/internal/infrastructure/file"
 )
 
+var ErrPageOrderMismatch = errors.New("page order should contain all pages of the chapter")
+
 type Page struct {
   Id        string    `bun:",pk,type:uuid"`
   ChapterId string    `bun:",nullzero,notnull,unique:page_chapter_idx,type:uuid"`
   Number    uint16    `bun:",nullzero,notnull,unique:page_chapter_idx"`
   ImageURL  file.Name `bun:",notnull,nullzero"`
+  Width     uint32    `bun:",nullzero"`
+  Height    uint32    `bun:",nullzero"`
+  Size      int64     `bun:",nullzero"` // In bytes
+  MimeType  string    `bun:",nullzero"`
+  BlurHash  string    `bun:",nullzero"` // Placeholder shown while the image is loading
 
   Chapter *Chapter `bun:"rel:belongs-to,join:chapter_id=id,on_delete:CASCADE"`
 }
@@ -22,3 +30,25 @@ func NewPage(chapterId string, filename file.Name, number uint16) Page {
     ImageURL:  filename,
   }
 }
+
+// SetImageInfo set the metadata of the page image
+func (p *Page) SetImageInfo(info file.ImageInfo) {
+  p.Width = info.Width
+  p.Height = info.Height
+  p.Size = info.Size
+  p.MimeType = info.MimeType
+  p.BlurHash = info.BlurHash
+}
+
+// SetUploadInfo set the size and mime type of the stored image, which could be different from the uploaded one after
+// it is processed
+func (p *Page) SetUploadInfo(info file.UploadInfo) {
+  p.Size = info.Size
+  p.MimeType = info.Format.MimeType()
+}
+
+// HasImageInfo check if the metadata of the page image is already set, pages uploaded before the metadata was
+// introduced doesn't have it
+func (p *Page) HasImageInfo() bool {
+  return p.Width != 0 && p.Height != 0
+}
@@ -0,0 +1,53 @@
+package mangas
+
+import (
+  "github.com/google/uuid"
+  "github.com/uptrace/bun"
+  "time"
+)
+
+type Person struct {
+  bun.BaseModel `bun:"table:people"`
+
+  Id          string `bun:",pk,type:uuid"`
+  Name        string `bun:",nullzero,notnull"`
+  NativeName  string `bun:",nullzero"`
+  Description string `bun:",nullzero,type:text"`
+
+  UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+  CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+
+  Works []MangaStaff `bun:"rel:has-many,join:id=person_id"`
+}
+
+func NewPerson(name, nativeName, desc string) Person {
+  currentTime := time.Now()
+  return Person{
+    Id:          uuid.NewString(),
+    Name:        name,
+    NativeName:  nativeName,
+    Description: desc,
+    UpdatedAt:   currentTime,
+    CreatedAt:   currentTime,
+  }
+}
+
+// MangaStaff used for people credited on each manga, the same person could be credited with different roles
+type MangaStaff struct {
+  bun.BaseModel `bun:"table:manga_staff"`
+
+  MangaId  string    `bun:",pk,type:uuid"`
+  PersonId string    `bun:",pk,type:uuid"`
+  Role     StaffRole `bun:",pk"`
+
+  Manga  *Manga  `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+  Person *Person `bun:"rel:belongs-to,join:person_id=id,on_delete:CASCADE"`
+}
+
+func NewMangaStaff(mangaId, personId string, role StaffRole) MangaStaff {
+  return MangaStaff{
+    MangaId:  mangaId,
+    PersonId: personId,
+    Role:     role,
+  }
+}
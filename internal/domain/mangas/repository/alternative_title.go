@@ -0,0 +1,10 @@
+package repository
+
+import "manga-explorer/internal/domain/mangas"
+
+type IAlternativeTitle interface {
+  Create(titles []mangas.AlternativeTitle) error
+  FindByMangaId(mangaId string) ([]mangas.AlternativeTitle, error)
+  // DeleteMangaSpecific delete alternative titles which are belongs to the manga
+  DeleteMangaSpecific(mangaId string, titleIds []string) error
+}
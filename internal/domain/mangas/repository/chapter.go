@@ -1,19 +1,50 @@
 package repository
 
 import (
+  "github.com/uptrace/bun"
   "manga-explorer/internal/domain/mangas"
   repo "manga-explorer/internal/infrastructure/repository"
 )
 
 type IChapter interface {
-  CreateChapter(chapter *mangas.Chapter) error
+  // CreateChapter insert chapter and the credited groups, it will return mangas.ErrGroupChapterDuplicate when the group already has the same chapter
+  // and mangas.ErrChapterDuplicate when the chapter has no group and the same chapter without group already exists
+  CreateChapter(chapter *mangas.Chapter, groups []mangas.ChapterGroup) error
+  // EditChapter update the chapter, it will return mangas.ErrGroupChapterDuplicate when the credited group already has the same chapter
+  // and mangas.ErrChapterDuplicate when the chapter has no group and the same chapter without group already exists
   EditChapter(chapter *mangas.Chapter) error
+  // EditChapterGroups add or remove credited groups of the chapter, it will return mangas.ErrGroupChapterDuplicate when the group already has the same chapter
+  // and mangas.ErrChapterDuplicate when all groups are removed and the same chapter without group already exists
+  EditChapterGroups(additional, removes []mangas.ChapterGroup) error
+  // MoveChapters set the volume of the chapters, use empty volumeId to detach the chapters from the volume. It will return
+  // mangas.ErrGroupChapterDuplicate when the credited group already has the same chapter on the volume and
+  // mangas.ErrChapterDuplicate when the chapter has no group and the same chapter without group is on the volume
+  MoveChapters(mangaId, volumeId string, chapterIds []string) error
   DeleteChapter(chapterId string) error
   FindChapter(id string) (*mangas.Chapter, error)
+  // FindSiblingChapters find the previous and next chapters, the chapters credited to the same group are prioritized,
+  // then the same language, then any chapter. The chapter will be nil when there is no such chapter
+  FindSiblingChapters(chapter *mangas.Chapter) (prev *mangas.Chapter, next *mangas.Chapter, err error)
+  // FindMangaChapters find chapters of the manga based on the filter, chapters will be flagged as read when the filter has user
+  FindMangaChapters(filter *mangas.ChapterFilter, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Chapter], error)
   FindVolumeDetails(volumeId string) (*mangas.Volume, error)
+  // FindChapterArchive find chapter with the pages and the metadata used for the archive and EPUB, including the manga
+  FindChapterArchive(chapterId string) (*mangas.Chapter, error)
+  // FindVolumeArchive find volume with the manga and the chapters, each chapter has the pages and the metadata used for the archive
+  FindVolumeArchive(volumeId string) (*mangas.Volume, error)
   FindPagesDetails(chapterId string, pages []uint16) ([]mangas.Page, error)
   DeleteChapterPages(chapterId string, pages []uint16) error
-  InsertChapterPages(pages []mangas.Page) error
+  // InsertChapterPages insert the pages in a transaction, commit is called with the transaction after the pages are
+  // inserted and the transaction is only committed when it returns nil, so the pages are not stored without the
+  // images. The commit could be nil when the images are already stored
+  InsertChapterPages(pages []mangas.Page, commit func(tx bun.IDB) error) error
+  // ReorderChapterPages renumber the pages of the chapter based on the order of the ids, the ids should contain all
+  // pages of the chapter, otherwise mangas.ErrPageOrderMismatch is returned
+  ReorderChapterPages(chapterId string, pageIds []string) error
+  // ReplaceChapterPage replace the image and the image metadata of the page with the same chapter and number, it
+  // returns the previous page, so the previous image can be deleted. The commit is called with the transaction like
+  // InsertChapterPages
+  ReplaceChapterPage(page *mangas.Page, commit func(tx bun.IDB) error) (*mangas.Page, error)
   InsertChapterHistories(history *mangas.ChapterHistory) error
   FindMangaChapterHistories(userId string, mangaId string, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Chapter], error)
 }
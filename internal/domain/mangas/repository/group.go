@@ -0,0 +1,20 @@
+package repository
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/infrastructure/repository"
+)
+
+type IGroup interface {
+  CreateGroup(group *mangas.Group) error
+  UpdateGroup(group *mangas.Group) error
+  DeleteGroupById(groupId string) error
+  // FindGroupById Get group with all the members
+  FindGroupById(groupId string) (*mangas.Group, error)
+  // ListGroups Get all groups which name is similar to the name parameter, set name to empty string to get all of them
+  ListGroups(name string, parameter repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Group], error)
+  // EditGroupMembers add or remove members, the role will be updated when the member is already exists
+  EditGroupMembers(additional, removes []mangas.GroupMember) error
+  // FindGroupChapters Get all chapters credited to the group ordered by the newest
+  FindGroupChapters(groupId string, parameter repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Chapter], error)
+}
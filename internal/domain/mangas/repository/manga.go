@@ -10,19 +10,40 @@ type IManga interface {
   EditManga(manga *mangas.Manga) error
   PatchManga(manga *mangas.Manga) error
   EditMangaGenres(additional, removes []mangas.MangaGenre) error
+  EditMangaTags(additional, removes []mangas.MangaTag) error
+  EditMangaStaff(additional, removes []mangas.MangaStaff) error
+  // CreateMangaRelation insert the relation and the inverse relation when the relation kind has it
+  CreateMangaRelation(relation *mangas.MangaRelation) error
+  // DeleteMangaRelation delete relation between both mangas on both directions
+  DeleteMangaRelation(mangaId, relatedId string) error
+  FindMangaRelations(mangaId string) ([]mangas.MangaRelation, error)
   FindMinimalMangaById(id string) (*mangas.Manga, error)
+  // FindMangaIdBySlug Get manga id by the current slug or the previous slugs
+  FindMangaIdBySlug(slug string) (string, error)
+  // FindSimilarSlugs Get all current and previous slugs which is the same or prefixed by the slug
+  FindSimilarSlugs(slug string) ([]string, error)
+  // UpdateMangaSlug Change the slug and keep the previous one, it will return mangas.ErrSlugAlreadyUsed when the slug is used by other manga
+  UpdateMangaSlug(mangaId, slug string) error
+  // EditMangaDownload allow or disallow the chapters and volumes of the manga to be downloaded
+  EditMangaDownload(mangaId string, allowed bool) error
+  // EditMangaExport allow or disallow the chapters and volumes of the manga to be exported as EPUB
+  EditMangaExport(mangaId string, allowed bool) error
   FindMangasById(ids ...string) ([]mangas.Manga, error)
   // FindMangasByFilter Get manga based on the filter specified, set limit and offset both to 0 to get all the mangas
   FindMangasByFilter(filter *mangas.SearchFilter, pagedQuery repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Manga], error)
   // FindRandomMangas Get manga which will be returning different manga for each call, set limit to 0 to get all the mangas
-  FindRandomMangas(limit uint64) ([]mangas.Manga, error)
-  FindMangaHistories(userId string, pagedQuery repository.QueryParameter) (repository.PagedQueryResult[[]mangas.MangaHistory], error)
+  FindRandomMangas(limit uint64, ratings []mangas.ContentRating) ([]mangas.Manga, error)
+  FindMangaHistories(userId string, ratings []mangas.ContentRating, pagedQuery repository.QueryParameter) (repository.PagedQueryResult[[]mangas.MangaHistory], error)
   // FindMangaFavorites Find favorites mangas by userId, returning favorites mangas and total favorites mangas on user
-  FindMangaFavorites(userId string, pagedQuery repository.QueryParameter) (repository.PagedQueryResult[[]mangas.MangaFavorite], error)
+  FindMangaFavorites(userId string, ratings []mangas.ContentRating, pagedQuery repository.QueryParameter) (repository.PagedQueryResult[[]mangas.MangaFavorite], error)
   InsertMangaFavorite(favorite *mangas.MangaFavorite) error
   RemoveMangaFavorite(favorite *mangas.MangaFavorite) error
-  // ListMangas Get all manga based on the offset and limit, set limit and offset both to 0 to get all the mangas
-  ListMangas(parameter repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Manga], error)
+  // ListMangas Get all manga which has the content ratings based on the offset and limit, set limit and offset both to 0 to get all the mangas
+  ListMangas(ratings []mangas.ContentRating, parameter repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Manga], error)
   CreateVolume(volume *mangas.Volume) error
+  // EditVolume update title, description and number of the volume
+  EditVolume(volume *mangas.Volume) error
+  PatchVolume(volume *mangas.Volume) error
+  FindVolumeById(id string) (*mangas.Volume, error)
   DeleteVolume(mangaId string, volumes []uint32) error
 }
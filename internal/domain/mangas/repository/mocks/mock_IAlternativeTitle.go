@@ -0,0 +1,187 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package repository
+
+import (
+	mangas "manga-explorer/internal/domain/mangas"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlternativeTitleMock is an autogenerated mock type for the IAlternativeTitle type
+type AlternativeTitleMock struct {
+	mock.Mock
+}
+
+type AlternativeTitleMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AlternativeTitleMock) EXPECT() *AlternativeTitleMock_Expecter {
+	return &AlternativeTitleMock_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: titles
+func (_m *AlternativeTitleMock) Create(titles []mangas.AlternativeTitle) error {
+	ret := _m.Called(titles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]mangas.AlternativeTitle) error); ok {
+		r0 = rf(titles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlternativeTitleMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AlternativeTitleMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - titles []mangas.AlternativeTitle
+func (_e *AlternativeTitleMock_Expecter) Create(titles interface{}) *AlternativeTitleMock_Create_Call {
+	return &AlternativeTitleMock_Create_Call{Call: _e.mock.On("Create", titles)}
+}
+
+func (_c *AlternativeTitleMock_Create_Call) Run(run func(titles []mangas.AlternativeTitle)) *AlternativeTitleMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]mangas.AlternativeTitle))
+	})
+	return _c
+}
+
+func (_c *AlternativeTitleMock_Create_Call) Return(_a0 error) *AlternativeTitleMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlternativeTitleMock_Create_Call) RunAndReturn(run func([]mangas.AlternativeTitle) error) *AlternativeTitleMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMangaSpecific provides a mock function with given fields: mangaId, titleIds
+func (_m *AlternativeTitleMock) DeleteMangaSpecific(mangaId string, titleIds []string) error {
+	ret := _m.Called(mangaId, titleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMangaSpecific")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(mangaId, titleIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlternativeTitleMock_DeleteMangaSpecific_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMangaSpecific'
+type AlternativeTitleMock_DeleteMangaSpecific_Call struct {
+	*mock.Call
+}
+
+// DeleteMangaSpecific is a helper method to define mock.On call
+//   - mangaId string
+//   - titleIds []string
+func (_e *AlternativeTitleMock_Expecter) DeleteMangaSpecific(mangaId interface{}, titleIds interface{}) *AlternativeTitleMock_DeleteMangaSpecific_Call {
+	return &AlternativeTitleMock_DeleteMangaSpecific_Call{Call: _e.mock.On("DeleteMangaSpecific", mangaId, titleIds)}
+}
+
+func (_c *AlternativeTitleMock_DeleteMangaSpecific_Call) Run(run func(mangaId string, titleIds []string)) *AlternativeTitleMock_DeleteMangaSpecific_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *AlternativeTitleMock_DeleteMangaSpecific_Call) Return(_a0 error) *AlternativeTitleMock_DeleteMangaSpecific_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlternativeTitleMock_DeleteMangaSpecific_Call) RunAndReturn(run func(string, []string) error) *AlternativeTitleMock_DeleteMangaSpecific_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMangaId provides a mock function with given fields: mangaId
+func (_m *AlternativeTitleMock) FindByMangaId(mangaId string) ([]mangas.AlternativeTitle, error) {
+	ret := _m.Called(mangaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMangaId")
+	}
+
+	var r0 []mangas.AlternativeTitle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]mangas.AlternativeTitle, error)); ok {
+		return rf(mangaId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []mangas.AlternativeTitle); ok {
+		r0 = rf(mangaId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mangas.AlternativeTitle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(mangaId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlternativeTitleMock_FindByMangaId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMangaId'
+type AlternativeTitleMock_FindByMangaId_Call struct {
+	*mock.Call
+}
+
+// FindByMangaId is a helper method to define mock.On call
+//   - mangaId string
+func (_e *AlternativeTitleMock_Expecter) FindByMangaId(mangaId interface{}) *AlternativeTitleMock_FindByMangaId_Call {
+	return &AlternativeTitleMock_FindByMangaId_Call{Call: _e.mock.On("FindByMangaId", mangaId)}
+}
+
+func (_c *AlternativeTitleMock_FindByMangaId_Call) Run(run func(mangaId string)) *AlternativeTitleMock_FindByMangaId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AlternativeTitleMock_FindByMangaId_Call) Return(_a0 []mangas.AlternativeTitle, _a1 error) *AlternativeTitleMock_FindByMangaId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlternativeTitleMock_FindByMangaId_Call) RunAndReturn(run func(string) ([]mangas.AlternativeTitle, error)) *AlternativeTitleMock_FindByMangaId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAlternativeTitleMock creates a new instance of AlternativeTitleMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlternativeTitleMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlternativeTitleMock {
+	mock := &AlternativeTitleMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	mangas "manga-explorer/internal/domain/mangas"
 	infrastructurerepository "manga-explorer/internal/infrastructure/repository"
 
+	bun "github.com/uptrace/bun"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,17 +24,17 @@ func (_m *ChapterMock) EXPECT() *ChapterMock_Expecter {
 	return &ChapterMock_Expecter{mock: &_m.Mock}
 }
 
-// CreateChapter provides a mock function with given fields: chapter
-func (_m *ChapterMock) CreateChapter(chapter *mangas.Chapter) error {
-	ret := _m.Called(chapter)
+// CreateChapter provides a mock function with given fields: chapter, groups
+func (_m *ChapterMock) CreateChapter(chapter *mangas.Chapter, groups []mangas.ChapterGroup) error {
+	ret := _m.Called(chapter, groups)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateChapter")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*mangas.Chapter) error); ok {
-		r0 = rf(chapter)
+	if rf, ok := ret.Get(0).(func(*mangas.Chapter, []mangas.ChapterGroup) error); ok {
+		r0 = rf(chapter, groups)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,13 +49,14 @@ type ChapterMock_CreateChapter_Call struct {
 
 // CreateChapter is a helper method to define mock.On call
 //   - chapter *mangas.Chapter
-func (_e *ChapterMock_Expecter) CreateChapter(chapter interface{}) *ChapterMock_CreateChapter_Call {
-	return &ChapterMock_CreateChapter_Call{Call: _e.mock.On("CreateChapter", chapter)}
+//   - groups []mangas.ChapterGroup
+func (_e *ChapterMock_Expecter) CreateChapter(chapter interface{}, groups interface{}) *ChapterMock_CreateChapter_Call {
+	return &ChapterMock_CreateChapter_Call{Call: _e.mock.On("CreateChapter", chapter, groups)}
 }
 
-func (_c *ChapterMock_CreateChapter_Call) Run(run func(chapter *mangas.Chapter)) *ChapterMock_CreateChapter_Call {
+func (_c *ChapterMock_CreateChapter_Call) Run(run func(chapter *mangas.Chapter, groups []mangas.ChapterGroup)) *ChapterMock_CreateChapter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*mangas.Chapter))
+		run(args[0].(*mangas.Chapter), args[1].([]mangas.ChapterGroup))
 	})
 	return _c
 }
@@ -63,7 +66,7 @@ func (_c *ChapterMock_CreateChapter_Call) Return(_a0 error) *ChapterMock_CreateC
 	return _c
 }
 
-func (_c *ChapterMock_CreateChapter_Call) RunAndReturn(run func(*mangas.Chapter) error) *ChapterMock_CreateChapter_Call {
+func (_c *ChapterMock_CreateChapter_Call) RunAndReturn(run func(*mangas.Chapter, []mangas.ChapterGroup) error) *ChapterMock_CreateChapter_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -207,6 +210,53 @@ func (_c *ChapterMock_EditChapter_Call) RunAndReturn(run func(*mangas.Chapter) e
 	return _c
 }
 
+// EditChapterGroups provides a mock function with given fields: additional, removes
+func (_m *ChapterMock) EditChapterGroups(additional []mangas.ChapterGroup, removes []mangas.ChapterGroup) error {
+	ret := _m.Called(additional, removes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditChapterGroups")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]mangas.ChapterGroup, []mangas.ChapterGroup) error); ok {
+		r0 = rf(additional, removes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChapterMock_EditChapterGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditChapterGroups'
+type ChapterMock_EditChapterGroups_Call struct {
+	*mock.Call
+}
+
+// EditChapterGroups is a helper method to define mock.On call
+//   - additional []mangas.ChapterGroup
+//   - removes []mangas.ChapterGroup
+func (_e *ChapterMock_Expecter) EditChapterGroups(additional interface{}, removes interface{}) *ChapterMock_EditChapterGroups_Call {
+	return &ChapterMock_EditChapterGroups_Call{Call: _e.mock.On("EditChapterGroups", additional, removes)}
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) Run(run func(additional []mangas.ChapterGroup, removes []mangas.ChapterGroup)) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]mangas.ChapterGroup), args[1].([]mangas.ChapterGroup))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) Return(_a0 error) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) RunAndReturn(run func([]mangas.ChapterGroup, []mangas.ChapterGroup) error) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindChapter provides a mock function with given fields: id
 func (_m *ChapterMock) FindChapter(id string) (*mangas.Chapter, error) {
 	ret := _m.Called(id)
@@ -265,6 +315,64 @@ func (_c *ChapterMock_FindChapter_Call) RunAndReturn(run func(string) (*mangas.C
 	return _c
 }
 
+// FindChapterArchive provides a mock function with given fields: chapterId
+func (_m *ChapterMock) FindChapterArchive(chapterId string) (*mangas.Chapter, error) {
+	ret := _m.Called(chapterId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindChapterArchive")
+	}
+
+	var r0 *mangas.Chapter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*mangas.Chapter, error)); ok {
+		return rf(chapterId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *mangas.Chapter); ok {
+		r0 = rf(chapterId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Chapter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(chapterId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_FindChapterArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindChapterArchive'
+type ChapterMock_FindChapterArchive_Call struct {
+	*mock.Call
+}
+
+// FindChapterArchive is a helper method to define mock.On call
+//   - chapterId string
+func (_e *ChapterMock_Expecter) FindChapterArchive(chapterId interface{}) *ChapterMock_FindChapterArchive_Call {
+	return &ChapterMock_FindChapterArchive_Call{Call: _e.mock.On("FindChapterArchive", chapterId)}
+}
+
+func (_c *ChapterMock_FindChapterArchive_Call) Run(run func(chapterId string)) *ChapterMock_FindChapterArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_FindChapterArchive_Call) Return(_a0 *mangas.Chapter, _a1 error) *ChapterMock_FindChapterArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_FindChapterArchive_Call) RunAndReturn(run func(string) (*mangas.Chapter, error)) *ChapterMock_FindChapterArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindMangaChapterHistories provides a mock function with given fields: userId, mangaId, pagedQuery
 func (_m *ChapterMock) FindMangaChapterHistories(userId string, mangaId string, pagedQuery infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error) {
 	ret := _m.Called(userId, mangaId, pagedQuery)
@@ -323,6 +431,63 @@ func (_c *ChapterMock_FindMangaChapterHistories_Call) RunAndReturn(run func(stri
 	return _c
 }
 
+// FindMangaChapters provides a mock function with given fields: filter, parameter
+func (_m *ChapterMock) FindMangaChapters(filter *mangas.ChapterFilter, parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error) {
+	ret := _m.Called(filter, parameter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaChapters")
+	}
+
+	var r0 infrastructurerepository.PagedQueryResult[[]mangas.Chapter]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*mangas.ChapterFilter, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error)); ok {
+		return rf(filter, parameter)
+	}
+	if rf, ok := ret.Get(0).(func(*mangas.ChapterFilter, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Chapter]); ok {
+		r0 = rf(filter, parameter)
+	} else {
+		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.Chapter])
+	}
+
+	if rf, ok := ret.Get(1).(func(*mangas.ChapterFilter, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(filter, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_FindMangaChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaChapters'
+type ChapterMock_FindMangaChapters_Call struct {
+	*mock.Call
+}
+
+// FindMangaChapters is a helper method to define mock.On call
+//   - filter *mangas.ChapterFilter
+//   - parameter infrastructurerepository.QueryParameter
+func (_e *ChapterMock_Expecter) FindMangaChapters(filter interface{}, parameter interface{}) *ChapterMock_FindMangaChapters_Call {
+	return &ChapterMock_FindMangaChapters_Call{Call: _e.mock.On("FindMangaChapters", filter, parameter)}
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) Run(run func(filter *mangas.ChapterFilter, parameter infrastructurerepository.QueryParameter)) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.ChapterFilter), args[1].(infrastructurerepository.QueryParameter))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) Return(_a0 infrastructurerepository.PagedQueryResult[[]mangas.Chapter], _a1 error) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) RunAndReturn(run func(*mangas.ChapterFilter, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error)) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindPagesDetails provides a mock function with given fields: chapterId, pages
 func (_m *ChapterMock) FindPagesDetails(chapterId string, pages []uint16) ([]mangas.Page, error) {
 	ret := _m.Called(chapterId, pages)
@@ -382,6 +547,131 @@ func (_c *ChapterMock_FindPagesDetails_Call) RunAndReturn(run func(string, []uin
 	return _c
 }
 
+// FindSiblingChapters provides a mock function with given fields: chapter
+func (_m *ChapterMock) FindSiblingChapters(chapter *mangas.Chapter) (*mangas.Chapter, *mangas.Chapter, error) {
+	ret := _m.Called(chapter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSiblingChapters")
+	}
+
+	var r0 *mangas.Chapter
+	var r1 *mangas.Chapter
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*mangas.Chapter) (*mangas.Chapter, *mangas.Chapter, error)); ok {
+		return rf(chapter)
+	}
+	if rf, ok := ret.Get(0).(func(*mangas.Chapter) *mangas.Chapter); ok {
+		r0 = rf(chapter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Chapter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*mangas.Chapter) *mangas.Chapter); ok {
+		r1 = rf(chapter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*mangas.Chapter)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*mangas.Chapter) error); ok {
+		r2 = rf(chapter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ChapterMock_FindSiblingChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSiblingChapters'
+type ChapterMock_FindSiblingChapters_Call struct {
+	*mock.Call
+}
+
+// FindSiblingChapters is a helper method to define mock.On call
+//   - chapter *mangas.Chapter
+func (_e *ChapterMock_Expecter) FindSiblingChapters(chapter interface{}) *ChapterMock_FindSiblingChapters_Call {
+	return &ChapterMock_FindSiblingChapters_Call{Call: _e.mock.On("FindSiblingChapters", chapter)}
+}
+
+func (_c *ChapterMock_FindSiblingChapters_Call) Run(run func(chapter *mangas.Chapter)) *ChapterMock_FindSiblingChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Chapter))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_FindSiblingChapters_Call) Return(prev *mangas.Chapter, next *mangas.Chapter, err error) *ChapterMock_FindSiblingChapters_Call {
+	_c.Call.Return(prev, next, err)
+	return _c
+}
+
+func (_c *ChapterMock_FindSiblingChapters_Call) RunAndReturn(run func(*mangas.Chapter) (*mangas.Chapter, *mangas.Chapter, error)) *ChapterMock_FindSiblingChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindVolumeArchive provides a mock function with given fields: volumeId
+func (_m *ChapterMock) FindVolumeArchive(volumeId string) (*mangas.Volume, error) {
+	ret := _m.Called(volumeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindVolumeArchive")
+	}
+
+	var r0 *mangas.Volume
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*mangas.Volume, error)); ok {
+		return rf(volumeId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *mangas.Volume); ok {
+		r0 = rf(volumeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Volume)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(volumeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_FindVolumeArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindVolumeArchive'
+type ChapterMock_FindVolumeArchive_Call struct {
+	*mock.Call
+}
+
+// FindVolumeArchive is a helper method to define mock.On call
+//   - volumeId string
+func (_e *ChapterMock_Expecter) FindVolumeArchive(volumeId interface{}) *ChapterMock_FindVolumeArchive_Call {
+	return &ChapterMock_FindVolumeArchive_Call{Call: _e.mock.On("FindVolumeArchive", volumeId)}
+}
+
+func (_c *ChapterMock_FindVolumeArchive_Call) Run(run func(volumeId string)) *ChapterMock_FindVolumeArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_FindVolumeArchive_Call) Return(_a0 *mangas.Volume, _a1 error) *ChapterMock_FindVolumeArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_FindVolumeArchive_Call) RunAndReturn(run func(string) (*mangas.Volume, error)) *ChapterMock_FindVolumeArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindVolumeDetails provides a mock function with given fields: volumeId
 func (_m *ChapterMock) FindVolumeDetails(volumeId string) (*mangas.Volume, error) {
 	ret := _m.Called(volumeId)
@@ -486,17 +776,17 @@ func (_c *ChapterMock_InsertChapterHistories_Call) RunAndReturn(run func(*mangas
 	return _c
 }
 
-// InsertChapterPages provides a mock function with given fields: pages
-func (_m *ChapterMock) InsertChapterPages(pages []mangas.Page) error {
-	ret := _m.Called(pages)
+// InsertChapterPages provides a mock function with given fields: pages, commit
+func (_m *ChapterMock) InsertChapterPages(pages []mangas.Page, commit func(bun.IDB) error) error {
+	ret := _m.Called(pages, commit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertChapterPages")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]mangas.Page) error); ok {
-		r0 = rf(pages)
+	if rf, ok := ret.Get(0).(func([]mangas.Page, func(bun.IDB) error) error); ok {
+		r0 = rf(pages, commit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -511,13 +801,14 @@ type ChapterMock_InsertChapterPages_Call struct {
 
 // InsertChapterPages is a helper method to define mock.On call
 //   - pages []mangas.Page
-func (_e *ChapterMock_Expecter) InsertChapterPages(pages interface{}) *ChapterMock_InsertChapterPages_Call {
-	return &ChapterMock_InsertChapterPages_Call{Call: _e.mock.On("InsertChapterPages", pages)}
+//   - commit func(bun.IDB) error
+func (_e *ChapterMock_Expecter) InsertChapterPages(pages interface{}, commit interface{}) *ChapterMock_InsertChapterPages_Call {
+	return &ChapterMock_InsertChapterPages_Call{Call: _e.mock.On("InsertChapterPages", pages, commit)}
 }
 
-func (_c *ChapterMock_InsertChapterPages_Call) Run(run func(pages []mangas.Page)) *ChapterMock_InsertChapterPages_Call {
+func (_c *ChapterMock_InsertChapterPages_Call) Run(run func(pages []mangas.Page, commit func(bun.IDB) error)) *ChapterMock_InsertChapterPages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]mangas.Page))
+		run(args[0].([]mangas.Page), args[1].(func(bun.IDB) error))
 	})
 	return _c
 }
@@ -527,7 +818,161 @@ func (_c *ChapterMock_InsertChapterPages_Call) Return(_a0 error) *ChapterMock_In
 	return _c
 }
 
-func (_c *ChapterMock_InsertChapterPages_Call) RunAndReturn(run func([]mangas.Page) error) *ChapterMock_InsertChapterPages_Call {
+func (_c *ChapterMock_InsertChapterPages_Call) RunAndReturn(run func([]mangas.Page, func(bun.IDB) error) error) *ChapterMock_InsertChapterPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveChapters provides a mock function with given fields: mangaId, volumeId, chapterIds
+func (_m *ChapterMock) MoveChapters(mangaId string, volumeId string, chapterIds []string) error {
+	ret := _m.Called(mangaId, volumeId, chapterIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveChapters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) error); ok {
+		r0 = rf(mangaId, volumeId, chapterIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChapterMock_MoveChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveChapters'
+type ChapterMock_MoveChapters_Call struct {
+	*mock.Call
+}
+
+// MoveChapters is a helper method to define mock.On call
+//   - mangaId string
+//   - volumeId string
+//   - chapterIds []string
+func (_e *ChapterMock_Expecter) MoveChapters(mangaId interface{}, volumeId interface{}, chapterIds interface{}) *ChapterMock_MoveChapters_Call {
+	return &ChapterMock_MoveChapters_Call{Call: _e.mock.On("MoveChapters", mangaId, volumeId, chapterIds)}
+}
+
+func (_c *ChapterMock_MoveChapters_Call) Run(run func(mangaId string, volumeId string, chapterIds []string)) *ChapterMock_MoveChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_MoveChapters_Call) Return(_a0 error) *ChapterMock_MoveChapters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChapterMock_MoveChapters_Call) RunAndReturn(run func(string, string, []string) error) *ChapterMock_MoveChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderChapterPages provides a mock function with given fields: chapterId, pageIds
+func (_m *ChapterMock) ReorderChapterPages(chapterId string, pageIds []string) error {
+	ret := _m.Called(chapterId, pageIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderChapterPages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(chapterId, pageIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChapterMock_ReorderChapterPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderChapterPages'
+type ChapterMock_ReorderChapterPages_Call struct {
+	*mock.Call
+}
+
+// ReorderChapterPages is a helper method to define mock.On call
+//   - chapterId string
+//   - pageIds []string
+func (_e *ChapterMock_Expecter) ReorderChapterPages(chapterId interface{}, pageIds interface{}) *ChapterMock_ReorderChapterPages_Call {
+	return &ChapterMock_ReorderChapterPages_Call{Call: _e.mock.On("ReorderChapterPages", chapterId, pageIds)}
+}
+
+func (_c *ChapterMock_ReorderChapterPages_Call) Run(run func(chapterId string, pageIds []string)) *ChapterMock_ReorderChapterPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ReorderChapterPages_Call) Return(_a0 error) *ChapterMock_ReorderChapterPages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChapterMock_ReorderChapterPages_Call) RunAndReturn(run func(string, []string) error) *ChapterMock_ReorderChapterPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceChapterPage provides a mock function with given fields: page, commit
+func (_m *ChapterMock) ReplaceChapterPage(page *mangas.Page, commit func(bun.IDB) error) (*mangas.Page, error) {
+	ret := _m.Called(page, commit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceChapterPage")
+	}
+
+	var r0 *mangas.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*mangas.Page, func(bun.IDB) error) (*mangas.Page, error)); ok {
+		return rf(page, commit)
+	}
+	if rf, ok := ret.Get(0).(func(*mangas.Page, func(bun.IDB) error) *mangas.Page); ok {
+		r0 = rf(page, commit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*mangas.Page, func(bun.IDB) error) error); ok {
+		r1 = rf(page, commit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_ReplaceChapterPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceChapterPage'
+type ChapterMock_ReplaceChapterPage_Call struct {
+	*mock.Call
+}
+
+// ReplaceChapterPage is a helper method to define mock.On call
+//   - page *mangas.Page
+//   - commit func(bun.IDB) error
+func (_e *ChapterMock_Expecter) ReplaceChapterPage(page interface{}, commit interface{}) *ChapterMock_ReplaceChapterPage_Call {
+	return &ChapterMock_ReplaceChapterPage_Call{Call: _e.mock.On("ReplaceChapterPage", page, commit)}
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) Run(run func(page *mangas.Page, commit func(bun.IDB) error)) *ChapterMock_ReplaceChapterPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Page), args[1].(func(bun.IDB) error))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) Return(_a0 *mangas.Page, _a1 error) *ChapterMock_ReplaceChapterPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) RunAndReturn(run func(*mangas.Page, func(bun.IDB) error) (*mangas.Page, error)) *ChapterMock_ReplaceChapterPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
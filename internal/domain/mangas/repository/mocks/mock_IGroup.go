@@ -0,0 +1,394 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package repository
+
+import (
+	mangas "manga-explorer/internal/domain/mangas"
+	infrastructurerepository "manga-explorer/internal/infrastructure/repository"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GroupMock is an autogenerated mock type for the IGroup type
+type GroupMock struct {
+	mock.Mock
+}
+
+type GroupMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupMock) EXPECT() *GroupMock_Expecter {
+	return &GroupMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateGroup provides a mock function with given fields: group
+func (_m *GroupMock) CreateGroup(group *mangas.Group) error {
+	ret := _m.Called(group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Group) error); ok {
+		r0 = rf(group)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupMock_CreateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroup'
+type GroupMock_CreateGroup_Call struct {
+	*mock.Call
+}
+
+// CreateGroup is a helper method to define mock.On call
+//   - group *mangas.Group
+func (_e *GroupMock_Expecter) CreateGroup(group interface{}) *GroupMock_CreateGroup_Call {
+	return &GroupMock_CreateGroup_Call{Call: _e.mock.On("CreateGroup", group)}
+}
+
+func (_c *GroupMock_CreateGroup_Call) Run(run func(group *mangas.Group)) *GroupMock_CreateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Group))
+	})
+	return _c
+}
+
+func (_c *GroupMock_CreateGroup_Call) Return(_a0 error) *GroupMock_CreateGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_CreateGroup_Call) RunAndReturn(run func(*mangas.Group) error) *GroupMock_CreateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupById provides a mock function with given fields: groupId
+func (_m *GroupMock) DeleteGroupById(groupId string) error {
+	ret := _m.Called(groupId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(groupId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupMock_DeleteGroupById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupById'
+type GroupMock_DeleteGroupById_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupById is a helper method to define mock.On call
+//   - groupId string
+func (_e *GroupMock_Expecter) DeleteGroupById(groupId interface{}) *GroupMock_DeleteGroupById_Call {
+	return &GroupMock_DeleteGroupById_Call{Call: _e.mock.On("DeleteGroupById", groupId)}
+}
+
+func (_c *GroupMock_DeleteGroupById_Call) Run(run func(groupId string)) *GroupMock_DeleteGroupById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupMock_DeleteGroupById_Call) Return(_a0 error) *GroupMock_DeleteGroupById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_DeleteGroupById_Call) RunAndReturn(run func(string) error) *GroupMock_DeleteGroupById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditGroupMembers provides a mock function with given fields: additional, removes
+func (_m *GroupMock) EditGroupMembers(additional []mangas.GroupMember, removes []mangas.GroupMember) error {
+	ret := _m.Called(additional, removes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditGroupMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]mangas.GroupMember, []mangas.GroupMember) error); ok {
+		r0 = rf(additional, removes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupMock_EditGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditGroupMembers'
+type GroupMock_EditGroupMembers_Call struct {
+	*mock.Call
+}
+
+// EditGroupMembers is a helper method to define mock.On call
+//   - additional []mangas.GroupMember
+//   - removes []mangas.GroupMember
+func (_e *GroupMock_Expecter) EditGroupMembers(additional interface{}, removes interface{}) *GroupMock_EditGroupMembers_Call {
+	return &GroupMock_EditGroupMembers_Call{Call: _e.mock.On("EditGroupMembers", additional, removes)}
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) Run(run func(additional []mangas.GroupMember, removes []mangas.GroupMember)) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]mangas.GroupMember), args[1].([]mangas.GroupMember))
+	})
+	return _c
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) Return(_a0 error) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) RunAndReturn(run func([]mangas.GroupMember, []mangas.GroupMember) error) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindGroupById provides a mock function with given fields: groupId
+func (_m *GroupMock) FindGroupById(groupId string) (*mangas.Group, error) {
+	ret := _m.Called(groupId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindGroupById")
+	}
+
+	var r0 *mangas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*mangas.Group, error)); ok {
+		return rf(groupId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *mangas.Group); ok {
+		r0 = rf(groupId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(groupId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMock_FindGroupById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindGroupById'
+type GroupMock_FindGroupById_Call struct {
+	*mock.Call
+}
+
+// FindGroupById is a helper method to define mock.On call
+//   - groupId string
+func (_e *GroupMock_Expecter) FindGroupById(groupId interface{}) *GroupMock_FindGroupById_Call {
+	return &GroupMock_FindGroupById_Call{Call: _e.mock.On("FindGroupById", groupId)}
+}
+
+func (_c *GroupMock_FindGroupById_Call) Run(run func(groupId string)) *GroupMock_FindGroupById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupMock_FindGroupById_Call) Return(_a0 *mangas.Group, _a1 error) *GroupMock_FindGroupById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMock_FindGroupById_Call) RunAndReturn(run func(string) (*mangas.Group, error)) *GroupMock_FindGroupById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindGroupChapters provides a mock function with given fields: groupId, parameter
+func (_m *GroupMock) FindGroupChapters(groupId string, parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error) {
+	ret := _m.Called(groupId, parameter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindGroupChapters")
+	}
+
+	var r0 infrastructurerepository.PagedQueryResult[[]mangas.Chapter]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error)); ok {
+		return rf(groupId, parameter)
+	}
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Chapter]); ok {
+		r0 = rf(groupId, parameter)
+	} else {
+		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.Chapter])
+	}
+
+	if rf, ok := ret.Get(1).(func(string, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(groupId, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMock_FindGroupChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindGroupChapters'
+type GroupMock_FindGroupChapters_Call struct {
+	*mock.Call
+}
+
+// FindGroupChapters is a helper method to define mock.On call
+//   - groupId string
+//   - parameter infrastructurerepository.QueryParameter
+func (_e *GroupMock_Expecter) FindGroupChapters(groupId interface{}, parameter interface{}) *GroupMock_FindGroupChapters_Call {
+	return &GroupMock_FindGroupChapters_Call{Call: _e.mock.On("FindGroupChapters", groupId, parameter)}
+}
+
+func (_c *GroupMock_FindGroupChapters_Call) Run(run func(groupId string, parameter infrastructurerepository.QueryParameter)) *GroupMock_FindGroupChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(infrastructurerepository.QueryParameter))
+	})
+	return _c
+}
+
+func (_c *GroupMock_FindGroupChapters_Call) Return(_a0 infrastructurerepository.PagedQueryResult[[]mangas.Chapter], _a1 error) *GroupMock_FindGroupChapters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMock_FindGroupChapters_Call) RunAndReturn(run func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Chapter], error)) *GroupMock_FindGroupChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroups provides a mock function with given fields: name, parameter
+func (_m *GroupMock) ListGroups(name string, parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Group], error) {
+	ret := _m.Called(name, parameter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroups")
+	}
+
+	var r0 infrastructurerepository.PagedQueryResult[[]mangas.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Group], error)); ok {
+		return rf(name, parameter)
+	}
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Group]); ok {
+		r0 = rf(name, parameter)
+	} else {
+		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.Group])
+	}
+
+	if rf, ok := ret.Get(1).(func(string, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(name, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMock_ListGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroups'
+type GroupMock_ListGroups_Call struct {
+	*mock.Call
+}
+
+// ListGroups is a helper method to define mock.On call
+//   - name string
+//   - parameter infrastructurerepository.QueryParameter
+func (_e *GroupMock_Expecter) ListGroups(name interface{}, parameter interface{}) *GroupMock_ListGroups_Call {
+	return &GroupMock_ListGroups_Call{Call: _e.mock.On("ListGroups", name, parameter)}
+}
+
+func (_c *GroupMock_ListGroups_Call) Run(run func(name string, parameter infrastructurerepository.QueryParameter)) *GroupMock_ListGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(infrastructurerepository.QueryParameter))
+	})
+	return _c
+}
+
+func (_c *GroupMock_ListGroups_Call) Return(_a0 infrastructurerepository.PagedQueryResult[[]mangas.Group], _a1 error) *GroupMock_ListGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMock_ListGroups_Call) RunAndReturn(run func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Group], error)) *GroupMock_ListGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGroup provides a mock function with given fields: group
+func (_m *GroupMock) UpdateGroup(group *mangas.Group) error {
+	ret := _m.Called(group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Group) error); ok {
+		r0 = rf(group)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupMock_UpdateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroup'
+type GroupMock_UpdateGroup_Call struct {
+	*mock.Call
+}
+
+// UpdateGroup is a helper method to define mock.On call
+//   - group *mangas.Group
+func (_e *GroupMock_Expecter) UpdateGroup(group interface{}) *GroupMock_UpdateGroup_Call {
+	return &GroupMock_UpdateGroup_Call{Call: _e.mock.On("UpdateGroup", group)}
+}
+
+func (_c *GroupMock_UpdateGroup_Call) Run(run func(group *mangas.Group)) *GroupMock_UpdateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Group))
+	})
+	return _c
+}
+
+func (_c *GroupMock_UpdateGroup_Call) Return(_a0 error) *GroupMock_UpdateGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_UpdateGroup_Call) RunAndReturn(run func(*mangas.Group) error) *GroupMock_UpdateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupMock creates a new instance of GroupMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupMock {
+	mock := &GroupMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
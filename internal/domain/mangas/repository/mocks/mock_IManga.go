@@ -69,6 +69,52 @@ func (_c *MangaMock_CreateManga_Call) RunAndReturn(run func(*mangas.Manga, []man
 	return _c
 }
 
+// CreateMangaRelation provides a mock function with given fields: relation
+func (_m *MangaMock) CreateMangaRelation(relation *mangas.MangaRelation) error {
+	ret := _m.Called(relation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMangaRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.MangaRelation) error); ok {
+		r0 = rf(relation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_CreateMangaRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMangaRelation'
+type MangaMock_CreateMangaRelation_Call struct {
+	*mock.Call
+}
+
+// CreateMangaRelation is a helper method to define mock.On call
+//   - relation *mangas.MangaRelation
+func (_e *MangaMock_Expecter) CreateMangaRelation(relation interface{}) *MangaMock_CreateMangaRelation_Call {
+	return &MangaMock_CreateMangaRelation_Call{Call: _e.mock.On("CreateMangaRelation", relation)}
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) Run(run func(relation *mangas.MangaRelation)) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.MangaRelation))
+	})
+	return _c
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) Return(_a0 error) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) RunAndReturn(run func(*mangas.MangaRelation) error) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateVolume provides a mock function with given fields: volume
 func (_m *MangaMock) CreateVolume(volume *mangas.Volume) error {
 	ret := _m.Called(volume)
@@ -115,6 +161,53 @@ func (_c *MangaMock_CreateVolume_Call) RunAndReturn(run func(*mangas.Volume) err
 	return _c
 }
 
+// DeleteMangaRelation provides a mock function with given fields: mangaId, relatedId
+func (_m *MangaMock) DeleteMangaRelation(mangaId string, relatedId string) error {
+	ret := _m.Called(mangaId, relatedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMangaRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(mangaId, relatedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_DeleteMangaRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMangaRelation'
+type MangaMock_DeleteMangaRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteMangaRelation is a helper method to define mock.On call
+//   - mangaId string
+//   - relatedId string
+func (_e *MangaMock_Expecter) DeleteMangaRelation(mangaId interface{}, relatedId interface{}) *MangaMock_DeleteMangaRelation_Call {
+	return &MangaMock_DeleteMangaRelation_Call{Call: _e.mock.On("DeleteMangaRelation", mangaId, relatedId)}
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) Run(run func(mangaId string, relatedId string)) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) Return(_a0 error) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) RunAndReturn(run func(string, string) error) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteVolume provides a mock function with given fields: mangaId, volumes
 func (_m *MangaMock) DeleteVolume(mangaId string, volumes []uint32) error {
 	ret := _m.Called(mangaId, volumes)
@@ -208,6 +301,100 @@ func (_c *MangaMock_EditManga_Call) RunAndReturn(run func(*mangas.Manga) error)
 	return _c
 }
 
+// EditMangaDownload provides a mock function with given fields: mangaId, allowed
+func (_m *MangaMock) EditMangaDownload(mangaId string, allowed bool) error {
+	ret := _m.Called(mangaId, allowed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaDownload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(mangaId, allowed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaDownload'
+type MangaMock_EditMangaDownload_Call struct {
+	*mock.Call
+}
+
+// EditMangaDownload is a helper method to define mock.On call
+//   - mangaId string
+//   - allowed bool
+func (_e *MangaMock_Expecter) EditMangaDownload(mangaId interface{}, allowed interface{}) *MangaMock_EditMangaDownload_Call {
+	return &MangaMock_EditMangaDownload_Call{Call: _e.mock.On("EditMangaDownload", mangaId, allowed)}
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) Run(run func(mangaId string, allowed bool)) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) Return(_a0 error) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) RunAndReturn(run func(string, bool) error) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMangaExport provides a mock function with given fields: mangaId, allowed
+func (_m *MangaMock) EditMangaExport(mangaId string, allowed bool) error {
+	ret := _m.Called(mangaId, allowed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(mangaId, allowed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaExport'
+type MangaMock_EditMangaExport_Call struct {
+	*mock.Call
+}
+
+// EditMangaExport is a helper method to define mock.On call
+//   - mangaId string
+//   - allowed bool
+func (_e *MangaMock_Expecter) EditMangaExport(mangaId interface{}, allowed interface{}) *MangaMock_EditMangaExport_Call {
+	return &MangaMock_EditMangaExport_Call{Call: _e.mock.On("EditMangaExport", mangaId, allowed)}
+}
+
+func (_c *MangaMock_EditMangaExport_Call) Run(run func(mangaId string, allowed bool)) *MangaMock_EditMangaExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaExport_Call) Return(_a0 error) *MangaMock_EditMangaExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaExport_Call) RunAndReturn(run func(string, bool) error) *MangaMock_EditMangaExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EditMangaGenres provides a mock function with given fields: additional, removes
 func (_m *MangaMock) EditMangaGenres(additional []mangas.MangaGenre, removes []mangas.MangaGenre) error {
 	ret := _m.Called(additional, removes)
@@ -255,9 +442,149 @@ func (_c *MangaMock_EditMangaGenres_Call) RunAndReturn(run func([]mangas.MangaGe
 	return _c
 }
 
-// FindMangaFavorites provides a mock function with given fields: userId, pagedQuery
-func (_m *MangaMock) FindMangaFavorites(userId string, pagedQuery infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error) {
-	ret := _m.Called(userId, pagedQuery)
+// EditMangaStaff provides a mock function with given fields: additional, removes
+func (_m *MangaMock) EditMangaStaff(additional []mangas.MangaStaff, removes []mangas.MangaStaff) error {
+	ret := _m.Called(additional, removes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaStaff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]mangas.MangaStaff, []mangas.MangaStaff) error); ok {
+		r0 = rf(additional, removes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaStaff'
+type MangaMock_EditMangaStaff_Call struct {
+	*mock.Call
+}
+
+// EditMangaStaff is a helper method to define mock.On call
+//   - additional []mangas.MangaStaff
+//   - removes []mangas.MangaStaff
+func (_e *MangaMock_Expecter) EditMangaStaff(additional interface{}, removes interface{}) *MangaMock_EditMangaStaff_Call {
+	return &MangaMock_EditMangaStaff_Call{Call: _e.mock.On("EditMangaStaff", additional, removes)}
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) Run(run func(additional []mangas.MangaStaff, removes []mangas.MangaStaff)) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]mangas.MangaStaff), args[1].([]mangas.MangaStaff))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) Return(_a0 error) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) RunAndReturn(run func([]mangas.MangaStaff, []mangas.MangaStaff) error) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMangaTags provides a mock function with given fields: additional, removes
+func (_m *MangaMock) EditMangaTags(additional []mangas.MangaTag, removes []mangas.MangaTag) error {
+	ret := _m.Called(additional, removes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]mangas.MangaTag, []mangas.MangaTag) error); ok {
+		r0 = rf(additional, removes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaTags'
+type MangaMock_EditMangaTags_Call struct {
+	*mock.Call
+}
+
+// EditMangaTags is a helper method to define mock.On call
+//   - additional []mangas.MangaTag
+//   - removes []mangas.MangaTag
+func (_e *MangaMock_Expecter) EditMangaTags(additional interface{}, removes interface{}) *MangaMock_EditMangaTags_Call {
+	return &MangaMock_EditMangaTags_Call{Call: _e.mock.On("EditMangaTags", additional, removes)}
+}
+
+func (_c *MangaMock_EditMangaTags_Call) Run(run func(additional []mangas.MangaTag, removes []mangas.MangaTag)) *MangaMock_EditMangaTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]mangas.MangaTag), args[1].([]mangas.MangaTag))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaTags_Call) Return(_a0 error) *MangaMock_EditMangaTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaTags_Call) RunAndReturn(run func([]mangas.MangaTag, []mangas.MangaTag) error) *MangaMock_EditMangaTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditVolume provides a mock function with given fields: volume
+func (_m *MangaMock) EditVolume(volume *mangas.Volume) error {
+	ret := _m.Called(volume)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Volume) error); ok {
+		r0 = rf(volume)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_EditVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditVolume'
+type MangaMock_EditVolume_Call struct {
+	*mock.Call
+}
+
+// EditVolume is a helper method to define mock.On call
+//   - volume *mangas.Volume
+func (_e *MangaMock_Expecter) EditVolume(volume interface{}) *MangaMock_EditVolume_Call {
+	return &MangaMock_EditVolume_Call{Call: _e.mock.On("EditVolume", volume)}
+}
+
+func (_c *MangaMock_EditVolume_Call) Run(run func(volume *mangas.Volume)) *MangaMock_EditVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Volume))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditVolume_Call) Return(_a0 error) *MangaMock_EditVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditVolume_Call) RunAndReturn(run func(*mangas.Volume) error) *MangaMock_EditVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMangaFavorites provides a mock function with given fields: userId, ratings, pagedQuery
+func (_m *MangaMock) FindMangaFavorites(userId string, ratings []mangas.ContentRating, pagedQuery infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error) {
+	ret := _m.Called(userId, ratings, pagedQuery)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindMangaFavorites")
@@ -265,17 +592,17 @@ func (_m *MangaMock) FindMangaFavorites(userId string, pagedQuery infrastructure
 
 	var r0 infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error)); ok {
-		return rf(userId, pagedQuery)
+	if rf, ok := ret.Get(0).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error)); ok {
+		return rf(userId, ratings, pagedQuery)
 	}
-	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite]); ok {
-		r0 = rf(userId, pagedQuery)
+	if rf, ok := ret.Get(0).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite]); ok {
+		r0 = rf(userId, ratings, pagedQuery)
 	} else {
 		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite])
 	}
 
-	if rf, ok := ret.Get(1).(func(string, infrastructurerepository.QueryParameter) error); ok {
-		r1 = rf(userId, pagedQuery)
+	if rf, ok := ret.Get(1).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(userId, ratings, pagedQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -290,14 +617,15 @@ type MangaMock_FindMangaFavorites_Call struct {
 
 // FindMangaFavorites is a helper method to define mock.On call
 //   - userId string
+//   - ratings []mangas.ContentRating
 //   - pagedQuery infrastructurerepository.QueryParameter
-func (_e *MangaMock_Expecter) FindMangaFavorites(userId interface{}, pagedQuery interface{}) *MangaMock_FindMangaFavorites_Call {
-	return &MangaMock_FindMangaFavorites_Call{Call: _e.mock.On("FindMangaFavorites", userId, pagedQuery)}
+func (_e *MangaMock_Expecter) FindMangaFavorites(userId interface{}, ratings interface{}, pagedQuery interface{}) *MangaMock_FindMangaFavorites_Call {
+	return &MangaMock_FindMangaFavorites_Call{Call: _e.mock.On("FindMangaFavorites", userId, ratings, pagedQuery)}
 }
 
-func (_c *MangaMock_FindMangaFavorites_Call) Run(run func(userId string, pagedQuery infrastructurerepository.QueryParameter)) *MangaMock_FindMangaFavorites_Call {
+func (_c *MangaMock_FindMangaFavorites_Call) Run(run func(userId string, ratings []mangas.ContentRating, pagedQuery infrastructurerepository.QueryParameter)) *MangaMock_FindMangaFavorites_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(infrastructurerepository.QueryParameter))
+		run(args[0].(string), args[1].([]mangas.ContentRating), args[2].(infrastructurerepository.QueryParameter))
 	})
 	return _c
 }
@@ -307,14 +635,14 @@ func (_c *MangaMock_FindMangaFavorites_Call) Return(_a0 infrastructurerepository
 	return _c
 }
 
-func (_c *MangaMock_FindMangaFavorites_Call) RunAndReturn(run func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error)) *MangaMock_FindMangaFavorites_Call {
+func (_c *MangaMock_FindMangaFavorites_Call) RunAndReturn(run func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaFavorite], error)) *MangaMock_FindMangaFavorites_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindMangaHistories provides a mock function with given fields: userId, pagedQuery
-func (_m *MangaMock) FindMangaHistories(userId string, pagedQuery infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error) {
-	ret := _m.Called(userId, pagedQuery)
+// FindMangaHistories provides a mock function with given fields: userId, ratings, pagedQuery
+func (_m *MangaMock) FindMangaHistories(userId string, ratings []mangas.ContentRating, pagedQuery infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error) {
+	ret := _m.Called(userId, ratings, pagedQuery)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindMangaHistories")
@@ -322,17 +650,17 @@ func (_m *MangaMock) FindMangaHistories(userId string, pagedQuery infrastructure
 
 	var r0 infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error)); ok {
-		return rf(userId, pagedQuery)
+	if rf, ok := ret.Get(0).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error)); ok {
+		return rf(userId, ratings, pagedQuery)
 	}
-	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory]); ok {
-		r0 = rf(userId, pagedQuery)
+	if rf, ok := ret.Get(0).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory]); ok {
+		r0 = rf(userId, ratings, pagedQuery)
 	} else {
 		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory])
 	}
 
-	if rf, ok := ret.Get(1).(func(string, infrastructurerepository.QueryParameter) error); ok {
-		r1 = rf(userId, pagedQuery)
+	if rf, ok := ret.Get(1).(func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(userId, ratings, pagedQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,14 +675,15 @@ type MangaMock_FindMangaHistories_Call struct {
 
 // FindMangaHistories is a helper method to define mock.On call
 //   - userId string
+//   - ratings []mangas.ContentRating
 //   - pagedQuery infrastructurerepository.QueryParameter
-func (_e *MangaMock_Expecter) FindMangaHistories(userId interface{}, pagedQuery interface{}) *MangaMock_FindMangaHistories_Call {
-	return &MangaMock_FindMangaHistories_Call{Call: _e.mock.On("FindMangaHistories", userId, pagedQuery)}
+func (_e *MangaMock_Expecter) FindMangaHistories(userId interface{}, ratings interface{}, pagedQuery interface{}) *MangaMock_FindMangaHistories_Call {
+	return &MangaMock_FindMangaHistories_Call{Call: _e.mock.On("FindMangaHistories", userId, ratings, pagedQuery)}
 }
 
-func (_c *MangaMock_FindMangaHistories_Call) Run(run func(userId string, pagedQuery infrastructurerepository.QueryParameter)) *MangaMock_FindMangaHistories_Call {
+func (_c *MangaMock_FindMangaHistories_Call) Run(run func(userId string, ratings []mangas.ContentRating, pagedQuery infrastructurerepository.QueryParameter)) *MangaMock_FindMangaHistories_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(infrastructurerepository.QueryParameter))
+		run(args[0].(string), args[1].([]mangas.ContentRating), args[2].(infrastructurerepository.QueryParameter))
 	})
 	return _c
 }
@@ -364,7 +693,121 @@ func (_c *MangaMock_FindMangaHistories_Call) Return(_a0 infrastructurerepository
 	return _c
 }
 
-func (_c *MangaMock_FindMangaHistories_Call) RunAndReturn(run func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error)) *MangaMock_FindMangaHistories_Call {
+func (_c *MangaMock_FindMangaHistories_Call) RunAndReturn(run func(string, []mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.MangaHistory], error)) *MangaMock_FindMangaHistories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMangaIdBySlug provides a mock function with given fields: slug
+func (_m *MangaMock) FindMangaIdBySlug(slug string) (string, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaIdBySlug")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindMangaIdBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaIdBySlug'
+type MangaMock_FindMangaIdBySlug_Call struct {
+	*mock.Call
+}
+
+// FindMangaIdBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MangaMock_Expecter) FindMangaIdBySlug(slug interface{}) *MangaMock_FindMangaIdBySlug_Call {
+	return &MangaMock_FindMangaIdBySlug_Call{Call: _e.mock.On("FindMangaIdBySlug", slug)}
+}
+
+func (_c *MangaMock_FindMangaIdBySlug_Call) Run(run func(slug string)) *MangaMock_FindMangaIdBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindMangaIdBySlug_Call) Return(_a0 string, _a1 error) *MangaMock_FindMangaIdBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindMangaIdBySlug_Call) RunAndReturn(run func(string) (string, error)) *MangaMock_FindMangaIdBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMangaRelations provides a mock function with given fields: mangaId
+func (_m *MangaMock) FindMangaRelations(mangaId string) ([]mangas.MangaRelation, error) {
+	ret := _m.Called(mangaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaRelations")
+	}
+
+	var r0 []mangas.MangaRelation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]mangas.MangaRelation, error)); ok {
+		return rf(mangaId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []mangas.MangaRelation); ok {
+		r0 = rf(mangaId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mangas.MangaRelation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(mangaId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindMangaRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaRelations'
+type MangaMock_FindMangaRelations_Call struct {
+	*mock.Call
+}
+
+// FindMangaRelations is a helper method to define mock.On call
+//   - mangaId string
+func (_e *MangaMock_Expecter) FindMangaRelations(mangaId interface{}) *MangaMock_FindMangaRelations_Call {
+	return &MangaMock_FindMangaRelations_Call{Call: _e.mock.On("FindMangaRelations", mangaId)}
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) Run(run func(mangaId string)) *MangaMock_FindMangaRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) Return(_a0 []mangas.MangaRelation, _a1 error) *MangaMock_FindMangaRelations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) RunAndReturn(run func(string) ([]mangas.MangaRelation, error)) *MangaMock_FindMangaRelations_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -555,9 +998,9 @@ func (_c *MangaMock_FindMinimalMangaById_Call) RunAndReturn(run func(string) (*m
 	return _c
 }
 
-// FindRandomMangas provides a mock function with given fields: limit
-func (_m *MangaMock) FindRandomMangas(limit uint64) ([]mangas.Manga, error) {
-	ret := _m.Called(limit)
+// FindRandomMangas provides a mock function with given fields: limit, ratings
+func (_m *MangaMock) FindRandomMangas(limit uint64, ratings []mangas.ContentRating) ([]mangas.Manga, error) {
+	ret := _m.Called(limit, ratings)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindRandomMangas")
@@ -565,19 +1008,19 @@ func (_m *MangaMock) FindRandomMangas(limit uint64) ([]mangas.Manga, error) {
 
 	var r0 []mangas.Manga
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint64) ([]mangas.Manga, error)); ok {
-		return rf(limit)
+	if rf, ok := ret.Get(0).(func(uint64, []mangas.ContentRating) ([]mangas.Manga, error)); ok {
+		return rf(limit, ratings)
 	}
-	if rf, ok := ret.Get(0).(func(uint64) []mangas.Manga); ok {
-		r0 = rf(limit)
+	if rf, ok := ret.Get(0).(func(uint64, []mangas.ContentRating) []mangas.Manga); ok {
+		r0 = rf(limit, ratings)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]mangas.Manga)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint64) error); ok {
-		r1 = rf(limit)
+	if rf, ok := ret.Get(1).(func(uint64, []mangas.ContentRating) error); ok {
+		r1 = rf(limit, ratings)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -592,13 +1035,14 @@ type MangaMock_FindRandomMangas_Call struct {
 
 // FindRandomMangas is a helper method to define mock.On call
 //   - limit uint64
-func (_e *MangaMock_Expecter) FindRandomMangas(limit interface{}) *MangaMock_FindRandomMangas_Call {
-	return &MangaMock_FindRandomMangas_Call{Call: _e.mock.On("FindRandomMangas", limit)}
+//   - ratings []mangas.ContentRating
+func (_e *MangaMock_Expecter) FindRandomMangas(limit interface{}, ratings interface{}) *MangaMock_FindRandomMangas_Call {
+	return &MangaMock_FindRandomMangas_Call{Call: _e.mock.On("FindRandomMangas", limit, ratings)}
 }
 
-func (_c *MangaMock_FindRandomMangas_Call) Run(run func(limit uint64)) *MangaMock_FindRandomMangas_Call {
+func (_c *MangaMock_FindRandomMangas_Call) Run(run func(limit uint64, ratings []mangas.ContentRating)) *MangaMock_FindRandomMangas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint64))
+		run(args[0].(uint64), args[1].([]mangas.ContentRating))
 	})
 	return _c
 }
@@ -608,7 +1052,123 @@ func (_c *MangaMock_FindRandomMangas_Call) Return(_a0 []mangas.Manga, _a1 error)
 	return _c
 }
 
-func (_c *MangaMock_FindRandomMangas_Call) RunAndReturn(run func(uint64) ([]mangas.Manga, error)) *MangaMock_FindRandomMangas_Call {
+func (_c *MangaMock_FindRandomMangas_Call) RunAndReturn(run func(uint64, []mangas.ContentRating) ([]mangas.Manga, error)) *MangaMock_FindRandomMangas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSimilarSlugs provides a mock function with given fields: slug
+func (_m *MangaMock) FindSimilarSlugs(slug string) ([]string, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSimilarSlugs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindSimilarSlugs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSimilarSlugs'
+type MangaMock_FindSimilarSlugs_Call struct {
+	*mock.Call
+}
+
+// FindSimilarSlugs is a helper method to define mock.On call
+//   - slug string
+func (_e *MangaMock_Expecter) FindSimilarSlugs(slug interface{}) *MangaMock_FindSimilarSlugs_Call {
+	return &MangaMock_FindSimilarSlugs_Call{Call: _e.mock.On("FindSimilarSlugs", slug)}
+}
+
+func (_c *MangaMock_FindSimilarSlugs_Call) Run(run func(slug string)) *MangaMock_FindSimilarSlugs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindSimilarSlugs_Call) Return(_a0 []string, _a1 error) *MangaMock_FindSimilarSlugs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindSimilarSlugs_Call) RunAndReturn(run func(string) ([]string, error)) *MangaMock_FindSimilarSlugs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindVolumeById provides a mock function with given fields: id
+func (_m *MangaMock) FindVolumeById(id string) (*mangas.Volume, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindVolumeById")
+	}
+
+	var r0 *mangas.Volume
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*mangas.Volume, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *mangas.Volume); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Volume)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindVolumeById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindVolumeById'
+type MangaMock_FindVolumeById_Call struct {
+	*mock.Call
+}
+
+// FindVolumeById is a helper method to define mock.On call
+//   - id string
+func (_e *MangaMock_Expecter) FindVolumeById(id interface{}) *MangaMock_FindVolumeById_Call {
+	return &MangaMock_FindVolumeById_Call{Call: _e.mock.On("FindVolumeById", id)}
+}
+
+func (_c *MangaMock_FindVolumeById_Call) Run(run func(id string)) *MangaMock_FindVolumeById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindVolumeById_Call) Return(_a0 *mangas.Volume, _a1 error) *MangaMock_FindVolumeById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindVolumeById_Call) RunAndReturn(run func(string) (*mangas.Volume, error)) *MangaMock_FindVolumeById_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -659,9 +1219,9 @@ func (_c *MangaMock_InsertMangaFavorite_Call) RunAndReturn(run func(*mangas.Mang
 	return _c
 }
 
-// ListMangas provides a mock function with given fields: parameter
-func (_m *MangaMock) ListMangas(parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error) {
-	ret := _m.Called(parameter)
+// ListMangas provides a mock function with given fields: ratings, parameter
+func (_m *MangaMock) ListMangas(ratings []mangas.ContentRating, parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error) {
+	ret := _m.Called(ratings, parameter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMangas")
@@ -669,17 +1229,17 @@ func (_m *MangaMock) ListMangas(parameter infrastructurerepository.QueryParamete
 
 	var r0 infrastructurerepository.PagedQueryResult[[]mangas.Manga]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error)); ok {
-		return rf(parameter)
+	if rf, ok := ret.Get(0).(func([]mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error)); ok {
+		return rf(ratings, parameter)
 	}
-	if rf, ok := ret.Get(0).(func(infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Manga]); ok {
-		r0 = rf(parameter)
+	if rf, ok := ret.Get(0).(func([]mangas.ContentRating, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Manga]); ok {
+		r0 = rf(ratings, parameter)
 	} else {
 		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.Manga])
 	}
 
-	if rf, ok := ret.Get(1).(func(infrastructurerepository.QueryParameter) error); ok {
-		r1 = rf(parameter)
+	if rf, ok := ret.Get(1).(func([]mangas.ContentRating, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(ratings, parameter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -693,14 +1253,15 @@ type MangaMock_ListMangas_Call struct {
 }
 
 // ListMangas is a helper method to define mock.On call
+//   - ratings []mangas.ContentRating
 //   - parameter infrastructurerepository.QueryParameter
-func (_e *MangaMock_Expecter) ListMangas(parameter interface{}) *MangaMock_ListMangas_Call {
-	return &MangaMock_ListMangas_Call{Call: _e.mock.On("ListMangas", parameter)}
+func (_e *MangaMock_Expecter) ListMangas(ratings interface{}, parameter interface{}) *MangaMock_ListMangas_Call {
+	return &MangaMock_ListMangas_Call{Call: _e.mock.On("ListMangas", ratings, parameter)}
 }
 
-func (_c *MangaMock_ListMangas_Call) Run(run func(parameter infrastructurerepository.QueryParameter)) *MangaMock_ListMangas_Call {
+func (_c *MangaMock_ListMangas_Call) Run(run func(ratings []mangas.ContentRating, parameter infrastructurerepository.QueryParameter)) *MangaMock_ListMangas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(infrastructurerepository.QueryParameter))
+		run(args[0].([]mangas.ContentRating), args[1].(infrastructurerepository.QueryParameter))
 	})
 	return _c
 }
@@ -710,7 +1271,7 @@ func (_c *MangaMock_ListMangas_Call) Return(_a0 infrastructurerepository.PagedQu
 	return _c
 }
 
-func (_c *MangaMock_ListMangas_Call) RunAndReturn(run func(infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error)) *MangaMock_ListMangas_Call {
+func (_c *MangaMock_ListMangas_Call) RunAndReturn(run func([]mangas.ContentRating, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Manga], error)) *MangaMock_ListMangas_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -761,6 +1322,52 @@ func (_c *MangaMock_PatchManga_Call) RunAndReturn(run func(*mangas.Manga) error)
 	return _c
 }
 
+// PatchVolume provides a mock function with given fields: volume
+func (_m *MangaMock) PatchVolume(volume *mangas.Volume) error {
+	ret := _m.Called(volume)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Volume) error); ok {
+		r0 = rf(volume)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_PatchVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchVolume'
+type MangaMock_PatchVolume_Call struct {
+	*mock.Call
+}
+
+// PatchVolume is a helper method to define mock.On call
+//   - volume *mangas.Volume
+func (_e *MangaMock_Expecter) PatchVolume(volume interface{}) *MangaMock_PatchVolume_Call {
+	return &MangaMock_PatchVolume_Call{Call: _e.mock.On("PatchVolume", volume)}
+}
+
+func (_c *MangaMock_PatchVolume_Call) Run(run func(volume *mangas.Volume)) *MangaMock_PatchVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Volume))
+	})
+	return _c
+}
+
+func (_c *MangaMock_PatchVolume_Call) Return(_a0 error) *MangaMock_PatchVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_PatchVolume_Call) RunAndReturn(run func(*mangas.Volume) error) *MangaMock_PatchVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveMangaFavorite provides a mock function with given fields: favorite
 func (_m *MangaMock) RemoveMangaFavorite(favorite *mangas.MangaFavorite) error {
 	ret := _m.Called(favorite)
@@ -807,6 +1414,53 @@ func (_c *MangaMock_RemoveMangaFavorite_Call) RunAndReturn(run func(*mangas.Mang
 	return _c
 }
 
+// UpdateMangaSlug provides a mock function with given fields: mangaId, slug
+func (_m *MangaMock) UpdateMangaSlug(mangaId string, slug string) error {
+	ret := _m.Called(mangaId, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMangaSlug")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(mangaId, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MangaMock_UpdateMangaSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMangaSlug'
+type MangaMock_UpdateMangaSlug_Call struct {
+	*mock.Call
+}
+
+// UpdateMangaSlug is a helper method to define mock.On call
+//   - mangaId string
+//   - slug string
+func (_e *MangaMock_Expecter) UpdateMangaSlug(mangaId interface{}, slug interface{}) *MangaMock_UpdateMangaSlug_Call {
+	return &MangaMock_UpdateMangaSlug_Call{Call: _e.mock.On("UpdateMangaSlug", mangaId, slug)}
+}
+
+func (_c *MangaMock_UpdateMangaSlug_Call) Run(run func(mangaId string, slug string)) *MangaMock_UpdateMangaSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_UpdateMangaSlug_Call) Return(_a0 error) *MangaMock_UpdateMangaSlug_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_UpdateMangaSlug_Call) RunAndReturn(run func(string, string) error) *MangaMock_UpdateMangaSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMangaMock creates a new instance of MangaMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMangaMock(t interface {
@@ -0,0 +1,290 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package repository
+
+import (
+	mangas "manga-explorer/internal/domain/mangas"
+	infrastructurerepository "manga-explorer/internal/infrastructure/repository"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PersonMock is an autogenerated mock type for the IPerson type
+type PersonMock struct {
+	mock.Mock
+}
+
+type PersonMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PersonMock) EXPECT() *PersonMock_Expecter {
+	return &PersonMock_Expecter{mock: &_m.Mock}
+}
+
+// CreatePerson provides a mock function with given fields: person
+func (_m *PersonMock) CreatePerson(person *mangas.Person) error {
+	ret := _m.Called(person)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePerson")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Person) error); ok {
+		r0 = rf(person)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonMock_CreatePerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePerson'
+type PersonMock_CreatePerson_Call struct {
+	*mock.Call
+}
+
+// CreatePerson is a helper method to define mock.On call
+//   - person *mangas.Person
+func (_e *PersonMock_Expecter) CreatePerson(person interface{}) *PersonMock_CreatePerson_Call {
+	return &PersonMock_CreatePerson_Call{Call: _e.mock.On("CreatePerson", person)}
+}
+
+func (_c *PersonMock_CreatePerson_Call) Run(run func(person *mangas.Person)) *PersonMock_CreatePerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Person))
+	})
+	return _c
+}
+
+func (_c *PersonMock_CreatePerson_Call) Return(_a0 error) *PersonMock_CreatePerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_CreatePerson_Call) RunAndReturn(run func(*mangas.Person) error) *PersonMock_CreatePerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePersonById provides a mock function with given fields: personId
+func (_m *PersonMock) DeletePersonById(personId string) error {
+	ret := _m.Called(personId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePersonById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(personId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonMock_DeletePersonById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePersonById'
+type PersonMock_DeletePersonById_Call struct {
+	*mock.Call
+}
+
+// DeletePersonById is a helper method to define mock.On call
+//   - personId string
+func (_e *PersonMock_Expecter) DeletePersonById(personId interface{}) *PersonMock_DeletePersonById_Call {
+	return &PersonMock_DeletePersonById_Call{Call: _e.mock.On("DeletePersonById", personId)}
+}
+
+func (_c *PersonMock_DeletePersonById_Call) Run(run func(personId string)) *PersonMock_DeletePersonById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonMock_DeletePersonById_Call) Return(_a0 error) *PersonMock_DeletePersonById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_DeletePersonById_Call) RunAndReturn(run func(string) error) *PersonMock_DeletePersonById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPersonById provides a mock function with given fields: personId
+func (_m *PersonMock) FindPersonById(personId string) (*mangas.Person, error) {
+	ret := _m.Called(personId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPersonById")
+	}
+
+	var r0 *mangas.Person
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*mangas.Person, error)); ok {
+		return rf(personId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *mangas.Person); ok {
+		r0 = rf(personId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mangas.Person)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(personId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonMock_FindPersonById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPersonById'
+type PersonMock_FindPersonById_Call struct {
+	*mock.Call
+}
+
+// FindPersonById is a helper method to define mock.On call
+//   - personId string
+func (_e *PersonMock_Expecter) FindPersonById(personId interface{}) *PersonMock_FindPersonById_Call {
+	return &PersonMock_FindPersonById_Call{Call: _e.mock.On("FindPersonById", personId)}
+}
+
+func (_c *PersonMock_FindPersonById_Call) Run(run func(personId string)) *PersonMock_FindPersonById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonMock_FindPersonById_Call) Return(_a0 *mangas.Person, _a1 error) *PersonMock_FindPersonById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonMock_FindPersonById_Call) RunAndReturn(run func(string) (*mangas.Person, error)) *PersonMock_FindPersonById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPeople provides a mock function with given fields: name, parameter
+func (_m *PersonMock) ListPeople(name string, parameter infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Person], error) {
+	ret := _m.Called(name, parameter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPeople")
+	}
+
+	var r0 infrastructurerepository.PagedQueryResult[[]mangas.Person]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Person], error)); ok {
+		return rf(name, parameter)
+	}
+	if rf, ok := ret.Get(0).(func(string, infrastructurerepository.QueryParameter) infrastructurerepository.PagedQueryResult[[]mangas.Person]); ok {
+		r0 = rf(name, parameter)
+	} else {
+		r0 = ret.Get(0).(infrastructurerepository.PagedQueryResult[[]mangas.Person])
+	}
+
+	if rf, ok := ret.Get(1).(func(string, infrastructurerepository.QueryParameter) error); ok {
+		r1 = rf(name, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonMock_ListPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPeople'
+type PersonMock_ListPeople_Call struct {
+	*mock.Call
+}
+
+// ListPeople is a helper method to define mock.On call
+//   - name string
+//   - parameter infrastructurerepository.QueryParameter
+func (_e *PersonMock_Expecter) ListPeople(name interface{}, parameter interface{}) *PersonMock_ListPeople_Call {
+	return &PersonMock_ListPeople_Call{Call: _e.mock.On("ListPeople", name, parameter)}
+}
+
+func (_c *PersonMock_ListPeople_Call) Run(run func(name string, parameter infrastructurerepository.QueryParameter)) *PersonMock_ListPeople_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(infrastructurerepository.QueryParameter))
+	})
+	return _c
+}
+
+func (_c *PersonMock_ListPeople_Call) Return(_a0 infrastructurerepository.PagedQueryResult[[]mangas.Person], _a1 error) *PersonMock_ListPeople_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonMock_ListPeople_Call) RunAndReturn(run func(string, infrastructurerepository.QueryParameter) (infrastructurerepository.PagedQueryResult[[]mangas.Person], error)) *PersonMock_ListPeople_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePerson provides a mock function with given fields: person
+func (_m *PersonMock) UpdatePerson(person *mangas.Person) error {
+	ret := _m.Called(person)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePerson")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Person) error); ok {
+		r0 = rf(person)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonMock_UpdatePerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePerson'
+type PersonMock_UpdatePerson_Call struct {
+	*mock.Call
+}
+
+// UpdatePerson is a helper method to define mock.On call
+//   - person *mangas.Person
+func (_e *PersonMock_Expecter) UpdatePerson(person interface{}) *PersonMock_UpdatePerson_Call {
+	return &PersonMock_UpdatePerson_Call{Call: _e.mock.On("UpdatePerson", person)}
+}
+
+func (_c *PersonMock_UpdatePerson_Call) Run(run func(person *mangas.Person)) *PersonMock_UpdatePerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Person))
+	})
+	return _c
+}
+
+func (_c *PersonMock_UpdatePerson_Call) Return(_a0 error) *PersonMock_UpdatePerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_UpdatePerson_Call) RunAndReturn(run func(*mangas.Person) error) *PersonMock_UpdatePerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPersonMock creates a new instance of PersonMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPersonMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PersonMock {
+	mock := &PersonMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
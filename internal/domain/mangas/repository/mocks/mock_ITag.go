@@ -0,0 +1,231 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package repository
+
+import (
+	mangas "manga-explorer/internal/domain/mangas"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagMock is an autogenerated mock type for the ITag type
+type TagMock struct {
+	mock.Mock
+}
+
+type TagMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagMock) EXPECT() *TagMock_Expecter {
+	return &TagMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: tag
+func (_m *TagMock) CreateTag(tag *mangas.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagMock_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagMock_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - tag *mangas.Tag
+func (_e *TagMock_Expecter) CreateTag(tag interface{}) *TagMock_CreateTag_Call {
+	return &TagMock_CreateTag_Call{Call: _e.mock.On("CreateTag", tag)}
+}
+
+func (_c *TagMock_CreateTag_Call) Run(run func(tag *mangas.Tag)) *TagMock_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Tag))
+	})
+	return _c
+}
+
+func (_c *TagMock_CreateTag_Call) Return(_a0 error) *TagMock_CreateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_CreateTag_Call) RunAndReturn(run func(*mangas.Tag) error) *TagMock_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTagById provides a mock function with given fields: tagId
+func (_m *TagMock) DeleteTagById(tagId string) error {
+	ret := _m.Called(tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTagById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(tagId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagMock_DeleteTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTagById'
+type TagMock_DeleteTagById_Call struct {
+	*mock.Call
+}
+
+// DeleteTagById is a helper method to define mock.On call
+//   - tagId string
+func (_e *TagMock_Expecter) DeleteTagById(tagId interface{}) *TagMock_DeleteTagById_Call {
+	return &TagMock_DeleteTagById_Call{Call: _e.mock.On("DeleteTagById", tagId)}
+}
+
+func (_c *TagMock_DeleteTagById_Call) Run(run func(tagId string)) *TagMock_DeleteTagById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TagMock_DeleteTagById_Call) Return(_a0 error) *TagMock_DeleteTagById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_DeleteTagById_Call) RunAndReturn(run func(string) error) *TagMock_DeleteTagById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields:
+func (_m *TagMock) ListTags() ([]mangas.Tag, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []mangas.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]mangas.Tag, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []mangas.Tag); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mangas.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagMock_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagMock_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+func (_e *TagMock_Expecter) ListTags() *TagMock_ListTags_Call {
+	return &TagMock_ListTags_Call{Call: _e.mock.On("ListTags")}
+}
+
+func (_c *TagMock_ListTags_Call) Run(run func()) *TagMock_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TagMock_ListTags_Call) Return(_a0 []mangas.Tag, _a1 error) *TagMock_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagMock_ListTags_Call) RunAndReturn(run func() ([]mangas.Tag, error)) *TagMock_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: tag
+func (_m *TagMock) UpdateTag(tag *mangas.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mangas.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagMock_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type TagMock_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - tag *mangas.Tag
+func (_e *TagMock_Expecter) UpdateTag(tag interface{}) *TagMock_UpdateTag_Call {
+	return &TagMock_UpdateTag_Call{Call: _e.mock.On("UpdateTag", tag)}
+}
+
+func (_c *TagMock_UpdateTag_Call) Run(run func(tag *mangas.Tag)) *TagMock_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*mangas.Tag))
+	})
+	return _c
+}
+
+func (_c *TagMock_UpdateTag_Call) Return(_a0 error) *TagMock_UpdateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_UpdateTag_Call) RunAndReturn(run func(*mangas.Tag) error) *TagMock_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagMock creates a new instance of TagMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagMock {
+	mock := &TagMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
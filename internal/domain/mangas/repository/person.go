@@ -0,0 +1,16 @@
+package repository
+
+import (
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/infrastructure/repository"
+)
+
+type IPerson interface {
+  CreatePerson(person *mangas.Person) error
+  UpdatePerson(person *mangas.Person) error
+  DeletePersonById(personId string) error
+  // FindPersonById Get person with all the mangas credited to
+  FindPersonById(personId string) (*mangas.Person, error)
+  // ListPeople Get all people which name is similar to the name parameter, set name to empty string to get all of them
+  ListPeople(name string, parameter repository.QueryParameter) (repository.PagedQueryResult[[]mangas.Person], error)
+}
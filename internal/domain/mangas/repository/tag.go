@@ -0,0 +1,10 @@
+package repository
+
+import "manga-explorer/internal/domain/mangas"
+
+type ITag interface {
+  CreateTag(tag *mangas.Tag) error
+  UpdateTag(tag *mangas.Tag) error
+  DeleteTagById(tagId string) error
+  ListTags() ([]mangas.Tag, error)
+}
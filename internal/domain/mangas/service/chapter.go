@@ -14,17 +14,38 @@ type IChapter interface {
 	DeleteChapter(chapterId string) status.Object
 	// EditChapter edit manga chapter
 	EditChapter(input *dto.ChapterEditInput) status.Object
+	// EditChapterGroups add or remove groups credited on the chapter
+	EditChapterGroups(input *dto.ChapterGroupEditInput) status.Object
+	// MoveChapters move the chapters into another volume of the same manga or detach them when the volume is empty
+	MoveChapters(input *dto.ChapterMoveInput) status.Object
+	// FindMangaChapters find chapters of the manga, the chapters will have read flag when the input has user
+	FindMangaChapters(input *dto.MangaChaptersFindInput) ([]dto.ChapterResponse, *dto2.ResponsePage, status.Object)
 	// FindChapterDetails Get manga chapter pages
 	FindMangaChapterHistories(input *dto.MangaChapterHistoriesFindInput) ([]dto.ChapterResponse, *dto2.ResponsePage, status.Object)
 	FindChapterDetails(chapterId string, userId opt.Optional[string]) (dto.ChapterResponse, status.Object)
-	// InsertChapterPage Uploads the image and set it as the page of manga chapter, it will return pages that failed to be inserted
-	InsertChapterPage(input *dto.PageCreateInput) (status.Object, []uint16)
+	// InsertChapterPage Uploads the images and set them as the pages of manga chapter, all pages will be inserted or none
+	// of them. It will return the inserted pages or the pages that failed to be inserted
+	InsertChapterPage(input *dto.PageCreateInput) ([]dto.PageUploadResponse, []uint16, status.Object)
+	// InsertChapterArchive extract the images of CBZ/ZIP archive as the chapter pages, all pages will be inserted or none of them
+	InsertChapterArchive(input *dto.PageArchiveCreateInput) ([]dto.PageUploadResponse, status.Object)
 	// CreateChapterComment Upsert new comment for manga chapter
 	CreateChapterComment(input *dto.ChapterCommentCreateInput) status.Object
 	// CreatePageComment Upsert new comment for chapter page
 	CreatePageComment(input *dto.PageCommentCreateInput) status.Object
 	// DeleteChapterPages Delete manga chapter pages based on the page numbers
 	DeleteChapterPages(input *dto.PageDeleteInput) status.Object
+	// ReorderChapterPages renumber all pages of the chapter based on the order of the page ids
+	ReorderChapterPages(input *dto.PageReorderInput) status.Object
+	// ReplaceChapterPage replace the image of the page in place, the previous image will be deleted
+	ReplaceChapterPage(input *dto.PageReplaceInput) (dto.PageUploadResponse, status.Object)
+	// DownloadChapter create CBZ archive of the chapter with ComicInfo.xml, the manga should allow download
+	DownloadChapter(chapterId string) (dto2.FileResponse, status.Object)
+	// DownloadVolume create ZIP archive containing CBZ archive of each chapter in the volume, the manga should allow download
+	DownloadVolume(volumeId string) (dto2.FileResponse, status.Object)
+	// ExportChapter create fixed-layout EPUB of the chapter, the manga should allow export
+	ExportChapter(input *dto.ChapterExportInput) (dto2.FileResponse, status.Object)
+	// ExportVolume create fixed-layout EPUB of the volume where each chapter is a section, the manga should allow export
+	ExportVolume(input *dto.VolumeExportInput) (dto2.FileResponse, status.Object)
 	// FindVolumeDetails find all chapters in a volume
 	FindVolumeDetails(volumeId string) (dto.VolumeResponse, status.Object)
 	// FindChapterComments find all chapter comments
@@ -0,0 +1,24 @@
+package service
+
+import (
+  dto2 "manga-explorer/internal/common/dto"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+)
+
+type IGroup interface {
+  // CreateGroup create new group which could be credited on chapters
+  CreateGroup(input *dto.GroupCreateInput) status.Object
+  // UpdateGroup update group details
+  UpdateGroup(input *dto.GroupEditInput) status.Object
+  // DeleteGroup delete group by the id, it will also remove the group from all credited chapters
+  DeleteGroup(groupId string) status.Object
+  // FindGroupById get group details with the members
+  FindGroupById(groupId string) (dto.GroupResponse, status.Object)
+  // ListGroups get all groups, filtered by the name when it is provided
+  ListGroups(input *dto.GroupListInput) ([]dto.GroupResponse, *dto2.ResponsePage, status.Object)
+  // EditGroupMembers add, remove or change role of the group members
+  EditGroupMembers(input *dto.GroupMemberEditInput) status.Object
+  // FindGroupReleases get all chapters released by the group
+  FindGroupReleases(input *dto.GroupReleaseInput) ([]dto.ChapterResponse, *dto2.ResponsePage, status.Object)
+}
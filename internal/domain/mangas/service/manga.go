@@ -5,36 +5,68 @@ import (
   dto2 "manga-explorer/internal/common/dto"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/domain/mangas/dto"
+  "manga-explorer/internal/util/opt"
 )
 
 type IManga interface {
   // CreateManga create new manga
   CreateManga(input *dto.MangaCreateInput) status.Object
-  UpdateMangaCover(input *dto.MangaCoverUpdateInput) status.Object
+  // UpdateMangaCover Upload new cover image for the manga and replace the previous one
+  UpdateMangaCover(input *dto.MangaCoverUpdateInput) (dto2.UploadResponse, status.Object)
+  // EditMangaSlug change the slug of the manga, the previous slug will still be resolvable
+  EditMangaSlug(input *dto.MangaSlugEditInput) status.Object
+  // EditMangaDownload allow or disallow the chapters and volumes of the manga to be downloaded
+  EditMangaDownload(input *dto.MangaDownloadEditInput) status.Object
+  // EditMangaExport allow or disallow the chapters and volumes of the manga to be exported as EPUB
+  EditMangaExport(input *dto.MangaExportEditInput) status.Object
   EditManga(input *dto.MangaEditInput) status.Object
   EditMangaGenres(input *dto.MangaGenreEditInput) status.Object
+  // EditMangaTags add or remove tags of the manga
+  EditMangaTags(input *dto.MangaTagEditInput) status.Object
+  // EditMangaStaff add or remove people credited on the manga
+  EditMangaStaff(input *dto.MangaStaffEditInput) status.Object
   // CreateVolume Upsert a new volume which should be belonged to manga with 0 chapters
   CreateVolume(input *dto.VolumeCreateInput) status.Object
-  // DeleteVolume Delete volume and make the chapters based on the volume into NULL
+  // DeleteVolume Delete volume and detach the chapters from the volume, the chapters are still belonged to the manga
   DeleteVolume(input *dto.VolumeDeleteInput) status.Object
+  // EditVolume Update the metadata of the volume
+  EditVolume(input *dto.VolumeEditInput) status.Object
+  // UpdateVolumeCover Upload new cover image for the volume and replace the previous one
+  UpdateVolumeCover(input *dto.VolumeCoverUpdateInput) (dto2.UploadResponse, status.Object)
   // CreateComments Upsert a new comment for manga, chapter, and page
   CreateComments(input *dto.MangaCommentCreateInput) status.Object
   // UpsertMangaRating Upsert or Update manga rating
   UpsertMangaRating(input *dto.RateUpsertInput) status.Object
+  // FindMangaHistories find user's read mangas, the mangas are filtered by user's allowed content ratings
   FindMangaHistories(userId string, query *dto2.PagedQueryInput) ([]dto.MangaHistoryResponse, *dto2.ResponsePage, status.Object)
+  // FindMangaFavorites find user's favorite mangas, the mangas are filtered by user's allowed content ratings
   FindMangaFavorites(userId string, query *dto2.PagedQueryInput) ([]dto.MangaFavoriteResponse, *dto2.ResponsePage, status.Object)
-  ListMangas(query *dto2.PagedQueryInput) ([]dto.MinimalMangaResponse, *dto2.ResponsePage, status.Object)
-  SearchMangas(query *dto.MangaSearchQuery) ([]dto.MinimalMangaResponse, *dto2.ResponsePage, status.Object)
+  // ListMangas list mangas allowed by the user's content ratings, anonymous user will only get safe mangas
+  ListMangas(query *dto2.PagedQueryInput, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, *dto2.ResponsePage, status.Object)
+  // SearchMangas search mangas allowed by the user's content ratings, anonymous user will only get safe mangas
+  SearchMangas(query *dto.MangaSearchQuery, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, *dto2.ResponsePage, status.Object)
   InsertMangaTranslations(input *dto.MangaTranslationInsertInput) status.Object
   FindMangaTranslations(mangaId string) ([]dto.TranslationResponse, status.Object)
   FindSpecificMangaTranslation(mangaId string, language common.Language) (dto.TranslationResponse, status.Object)
   DeleteMangaTranslations(input *dto.MangaTranslationsDeleteInput) status.Object
   DeleteTranslations(input *dto.TranslationDeleteInput) status.Object
   UpdateTranslation(input *dto.TranslationEditInput) status.Object
+  // InsertMangaAlternativeTitles add alternative titles like romaji, abbreviation and synonyms into the manga
+  InsertMangaAlternativeTitles(input *dto.MangaAlternativeTitleInsertInput) status.Object
+  FindMangaAlternativeTitles(mangaId string) ([]dto.AlternativeTitleResponse, status.Object)
+  DeleteMangaAlternativeTitles(input *dto.MangaAlternativeTitleDeleteInput) status.Object
+  // CreateMangaRelation link two mangas, the inverse relation will be created too when it is available
+  CreateMangaRelation(input *dto.MangaRelationCreateInput) status.Object
+  // DeleteMangaRelation unlink two mangas on both directions
+  DeleteMangaRelation(input *dto.MangaRelationDeleteInput) status.Object
+  FindMangaRelations(mangaId string) ([]dto.MangaRelationResponse, status.Object)
   // FindMangaByIds find mangas based on the ids
   FindMangaByIds(mangaId ...string) ([]dto.MangaResponse, status.Object)
-  // FindRandomMangas find random based mangas and will return n manga count. n is limit parameter
-  FindRandomMangas(limit uint64) ([]dto.MinimalMangaResponse, status.Object)
+  // FindMangaBySlug find manga based on the current or previous slugs
+  FindMangaBySlug(slug string) ([]dto.MangaResponse, status.Object)
+  // FindRandomMangas find random based mangas and will return n manga count. n is limit parameter.
+  // The mangas are filtered by the user's content ratings, anonymous user will only get safe mangas
+  FindRandomMangas(limit uint64, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, status.Object)
   // FindMangaComments find all manga comments
   FindMangaComments(mangaId string) ([]dto.CommentResponse, status.Object)
   // FindMangaRatings find all manga ratings
@@ -256,6 +256,118 @@ func (_c *ChapterMock_DeleteChapterPages_Call) RunAndReturn(run func(*dto.PageDe
 	return _c
 }
 
+// DownloadChapter provides a mock function with given fields: chapterId
+func (_m *ChapterMock) DownloadChapter(chapterId string) (commondto.FileResponse, status.Object) {
+	ret := _m.Called(chapterId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadChapter")
+	}
+
+	var r0 commondto.FileResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) (commondto.FileResponse, status.Object)); ok {
+		return rf(chapterId)
+	}
+	if rf, ok := ret.Get(0).(func(string) commondto.FileResponse); ok {
+		r0 = rf(chapterId)
+	} else {
+		r0 = ret.Get(0).(commondto.FileResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(chapterId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_DownloadChapter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadChapter'
+type ChapterMock_DownloadChapter_Call struct {
+	*mock.Call
+}
+
+// DownloadChapter is a helper method to define mock.On call
+//   - chapterId string
+func (_e *ChapterMock_Expecter) DownloadChapter(chapterId interface{}) *ChapterMock_DownloadChapter_Call {
+	return &ChapterMock_DownloadChapter_Call{Call: _e.mock.On("DownloadChapter", chapterId)}
+}
+
+func (_c *ChapterMock_DownloadChapter_Call) Run(run func(chapterId string)) *ChapterMock_DownloadChapter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_DownloadChapter_Call) Return(_a0 commondto.FileResponse, _a1 status.Object) *ChapterMock_DownloadChapter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_DownloadChapter_Call) RunAndReturn(run func(string) (commondto.FileResponse, status.Object)) *ChapterMock_DownloadChapter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadVolume provides a mock function with given fields: volumeId
+func (_m *ChapterMock) DownloadVolume(volumeId string) (commondto.FileResponse, status.Object) {
+	ret := _m.Called(volumeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadVolume")
+	}
+
+	var r0 commondto.FileResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) (commondto.FileResponse, status.Object)); ok {
+		return rf(volumeId)
+	}
+	if rf, ok := ret.Get(0).(func(string) commondto.FileResponse); ok {
+		r0 = rf(volumeId)
+	} else {
+		r0 = ret.Get(0).(commondto.FileResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(volumeId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_DownloadVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadVolume'
+type ChapterMock_DownloadVolume_Call struct {
+	*mock.Call
+}
+
+// DownloadVolume is a helper method to define mock.On call
+//   - volumeId string
+func (_e *ChapterMock_Expecter) DownloadVolume(volumeId interface{}) *ChapterMock_DownloadVolume_Call {
+	return &ChapterMock_DownloadVolume_Call{Call: _e.mock.On("DownloadVolume", volumeId)}
+}
+
+func (_c *ChapterMock_DownloadVolume_Call) Run(run func(volumeId string)) *ChapterMock_DownloadVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_DownloadVolume_Call) Return(_a0 commondto.FileResponse, _a1 status.Object) *ChapterMock_DownloadVolume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_DownloadVolume_Call) RunAndReturn(run func(string) (commondto.FileResponse, status.Object)) *ChapterMock_DownloadVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EditChapter provides a mock function with given fields: input
 func (_m *ChapterMock) EditChapter(input *dto.ChapterEditInput) status.Object {
 	ret := _m.Called(input)
@@ -302,6 +414,164 @@ func (_c *ChapterMock_EditChapter_Call) RunAndReturn(run func(*dto.ChapterEditIn
 	return _c
 }
 
+// EditChapterGroups provides a mock function with given fields: input
+func (_m *ChapterMock) EditChapterGroups(input *dto.ChapterGroupEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditChapterGroups")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.ChapterGroupEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// ChapterMock_EditChapterGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditChapterGroups'
+type ChapterMock_EditChapterGroups_Call struct {
+	*mock.Call
+}
+
+// EditChapterGroups is a helper method to define mock.On call
+//   - input *dto.ChapterGroupEditInput
+func (_e *ChapterMock_Expecter) EditChapterGroups(input interface{}) *ChapterMock_EditChapterGroups_Call {
+	return &ChapterMock_EditChapterGroups_Call{Call: _e.mock.On("EditChapterGroups", input)}
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) Run(run func(input *dto.ChapterGroupEditInput)) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.ChapterGroupEditInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) Return(_a0 status.Object) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChapterMock_EditChapterGroups_Call) RunAndReturn(run func(*dto.ChapterGroupEditInput) status.Object) *ChapterMock_EditChapterGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportChapter provides a mock function with given fields: input
+func (_m *ChapterMock) ExportChapter(input *dto.ChapterExportInput) (commondto.FileResponse, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportChapter")
+	}
+
+	var r0 commondto.FileResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.ChapterExportInput) (commondto.FileResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.ChapterExportInput) commondto.FileResponse); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(commondto.FileResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.ChapterExportInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_ExportChapter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportChapter'
+type ChapterMock_ExportChapter_Call struct {
+	*mock.Call
+}
+
+// ExportChapter is a helper method to define mock.On call
+//   - input *dto.ChapterExportInput
+func (_e *ChapterMock_Expecter) ExportChapter(input interface{}) *ChapterMock_ExportChapter_Call {
+	return &ChapterMock_ExportChapter_Call{Call: _e.mock.On("ExportChapter", input)}
+}
+
+func (_c *ChapterMock_ExportChapter_Call) Run(run func(input *dto.ChapterExportInput)) *ChapterMock_ExportChapter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.ChapterExportInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ExportChapter_Call) Return(_a0 commondto.FileResponse, _a1 status.Object) *ChapterMock_ExportChapter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_ExportChapter_Call) RunAndReturn(run func(*dto.ChapterExportInput) (commondto.FileResponse, status.Object)) *ChapterMock_ExportChapter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportVolume provides a mock function with given fields: input
+func (_m *ChapterMock) ExportVolume(input *dto.VolumeExportInput) (commondto.FileResponse, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportVolume")
+	}
+
+	var r0 commondto.FileResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.VolumeExportInput) (commondto.FileResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.VolumeExportInput) commondto.FileResponse); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(commondto.FileResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.VolumeExportInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_ExportVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportVolume'
+type ChapterMock_ExportVolume_Call struct {
+	*mock.Call
+}
+
+// ExportVolume is a helper method to define mock.On call
+//   - input *dto.VolumeExportInput
+func (_e *ChapterMock_Expecter) ExportVolume(input interface{}) *ChapterMock_ExportVolume_Call {
+	return &ChapterMock_ExportVolume_Call{Call: _e.mock.On("ExportVolume", input)}
+}
+
+func (_c *ChapterMock_ExportVolume_Call) Run(run func(input *dto.VolumeExportInput)) *ChapterMock_ExportVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.VolumeExportInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ExportVolume_Call) Return(_a0 commondto.FileResponse, _a1 status.Object) *ChapterMock_ExportVolume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_ExportVolume_Call) RunAndReturn(run func(*dto.VolumeExportInput) (commondto.FileResponse, status.Object)) *ChapterMock_ExportVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindChapterComments provides a mock function with given fields: chapterId
 func (_m *ChapterMock) FindChapterComments(chapterId string) ([]dto.CommentResponse, status.Object) {
 	ret := _m.Called(chapterId)
@@ -484,6 +754,73 @@ func (_c *ChapterMock_FindMangaChapterHistories_Call) RunAndReturn(run func(*dto
 	return _c
 }
 
+// FindMangaChapters provides a mock function with given fields: input
+func (_m *ChapterMock) FindMangaChapters(input *dto.MangaChaptersFindInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaChapters")
+	}
+
+	var r0 []dto.ChapterResponse
+	var r1 *commondto.ResponsePage
+	var r2 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaChaptersFindInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.MangaChaptersFindInput) []dto.ChapterResponse); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ChapterResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.MangaChaptersFindInput) *commondto.ResponsePage); ok {
+		r1 = rf(input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*commondto.ResponsePage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*dto.MangaChaptersFindInput) status.Object); ok {
+		r2 = rf(input)
+	} else {
+		r2 = ret.Get(2).(status.Object)
+	}
+
+	return r0, r1, r2
+}
+
+// ChapterMock_FindMangaChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaChapters'
+type ChapterMock_FindMangaChapters_Call struct {
+	*mock.Call
+}
+
+// FindMangaChapters is a helper method to define mock.On call
+//   - input *dto.MangaChaptersFindInput
+func (_e *ChapterMock_Expecter) FindMangaChapters(input interface{}) *ChapterMock_FindMangaChapters_Call {
+	return &ChapterMock_FindMangaChapters_Call{Call: _e.mock.On("FindMangaChapters", input)}
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) Run(run func(input *dto.MangaChaptersFindInput)) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaChaptersFindInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) Return(_a0 []dto.ChapterResponse, _a1 *commondto.ResponsePage, _a2 status.Object) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ChapterMock_FindMangaChapters_Call) RunAndReturn(run func(*dto.MangaChaptersFindInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object)) *ChapterMock_FindMangaChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindPageComments provides a mock function with given fields: pageId
 func (_m *ChapterMock) FindPageComments(pageId string) ([]dto.CommentResponse, status.Object) {
 	ret := _m.Called(pageId)
@@ -598,22 +935,101 @@ func (_c *ChapterMock_FindVolumeDetails_Call) RunAndReturn(run func(string) (dto
 	return _c
 }
 
+// InsertChapterArchive provides a mock function with given fields: input
+func (_m *ChapterMock) InsertChapterArchive(input *dto.PageArchiveCreateInput) ([]dto.PageUploadResponse, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertChapterArchive")
+	}
+
+	var r0 []dto.PageUploadResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PageArchiveCreateInput) ([]dto.PageUploadResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.PageArchiveCreateInput) []dto.PageUploadResponse); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PageUploadResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.PageArchiveCreateInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_InsertChapterArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertChapterArchive'
+type ChapterMock_InsertChapterArchive_Call struct {
+	*mock.Call
+}
+
+// InsertChapterArchive is a helper method to define mock.On call
+//   - input *dto.PageArchiveCreateInput
+func (_e *ChapterMock_Expecter) InsertChapterArchive(input interface{}) *ChapterMock_InsertChapterArchive_Call {
+	return &ChapterMock_InsertChapterArchive_Call{Call: _e.mock.On("InsertChapterArchive", input)}
+}
+
+func (_c *ChapterMock_InsertChapterArchive_Call) Run(run func(input *dto.PageArchiveCreateInput)) *ChapterMock_InsertChapterArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PageArchiveCreateInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_InsertChapterArchive_Call) Return(_a0 []dto.PageUploadResponse, _a1 status.Object) *ChapterMock_InsertChapterArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_InsertChapterArchive_Call) RunAndReturn(run func(*dto.PageArchiveCreateInput) ([]dto.PageUploadResponse, status.Object)) *ChapterMock_InsertChapterArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertChapterPage provides a mock function with given fields: input
-func (_m *ChapterMock) InsertChapterPage(input *dto.PageCreateInput) status.Object {
+func (_m *ChapterMock) InsertChapterPage(input *dto.PageCreateInput) ([]dto.PageUploadResponse, []uint16, status.Object) {
 	ret := _m.Called(input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertChapterPage")
 	}
 
-	var r0 status.Object
-	if rf, ok := ret.Get(0).(func(*dto.PageCreateInput) status.Object); ok {
+	var r0 []dto.PageUploadResponse
+	var r1 []uint16
+	var r2 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PageCreateInput) ([]dto.PageUploadResponse, []uint16, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.PageCreateInput) []dto.PageUploadResponse); ok {
 		r0 = rf(input)
 	} else {
-		r0 = ret.Get(0).(status.Object)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PageUploadResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*dto.PageCreateInput) []uint16); ok {
+		r1 = rf(input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]uint16)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*dto.PageCreateInput) status.Object); ok {
+		r2 = rf(input)
+	} else {
+		r2 = ret.Get(2).(status.Object)
+	}
+
+	return r0, r1, r2
 }
 
 // ChapterMock_InsertChapterPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertChapterPage'
@@ -634,12 +1050,160 @@ func (_c *ChapterMock_InsertChapterPage_Call) Run(run func(input *dto.PageCreate
 	return _c
 }
 
-func (_c *ChapterMock_InsertChapterPage_Call) Return(_a0 status.Object) *ChapterMock_InsertChapterPage_Call {
+func (_c *ChapterMock_InsertChapterPage_Call) Return(_a0 []dto.PageUploadResponse, _a1 []uint16, _a2 status.Object) *ChapterMock_InsertChapterPage_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ChapterMock_InsertChapterPage_Call) RunAndReturn(run func(*dto.PageCreateInput) ([]dto.PageUploadResponse, []uint16, status.Object)) *ChapterMock_InsertChapterPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveChapters provides a mock function with given fields: input
+func (_m *ChapterMock) MoveChapters(input *dto.ChapterMoveInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveChapters")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.ChapterMoveInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// ChapterMock_MoveChapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveChapters'
+type ChapterMock_MoveChapters_Call struct {
+	*mock.Call
+}
+
+// MoveChapters is a helper method to define mock.On call
+//   - input *dto.ChapterMoveInput
+func (_e *ChapterMock_Expecter) MoveChapters(input interface{}) *ChapterMock_MoveChapters_Call {
+	return &ChapterMock_MoveChapters_Call{Call: _e.mock.On("MoveChapters", input)}
+}
+
+func (_c *ChapterMock_MoveChapters_Call) Run(run func(input *dto.ChapterMoveInput)) *ChapterMock_MoveChapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.ChapterMoveInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_MoveChapters_Call) Return(_a0 status.Object) *ChapterMock_MoveChapters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChapterMock_MoveChapters_Call) RunAndReturn(run func(*dto.ChapterMoveInput) status.Object) *ChapterMock_MoveChapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderChapterPages provides a mock function with given fields: input
+func (_m *ChapterMock) ReorderChapterPages(input *dto.PageReorderInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderChapterPages")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PageReorderInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// ChapterMock_ReorderChapterPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderChapterPages'
+type ChapterMock_ReorderChapterPages_Call struct {
+	*mock.Call
+}
+
+// ReorderChapterPages is a helper method to define mock.On call
+//   - input *dto.PageReorderInput
+func (_e *ChapterMock_Expecter) ReorderChapterPages(input interface{}) *ChapterMock_ReorderChapterPages_Call {
+	return &ChapterMock_ReorderChapterPages_Call{Call: _e.mock.On("ReorderChapterPages", input)}
+}
+
+func (_c *ChapterMock_ReorderChapterPages_Call) Run(run func(input *dto.PageReorderInput)) *ChapterMock_ReorderChapterPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PageReorderInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ReorderChapterPages_Call) Return(_a0 status.Object) *ChapterMock_ReorderChapterPages_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ChapterMock_InsertChapterPage_Call) RunAndReturn(run func(*dto.PageCreateInput) status.Object) *ChapterMock_InsertChapterPage_Call {
+func (_c *ChapterMock_ReorderChapterPages_Call) RunAndReturn(run func(*dto.PageReorderInput) status.Object) *ChapterMock_ReorderChapterPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceChapterPage provides a mock function with given fields: input
+func (_m *ChapterMock) ReplaceChapterPage(input *dto.PageReplaceInput) (dto.PageUploadResponse, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceChapterPage")
+	}
+
+	var r0 dto.PageUploadResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PageReplaceInput) (dto.PageUploadResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.PageReplaceInput) dto.PageUploadResponse); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(dto.PageUploadResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.PageReplaceInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// ChapterMock_ReplaceChapterPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceChapterPage'
+type ChapterMock_ReplaceChapterPage_Call struct {
+	*mock.Call
+}
+
+// ReplaceChapterPage is a helper method to define mock.On call
+//   - input *dto.PageReplaceInput
+func (_e *ChapterMock_Expecter) ReplaceChapterPage(input interface{}) *ChapterMock_ReplaceChapterPage_Call {
+	return &ChapterMock_ReplaceChapterPage_Call{Call: _e.mock.On("ReplaceChapterPage", input)}
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) Run(run func(input *dto.PageReplaceInput)) *ChapterMock_ReplaceChapterPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PageReplaceInput))
+	})
+	return _c
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) Return(_a0 dto.PageUploadResponse, _a1 status.Object) *ChapterMock_ReplaceChapterPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChapterMock_ReplaceChapterPage_Call) RunAndReturn(run func(*dto.PageReplaceInput) (dto.PageUploadResponse, status.Object)) *ChapterMock_ReplaceChapterPage_Call {
 	_c.Call.Return(run)
 	return _c
 }
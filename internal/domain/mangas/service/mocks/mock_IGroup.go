@@ -0,0 +1,413 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package service
+
+import (
+	commondto "manga-explorer/internal/common/dto"
+	dto "manga-explorer/internal/domain/mangas/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "manga-explorer/internal/common/status"
+)
+
+// GroupMock is an autogenerated mock type for the IGroup type
+type GroupMock struct {
+	mock.Mock
+}
+
+type GroupMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupMock) EXPECT() *GroupMock_Expecter {
+	return &GroupMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateGroup provides a mock function with given fields: input
+func (_m *GroupMock) CreateGroup(input *dto.GroupCreateInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroup")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.GroupCreateInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// GroupMock_CreateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroup'
+type GroupMock_CreateGroup_Call struct {
+	*mock.Call
+}
+
+// CreateGroup is a helper method to define mock.On call
+//   - input *dto.GroupCreateInput
+func (_e *GroupMock_Expecter) CreateGroup(input interface{}) *GroupMock_CreateGroup_Call {
+	return &GroupMock_CreateGroup_Call{Call: _e.mock.On("CreateGroup", input)}
+}
+
+func (_c *GroupMock_CreateGroup_Call) Run(run func(input *dto.GroupCreateInput)) *GroupMock_CreateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.GroupCreateInput))
+	})
+	return _c
+}
+
+func (_c *GroupMock_CreateGroup_Call) Return(_a0 status.Object) *GroupMock_CreateGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_CreateGroup_Call) RunAndReturn(run func(*dto.GroupCreateInput) status.Object) *GroupMock_CreateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroup provides a mock function with given fields: groupId
+func (_m *GroupMock) DeleteGroup(groupId string) status.Object {
+	ret := _m.Called(groupId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(string) status.Object); ok {
+		r0 = rf(groupId)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// GroupMock_DeleteGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroup'
+type GroupMock_DeleteGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteGroup is a helper method to define mock.On call
+//   - groupId string
+func (_e *GroupMock_Expecter) DeleteGroup(groupId interface{}) *GroupMock_DeleteGroup_Call {
+	return &GroupMock_DeleteGroup_Call{Call: _e.mock.On("DeleteGroup", groupId)}
+}
+
+func (_c *GroupMock_DeleteGroup_Call) Run(run func(groupId string)) *GroupMock_DeleteGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupMock_DeleteGroup_Call) Return(_a0 status.Object) *GroupMock_DeleteGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_DeleteGroup_Call) RunAndReturn(run func(string) status.Object) *GroupMock_DeleteGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditGroupMembers provides a mock function with given fields: input
+func (_m *GroupMock) EditGroupMembers(input *dto.GroupMemberEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditGroupMembers")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.GroupMemberEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// GroupMock_EditGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditGroupMembers'
+type GroupMock_EditGroupMembers_Call struct {
+	*mock.Call
+}
+
+// EditGroupMembers is a helper method to define mock.On call
+//   - input *dto.GroupMemberEditInput
+func (_e *GroupMock_Expecter) EditGroupMembers(input interface{}) *GroupMock_EditGroupMembers_Call {
+	return &GroupMock_EditGroupMembers_Call{Call: _e.mock.On("EditGroupMembers", input)}
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) Run(run func(input *dto.GroupMemberEditInput)) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.GroupMemberEditInput))
+	})
+	return _c
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) Return(_a0 status.Object) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_EditGroupMembers_Call) RunAndReturn(run func(*dto.GroupMemberEditInput) status.Object) *GroupMock_EditGroupMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindGroupById provides a mock function with given fields: groupId
+func (_m *GroupMock) FindGroupById(groupId string) (dto.GroupResponse, status.Object) {
+	ret := _m.Called(groupId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindGroupById")
+	}
+
+	var r0 dto.GroupResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) (dto.GroupResponse, status.Object)); ok {
+		return rf(groupId)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.GroupResponse); ok {
+		r0 = rf(groupId)
+	} else {
+		r0 = ret.Get(0).(dto.GroupResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(groupId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// GroupMock_FindGroupById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindGroupById'
+type GroupMock_FindGroupById_Call struct {
+	*mock.Call
+}
+
+// FindGroupById is a helper method to define mock.On call
+//   - groupId string
+func (_e *GroupMock_Expecter) FindGroupById(groupId interface{}) *GroupMock_FindGroupById_Call {
+	return &GroupMock_FindGroupById_Call{Call: _e.mock.On("FindGroupById", groupId)}
+}
+
+func (_c *GroupMock_FindGroupById_Call) Run(run func(groupId string)) *GroupMock_FindGroupById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupMock_FindGroupById_Call) Return(_a0 dto.GroupResponse, _a1 status.Object) *GroupMock_FindGroupById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMock_FindGroupById_Call) RunAndReturn(run func(string) (dto.GroupResponse, status.Object)) *GroupMock_FindGroupById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindGroupReleases provides a mock function with given fields: input
+func (_m *GroupMock) FindGroupReleases(input *dto.GroupReleaseInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindGroupReleases")
+	}
+
+	var r0 []dto.ChapterResponse
+	var r1 *commondto.ResponsePage
+	var r2 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.GroupReleaseInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.GroupReleaseInput) []dto.ChapterResponse); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ChapterResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.GroupReleaseInput) *commondto.ResponsePage); ok {
+		r1 = rf(input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*commondto.ResponsePage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*dto.GroupReleaseInput) status.Object); ok {
+		r2 = rf(input)
+	} else {
+		r2 = ret.Get(2).(status.Object)
+	}
+
+	return r0, r1, r2
+}
+
+// GroupMock_FindGroupReleases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindGroupReleases'
+type GroupMock_FindGroupReleases_Call struct {
+	*mock.Call
+}
+
+// FindGroupReleases is a helper method to define mock.On call
+//   - input *dto.GroupReleaseInput
+func (_e *GroupMock_Expecter) FindGroupReleases(input interface{}) *GroupMock_FindGroupReleases_Call {
+	return &GroupMock_FindGroupReleases_Call{Call: _e.mock.On("FindGroupReleases", input)}
+}
+
+func (_c *GroupMock_FindGroupReleases_Call) Run(run func(input *dto.GroupReleaseInput)) *GroupMock_FindGroupReleases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.GroupReleaseInput))
+	})
+	return _c
+}
+
+func (_c *GroupMock_FindGroupReleases_Call) Return(_a0 []dto.ChapterResponse, _a1 *commondto.ResponsePage, _a2 status.Object) *GroupMock_FindGroupReleases_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *GroupMock_FindGroupReleases_Call) RunAndReturn(run func(*dto.GroupReleaseInput) ([]dto.ChapterResponse, *commondto.ResponsePage, status.Object)) *GroupMock_FindGroupReleases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroups provides a mock function with given fields: input
+func (_m *GroupMock) ListGroups(input *dto.GroupListInput) ([]dto.GroupResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroups")
+	}
+
+	var r0 []dto.GroupResponse
+	var r1 *commondto.ResponsePage
+	var r2 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.GroupListInput) ([]dto.GroupResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.GroupListInput) []dto.GroupResponse); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.GroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.GroupListInput) *commondto.ResponsePage); ok {
+		r1 = rf(input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*commondto.ResponsePage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*dto.GroupListInput) status.Object); ok {
+		r2 = rf(input)
+	} else {
+		r2 = ret.Get(2).(status.Object)
+	}
+
+	return r0, r1, r2
+}
+
+// GroupMock_ListGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroups'
+type GroupMock_ListGroups_Call struct {
+	*mock.Call
+}
+
+// ListGroups is a helper method to define mock.On call
+//   - input *dto.GroupListInput
+func (_e *GroupMock_Expecter) ListGroups(input interface{}) *GroupMock_ListGroups_Call {
+	return &GroupMock_ListGroups_Call{Call: _e.mock.On("ListGroups", input)}
+}
+
+func (_c *GroupMock_ListGroups_Call) Run(run func(input *dto.GroupListInput)) *GroupMock_ListGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.GroupListInput))
+	})
+	return _c
+}
+
+func (_c *GroupMock_ListGroups_Call) Return(_a0 []dto.GroupResponse, _a1 *commondto.ResponsePage, _a2 status.Object) *GroupMock_ListGroups_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *GroupMock_ListGroups_Call) RunAndReturn(run func(*dto.GroupListInput) ([]dto.GroupResponse, *commondto.ResponsePage, status.Object)) *GroupMock_ListGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGroup provides a mock function with given fields: input
+func (_m *GroupMock) UpdateGroup(input *dto.GroupEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroup")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.GroupEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// GroupMock_UpdateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroup'
+type GroupMock_UpdateGroup_Call struct {
+	*mock.Call
+}
+
+// UpdateGroup is a helper method to define mock.On call
+//   - input *dto.GroupEditInput
+func (_e *GroupMock_Expecter) UpdateGroup(input interface{}) *GroupMock_UpdateGroup_Call {
+	return &GroupMock_UpdateGroup_Call{Call: _e.mock.On("UpdateGroup", input)}
+}
+
+func (_c *GroupMock_UpdateGroup_Call) Run(run func(input *dto.GroupEditInput)) *GroupMock_UpdateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.GroupEditInput))
+	})
+	return _c
+}
+
+func (_c *GroupMock_UpdateGroup_Call) Return(_a0 status.Object) *GroupMock_UpdateGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupMock_UpdateGroup_Call) RunAndReturn(run func(*dto.GroupEditInput) status.Object) *GroupMock_UpdateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupMock creates a new instance of GroupMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupMock {
+	mock := &GroupMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	opt "manga-explorer/internal/util/opt"
+
 	status "manga-explorer/internal/common/status"
 )
 
@@ -164,6 +166,52 @@ func (_c *MangaMock_CreateManga_Call) RunAndReturn(run func(*dto.MangaCreateInpu
 	return _c
 }
 
+// CreateMangaRelation provides a mock function with given fields: input
+func (_m *MangaMock) CreateMangaRelation(input *dto.MangaRelationCreateInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMangaRelation")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaRelationCreateInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_CreateMangaRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMangaRelation'
+type MangaMock_CreateMangaRelation_Call struct {
+	*mock.Call
+}
+
+// CreateMangaRelation is a helper method to define mock.On call
+//   - input *dto.MangaRelationCreateInput
+func (_e *MangaMock_Expecter) CreateMangaRelation(input interface{}) *MangaMock_CreateMangaRelation_Call {
+	return &MangaMock_CreateMangaRelation_Call{Call: _e.mock.On("CreateMangaRelation", input)}
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) Run(run func(input *dto.MangaRelationCreateInput)) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaRelationCreateInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) Return(_a0 status.Object) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_CreateMangaRelation_Call) RunAndReturn(run func(*dto.MangaRelationCreateInput) status.Object) *MangaMock_CreateMangaRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateVolume provides a mock function with given fields: input
 func (_m *MangaMock) CreateVolume(input *dto.VolumeCreateInput) status.Object {
 	ret := _m.Called(input)
@@ -210,6 +258,98 @@ func (_c *MangaMock_CreateVolume_Call) RunAndReturn(run func(*dto.VolumeCreateIn
 	return _c
 }
 
+// DeleteMangaAlternativeTitles provides a mock function with given fields: input
+func (_m *MangaMock) DeleteMangaAlternativeTitles(input *dto.MangaAlternativeTitleDeleteInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMangaAlternativeTitles")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaAlternativeTitleDeleteInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_DeleteMangaAlternativeTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMangaAlternativeTitles'
+type MangaMock_DeleteMangaAlternativeTitles_Call struct {
+	*mock.Call
+}
+
+// DeleteMangaAlternativeTitles is a helper method to define mock.On call
+//   - input *dto.MangaAlternativeTitleDeleteInput
+func (_e *MangaMock_Expecter) DeleteMangaAlternativeTitles(input interface{}) *MangaMock_DeleteMangaAlternativeTitles_Call {
+	return &MangaMock_DeleteMangaAlternativeTitles_Call{Call: _e.mock.On("DeleteMangaAlternativeTitles", input)}
+}
+
+func (_c *MangaMock_DeleteMangaAlternativeTitles_Call) Run(run func(input *dto.MangaAlternativeTitleDeleteInput)) *MangaMock_DeleteMangaAlternativeTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaAlternativeTitleDeleteInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaAlternativeTitles_Call) Return(_a0 status.Object) *MangaMock_DeleteMangaAlternativeTitles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaAlternativeTitles_Call) RunAndReturn(run func(*dto.MangaAlternativeTitleDeleteInput) status.Object) *MangaMock_DeleteMangaAlternativeTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMangaRelation provides a mock function with given fields: input
+func (_m *MangaMock) DeleteMangaRelation(input *dto.MangaRelationDeleteInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMangaRelation")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaRelationDeleteInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_DeleteMangaRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMangaRelation'
+type MangaMock_DeleteMangaRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteMangaRelation is a helper method to define mock.On call
+//   - input *dto.MangaRelationDeleteInput
+func (_e *MangaMock_Expecter) DeleteMangaRelation(input interface{}) *MangaMock_DeleteMangaRelation_Call {
+	return &MangaMock_DeleteMangaRelation_Call{Call: _e.mock.On("DeleteMangaRelation", input)}
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) Run(run func(input *dto.MangaRelationDeleteInput)) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaRelationDeleteInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) Return(_a0 status.Object) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_DeleteMangaRelation_Call) RunAndReturn(run func(*dto.MangaRelationDeleteInput) status.Object) *MangaMock_DeleteMangaRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteMangaTranslations provides a mock function with given fields: input
 func (_m *MangaMock) DeleteMangaTranslations(input *dto.MangaTranslationsDeleteInput) status.Object {
 	ret := _m.Called(input)
@@ -394,6 +534,98 @@ func (_c *MangaMock_EditManga_Call) RunAndReturn(run func(*dto.MangaEditInput) s
 	return _c
 }
 
+// EditMangaDownload provides a mock function with given fields: input
+func (_m *MangaMock) EditMangaDownload(input *dto.MangaDownloadEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaDownload")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaDownloadEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaDownload'
+type MangaMock_EditMangaDownload_Call struct {
+	*mock.Call
+}
+
+// EditMangaDownload is a helper method to define mock.On call
+//   - input *dto.MangaDownloadEditInput
+func (_e *MangaMock_Expecter) EditMangaDownload(input interface{}) *MangaMock_EditMangaDownload_Call {
+	return &MangaMock_EditMangaDownload_Call{Call: _e.mock.On("EditMangaDownload", input)}
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) Run(run func(input *dto.MangaDownloadEditInput)) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaDownloadEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) Return(_a0 status.Object) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaDownload_Call) RunAndReturn(run func(*dto.MangaDownloadEditInput) status.Object) *MangaMock_EditMangaDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMangaExport provides a mock function with given fields: input
+func (_m *MangaMock) EditMangaExport(input *dto.MangaExportEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaExport")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaExportEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaExport'
+type MangaMock_EditMangaExport_Call struct {
+	*mock.Call
+}
+
+// EditMangaExport is a helper method to define mock.On call
+//   - input *dto.MangaExportEditInput
+func (_e *MangaMock_Expecter) EditMangaExport(input interface{}) *MangaMock_EditMangaExport_Call {
+	return &MangaMock_EditMangaExport_Call{Call: _e.mock.On("EditMangaExport", input)}
+}
+
+func (_c *MangaMock_EditMangaExport_Call) Run(run func(input *dto.MangaExportEditInput)) *MangaMock_EditMangaExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaExportEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaExport_Call) Return(_a0 status.Object) *MangaMock_EditMangaExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaExport_Call) RunAndReturn(run func(*dto.MangaExportEditInput) status.Object) *MangaMock_EditMangaExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EditMangaGenres provides a mock function with given fields: input
 func (_m *MangaMock) EditMangaGenres(input *dto.MangaGenreEditInput) status.Object {
 	ret := _m.Called(input)
@@ -440,6 +672,248 @@ func (_c *MangaMock_EditMangaGenres_Call) RunAndReturn(run func(*dto.MangaGenreE
 	return _c
 }
 
+// EditMangaSlug provides a mock function with given fields: input
+func (_m *MangaMock) EditMangaSlug(input *dto.MangaSlugEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaSlug")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaSlugEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaSlug'
+type MangaMock_EditMangaSlug_Call struct {
+	*mock.Call
+}
+
+// EditMangaSlug is a helper method to define mock.On call
+//   - input *dto.MangaSlugEditInput
+func (_e *MangaMock_Expecter) EditMangaSlug(input interface{}) *MangaMock_EditMangaSlug_Call {
+	return &MangaMock_EditMangaSlug_Call{Call: _e.mock.On("EditMangaSlug", input)}
+}
+
+func (_c *MangaMock_EditMangaSlug_Call) Run(run func(input *dto.MangaSlugEditInput)) *MangaMock_EditMangaSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaSlugEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaSlug_Call) Return(_a0 status.Object) *MangaMock_EditMangaSlug_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaSlug_Call) RunAndReturn(run func(*dto.MangaSlugEditInput) status.Object) *MangaMock_EditMangaSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMangaStaff provides a mock function with given fields: input
+func (_m *MangaMock) EditMangaStaff(input *dto.MangaStaffEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaStaff")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaStaffEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaStaff'
+type MangaMock_EditMangaStaff_Call struct {
+	*mock.Call
+}
+
+// EditMangaStaff is a helper method to define mock.On call
+//   - input *dto.MangaStaffEditInput
+func (_e *MangaMock_Expecter) EditMangaStaff(input interface{}) *MangaMock_EditMangaStaff_Call {
+	return &MangaMock_EditMangaStaff_Call{Call: _e.mock.On("EditMangaStaff", input)}
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) Run(run func(input *dto.MangaStaffEditInput)) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaStaffEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) Return(_a0 status.Object) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaStaff_Call) RunAndReturn(run func(*dto.MangaStaffEditInput) status.Object) *MangaMock_EditMangaStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMangaTags provides a mock function with given fields: input
+func (_m *MangaMock) EditMangaTags(input *dto.MangaTagEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMangaTags")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaTagEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditMangaTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMangaTags'
+type MangaMock_EditMangaTags_Call struct {
+	*mock.Call
+}
+
+// EditMangaTags is a helper method to define mock.On call
+//   - input *dto.MangaTagEditInput
+func (_e *MangaMock_Expecter) EditMangaTags(input interface{}) *MangaMock_EditMangaTags_Call {
+	return &MangaMock_EditMangaTags_Call{Call: _e.mock.On("EditMangaTags", input)}
+}
+
+func (_c *MangaMock_EditMangaTags_Call) Run(run func(input *dto.MangaTagEditInput)) *MangaMock_EditMangaTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaTagEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditMangaTags_Call) Return(_a0 status.Object) *MangaMock_EditMangaTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditMangaTags_Call) RunAndReturn(run func(*dto.MangaTagEditInput) status.Object) *MangaMock_EditMangaTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditVolume provides a mock function with given fields: input
+func (_m *MangaMock) EditVolume(input *dto.VolumeEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditVolume")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.VolumeEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_EditVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditVolume'
+type MangaMock_EditVolume_Call struct {
+	*mock.Call
+}
+
+// EditVolume is a helper method to define mock.On call
+//   - input *dto.VolumeEditInput
+func (_e *MangaMock_Expecter) EditVolume(input interface{}) *MangaMock_EditVolume_Call {
+	return &MangaMock_EditVolume_Call{Call: _e.mock.On("EditVolume", input)}
+}
+
+func (_c *MangaMock_EditVolume_Call) Run(run func(input *dto.VolumeEditInput)) *MangaMock_EditVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.VolumeEditInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_EditVolume_Call) Return(_a0 status.Object) *MangaMock_EditVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_EditVolume_Call) RunAndReturn(run func(*dto.VolumeEditInput) status.Object) *MangaMock_EditVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMangaAlternativeTitles provides a mock function with given fields: mangaId
+func (_m *MangaMock) FindMangaAlternativeTitles(mangaId string) ([]dto.AlternativeTitleResponse, status.Object) {
+	ret := _m.Called(mangaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaAlternativeTitles")
+	}
+
+	var r0 []dto.AlternativeTitleResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) ([]dto.AlternativeTitleResponse, status.Object)); ok {
+		return rf(mangaId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []dto.AlternativeTitleResponse); ok {
+		r0 = rf(mangaId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AlternativeTitleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(mangaId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindMangaAlternativeTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaAlternativeTitles'
+type MangaMock_FindMangaAlternativeTitles_Call struct {
+	*mock.Call
+}
+
+// FindMangaAlternativeTitles is a helper method to define mock.On call
+//   - mangaId string
+func (_e *MangaMock_Expecter) FindMangaAlternativeTitles(mangaId interface{}) *MangaMock_FindMangaAlternativeTitles_Call {
+	return &MangaMock_FindMangaAlternativeTitles_Call{Call: _e.mock.On("FindMangaAlternativeTitles", mangaId)}
+}
+
+func (_c *MangaMock_FindMangaAlternativeTitles_Call) Run(run func(mangaId string)) *MangaMock_FindMangaAlternativeTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindMangaAlternativeTitles_Call) Return(_a0 []dto.AlternativeTitleResponse, _a1 status.Object) *MangaMock_FindMangaAlternativeTitles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindMangaAlternativeTitles_Call) RunAndReturn(run func(string) ([]dto.AlternativeTitleResponse, status.Object)) *MangaMock_FindMangaAlternativeTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindMangaByIds provides a mock function with given fields: mangaId
 func (_m *MangaMock) FindMangaByIds(mangaId ...string) ([]dto.MangaResponse, status.Object) {
 	_va := make([]interface{}, len(mangaId))
@@ -511,6 +985,64 @@ func (_c *MangaMock_FindMangaByIds_Call) RunAndReturn(run func(...string) ([]dto
 	return _c
 }
 
+// FindMangaBySlug provides a mock function with given fields: slug
+func (_m *MangaMock) FindMangaBySlug(slug string) ([]dto.MangaResponse, status.Object) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaBySlug")
+	}
+
+	var r0 []dto.MangaResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) ([]dto.MangaResponse, status.Object)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) []dto.MangaResponse); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.MangaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindMangaBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaBySlug'
+type MangaMock_FindMangaBySlug_Call struct {
+	*mock.Call
+}
+
+// FindMangaBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MangaMock_Expecter) FindMangaBySlug(slug interface{}) *MangaMock_FindMangaBySlug_Call {
+	return &MangaMock_FindMangaBySlug_Call{Call: _e.mock.On("FindMangaBySlug", slug)}
+}
+
+func (_c *MangaMock_FindMangaBySlug_Call) Run(run func(slug string)) *MangaMock_FindMangaBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindMangaBySlug_Call) Return(_a0 []dto.MangaResponse, _a1 status.Object) *MangaMock_FindMangaBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindMangaBySlug_Call) RunAndReturn(run func(string) ([]dto.MangaResponse, status.Object)) *MangaMock_FindMangaBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindMangaComments provides a mock function with given fields: mangaId
 func (_m *MangaMock) FindMangaComments(mangaId string) ([]dto.CommentResponse, status.Object) {
 	ret := _m.Called(mangaId)
@@ -763,6 +1295,64 @@ func (_c *MangaMock_FindMangaRatings_Call) RunAndReturn(run func(string) ([]dto.
 	return _c
 }
 
+// FindMangaRelations provides a mock function with given fields: mangaId
+func (_m *MangaMock) FindMangaRelations(mangaId string) ([]dto.MangaRelationResponse, status.Object) {
+	ret := _m.Called(mangaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMangaRelations")
+	}
+
+	var r0 []dto.MangaRelationResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) ([]dto.MangaRelationResponse, status.Object)); ok {
+		return rf(mangaId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []dto.MangaRelationResponse); ok {
+		r0 = rf(mangaId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.MangaRelationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(mangaId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_FindMangaRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMangaRelations'
+type MangaMock_FindMangaRelations_Call struct {
+	*mock.Call
+}
+
+// FindMangaRelations is a helper method to define mock.On call
+//   - mangaId string
+func (_e *MangaMock_Expecter) FindMangaRelations(mangaId interface{}) *MangaMock_FindMangaRelations_Call {
+	return &MangaMock_FindMangaRelations_Call{Call: _e.mock.On("FindMangaRelations", mangaId)}
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) Run(run func(mangaId string)) *MangaMock_FindMangaRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) Return(_a0 []dto.MangaRelationResponse, _a1 status.Object) *MangaMock_FindMangaRelations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_FindMangaRelations_Call) RunAndReturn(run func(string) ([]dto.MangaRelationResponse, status.Object)) *MangaMock_FindMangaRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindMangaTranslations provides a mock function with given fields: mangaId
 func (_m *MangaMock) FindMangaTranslations(mangaId string) ([]dto.TranslationResponse, status.Object) {
 	ret := _m.Called(mangaId)
@@ -821,9 +1411,9 @@ func (_c *MangaMock_FindMangaTranslations_Call) RunAndReturn(run func(string) ([
 	return _c
 }
 
-// FindRandomMangas provides a mock function with given fields: limit
-func (_m *MangaMock) FindRandomMangas(limit uint64) ([]dto.MinimalMangaResponse, status.Object) {
-	ret := _m.Called(limit)
+// FindRandomMangas provides a mock function with given fields: limit, userId
+func (_m *MangaMock) FindRandomMangas(limit uint64, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, status.Object) {
+	ret := _m.Called(limit, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindRandomMangas")
@@ -831,19 +1421,19 @@ func (_m *MangaMock) FindRandomMangas(limit uint64) ([]dto.MinimalMangaResponse,
 
 	var r0 []dto.MinimalMangaResponse
 	var r1 status.Object
-	if rf, ok := ret.Get(0).(func(uint64) ([]dto.MinimalMangaResponse, status.Object)); ok {
-		return rf(limit)
+	if rf, ok := ret.Get(0).(func(uint64, opt.Optional[string]) ([]dto.MinimalMangaResponse, status.Object)); ok {
+		return rf(limit, userId)
 	}
-	if rf, ok := ret.Get(0).(func(uint64) []dto.MinimalMangaResponse); ok {
-		r0 = rf(limit)
+	if rf, ok := ret.Get(0).(func(uint64, opt.Optional[string]) []dto.MinimalMangaResponse); ok {
+		r0 = rf(limit, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.MinimalMangaResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint64) status.Object); ok {
-		r1 = rf(limit)
+	if rf, ok := ret.Get(1).(func(uint64, opt.Optional[string]) status.Object); ok {
+		r1 = rf(limit, userId)
 	} else {
 		r1 = ret.Get(1).(status.Object)
 	}
@@ -858,13 +1448,14 @@ type MangaMock_FindRandomMangas_Call struct {
 
 // FindRandomMangas is a helper method to define mock.On call
 //   - limit uint64
-func (_e *MangaMock_Expecter) FindRandomMangas(limit interface{}) *MangaMock_FindRandomMangas_Call {
-	return &MangaMock_FindRandomMangas_Call{Call: _e.mock.On("FindRandomMangas", limit)}
+//   - userId opt.Optional[string]
+func (_e *MangaMock_Expecter) FindRandomMangas(limit interface{}, userId interface{}) *MangaMock_FindRandomMangas_Call {
+	return &MangaMock_FindRandomMangas_Call{Call: _e.mock.On("FindRandomMangas", limit, userId)}
 }
 
-func (_c *MangaMock_FindRandomMangas_Call) Run(run func(limit uint64)) *MangaMock_FindRandomMangas_Call {
+func (_c *MangaMock_FindRandomMangas_Call) Run(run func(limit uint64, userId opt.Optional[string])) *MangaMock_FindRandomMangas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint64))
+		run(args[0].(uint64), args[1].(opt.Optional[string]))
 	})
 	return _c
 }
@@ -874,7 +1465,7 @@ func (_c *MangaMock_FindRandomMangas_Call) Return(_a0 []dto.MinimalMangaResponse
 	return _c
 }
 
-func (_c *MangaMock_FindRandomMangas_Call) RunAndReturn(run func(uint64) ([]dto.MinimalMangaResponse, status.Object)) *MangaMock_FindRandomMangas_Call {
+func (_c *MangaMock_FindRandomMangas_Call) RunAndReturn(run func(uint64, opt.Optional[string]) ([]dto.MinimalMangaResponse, status.Object)) *MangaMock_FindRandomMangas_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -936,6 +1527,52 @@ func (_c *MangaMock_FindSpecificMangaTranslation_Call) RunAndReturn(run func(str
 	return _c
 }
 
+// InsertMangaAlternativeTitles provides a mock function with given fields: input
+func (_m *MangaMock) InsertMangaAlternativeTitles(input *dto.MangaAlternativeTitleInsertInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMangaAlternativeTitles")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaAlternativeTitleInsertInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// MangaMock_InsertMangaAlternativeTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMangaAlternativeTitles'
+type MangaMock_InsertMangaAlternativeTitles_Call struct {
+	*mock.Call
+}
+
+// InsertMangaAlternativeTitles is a helper method to define mock.On call
+//   - input *dto.MangaAlternativeTitleInsertInput
+func (_e *MangaMock_Expecter) InsertMangaAlternativeTitles(input interface{}) *MangaMock_InsertMangaAlternativeTitles_Call {
+	return &MangaMock_InsertMangaAlternativeTitles_Call{Call: _e.mock.On("InsertMangaAlternativeTitles", input)}
+}
+
+func (_c *MangaMock_InsertMangaAlternativeTitles_Call) Run(run func(input *dto.MangaAlternativeTitleInsertInput)) *MangaMock_InsertMangaAlternativeTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.MangaAlternativeTitleInsertInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_InsertMangaAlternativeTitles_Call) Return(_a0 status.Object) *MangaMock_InsertMangaAlternativeTitles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MangaMock_InsertMangaAlternativeTitles_Call) RunAndReturn(run func(*dto.MangaAlternativeTitleInsertInput) status.Object) *MangaMock_InsertMangaAlternativeTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertMangaTranslations provides a mock function with given fields: input
 func (_m *MangaMock) InsertMangaTranslations(input *dto.MangaTranslationInsertInput) status.Object {
 	ret := _m.Called(input)
@@ -982,9 +1619,9 @@ func (_c *MangaMock_InsertMangaTranslations_Call) RunAndReturn(run func(*dto.Man
 	return _c
 }
 
-// ListMangas provides a mock function with given fields: query
-func (_m *MangaMock) ListMangas(query *commondto.PagedQueryInput) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object) {
-	ret := _m.Called(query)
+// ListMangas provides a mock function with given fields: query, userId
+func (_m *MangaMock) ListMangas(query *commondto.PagedQueryInput, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(query, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMangas")
@@ -993,27 +1630,27 @@ func (_m *MangaMock) ListMangas(query *commondto.PagedQueryInput) ([]dto.Minimal
 	var r0 []dto.MinimalMangaResponse
 	var r1 *commondto.ResponsePage
 	var r2 status.Object
-	if rf, ok := ret.Get(0).(func(*commondto.PagedQueryInput) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)); ok {
-		return rf(query)
+	if rf, ok := ret.Get(0).(func(*commondto.PagedQueryInput, opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(query, userId)
 	}
-	if rf, ok := ret.Get(0).(func(*commondto.PagedQueryInput) []dto.MinimalMangaResponse); ok {
-		r0 = rf(query)
+	if rf, ok := ret.Get(0).(func(*commondto.PagedQueryInput, opt.Optional[string]) []dto.MinimalMangaResponse); ok {
+		r0 = rf(query, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.MinimalMangaResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*commondto.PagedQueryInput) *commondto.ResponsePage); ok {
-		r1 = rf(query)
+	if rf, ok := ret.Get(1).(func(*commondto.PagedQueryInput, opt.Optional[string]) *commondto.ResponsePage); ok {
+		r1 = rf(query, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*commondto.ResponsePage)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(*commondto.PagedQueryInput) status.Object); ok {
-		r2 = rf(query)
+	if rf, ok := ret.Get(2).(func(*commondto.PagedQueryInput, opt.Optional[string]) status.Object); ok {
+		r2 = rf(query, userId)
 	} else {
 		r2 = ret.Get(2).(status.Object)
 	}
@@ -1028,13 +1665,14 @@ type MangaMock_ListMangas_Call struct {
 
 // ListMangas is a helper method to define mock.On call
 //   - query *commondto.PagedQueryInput
-func (_e *MangaMock_Expecter) ListMangas(query interface{}) *MangaMock_ListMangas_Call {
-	return &MangaMock_ListMangas_Call{Call: _e.mock.On("ListMangas", query)}
+//   - userId opt.Optional[string]
+func (_e *MangaMock_Expecter) ListMangas(query interface{}, userId interface{}) *MangaMock_ListMangas_Call {
+	return &MangaMock_ListMangas_Call{Call: _e.mock.On("ListMangas", query, userId)}
 }
 
-func (_c *MangaMock_ListMangas_Call) Run(run func(query *commondto.PagedQueryInput)) *MangaMock_ListMangas_Call {
+func (_c *MangaMock_ListMangas_Call) Run(run func(query *commondto.PagedQueryInput, userId opt.Optional[string])) *MangaMock_ListMangas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*commondto.PagedQueryInput))
+		run(args[0].(*commondto.PagedQueryInput), args[1].(opt.Optional[string]))
 	})
 	return _c
 }
@@ -1044,7 +1682,7 @@ func (_c *MangaMock_ListMangas_Call) Return(_a0 []dto.MinimalMangaResponse, _a1
 	return _c
 }
 
-func (_c *MangaMock_ListMangas_Call) RunAndReturn(run func(*commondto.PagedQueryInput) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)) *MangaMock_ListMangas_Call {
+func (_c *MangaMock_ListMangas_Call) RunAndReturn(run func(*commondto.PagedQueryInput, opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)) *MangaMock_ListMangas_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1095,9 +1733,9 @@ func (_c *MangaMock_RemoveFavoriteManga_Call) RunAndReturn(run func(*dto.Favorit
 	return _c
 }
 
-// SearchMangas provides a mock function with given fields: query
-func (_m *MangaMock) SearchMangas(query *dto.MangaSearchQuery) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object) {
-	ret := _m.Called(query)
+// SearchMangas provides a mock function with given fields: query, userId
+func (_m *MangaMock) SearchMangas(query *dto.MangaSearchQuery, userId opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(query, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SearchMangas")
@@ -1106,27 +1744,27 @@ func (_m *MangaMock) SearchMangas(query *dto.MangaSearchQuery) ([]dto.MinimalMan
 	var r0 []dto.MinimalMangaResponse
 	var r1 *commondto.ResponsePage
 	var r2 status.Object
-	if rf, ok := ret.Get(0).(func(*dto.MangaSearchQuery) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)); ok {
-		return rf(query)
+	if rf, ok := ret.Get(0).(func(*dto.MangaSearchQuery, opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(query, userId)
 	}
-	if rf, ok := ret.Get(0).(func(*dto.MangaSearchQuery) []dto.MinimalMangaResponse); ok {
-		r0 = rf(query)
+	if rf, ok := ret.Get(0).(func(*dto.MangaSearchQuery, opt.Optional[string]) []dto.MinimalMangaResponse); ok {
+		r0 = rf(query, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.MinimalMangaResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*dto.MangaSearchQuery) *commondto.ResponsePage); ok {
-		r1 = rf(query)
+	if rf, ok := ret.Get(1).(func(*dto.MangaSearchQuery, opt.Optional[string]) *commondto.ResponsePage); ok {
+		r1 = rf(query, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*commondto.ResponsePage)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(*dto.MangaSearchQuery) status.Object); ok {
-		r2 = rf(query)
+	if rf, ok := ret.Get(2).(func(*dto.MangaSearchQuery, opt.Optional[string]) status.Object); ok {
+		r2 = rf(query, userId)
 	} else {
 		r2 = ret.Get(2).(status.Object)
 	}
@@ -1141,13 +1779,14 @@ type MangaMock_SearchMangas_Call struct {
 
 // SearchMangas is a helper method to define mock.On call
 //   - query *dto.MangaSearchQuery
-func (_e *MangaMock_Expecter) SearchMangas(query interface{}) *MangaMock_SearchMangas_Call {
-	return &MangaMock_SearchMangas_Call{Call: _e.mock.On("SearchMangas", query)}
+//   - userId opt.Optional[string]
+func (_e *MangaMock_Expecter) SearchMangas(query interface{}, userId interface{}) *MangaMock_SearchMangas_Call {
+	return &MangaMock_SearchMangas_Call{Call: _e.mock.On("SearchMangas", query, userId)}
 }
 
-func (_c *MangaMock_SearchMangas_Call) Run(run func(query *dto.MangaSearchQuery)) *MangaMock_SearchMangas_Call {
+func (_c *MangaMock_SearchMangas_Call) Run(run func(query *dto.MangaSearchQuery, userId opt.Optional[string])) *MangaMock_SearchMangas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.MangaSearchQuery))
+		run(args[0].(*dto.MangaSearchQuery), args[1].(opt.Optional[string]))
 	})
 	return _c
 }
@@ -1157,27 +1796,37 @@ func (_c *MangaMock_SearchMangas_Call) Return(_a0 []dto.MinimalMangaResponse, _a
 	return _c
 }
 
-func (_c *MangaMock_SearchMangas_Call) RunAndReturn(run func(*dto.MangaSearchQuery) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)) *MangaMock_SearchMangas_Call {
+func (_c *MangaMock_SearchMangas_Call) RunAndReturn(run func(*dto.MangaSearchQuery, opt.Optional[string]) ([]dto.MinimalMangaResponse, *commondto.ResponsePage, status.Object)) *MangaMock_SearchMangas_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateMangaCover provides a mock function with given fields: input
-func (_m *MangaMock) UpdateMangaCover(input *dto.MangaCoverUpdateInput) status.Object {
+func (_m *MangaMock) UpdateMangaCover(input *dto.MangaCoverUpdateInput) (commondto.UploadResponse, status.Object) {
 	ret := _m.Called(input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateMangaCover")
 	}
 
-	var r0 status.Object
-	if rf, ok := ret.Get(0).(func(*dto.MangaCoverUpdateInput) status.Object); ok {
+	var r0 commondto.UploadResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.MangaCoverUpdateInput) (commondto.UploadResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.MangaCoverUpdateInput) commondto.UploadResponse); ok {
 		r0 = rf(input)
 	} else {
-		r0 = ret.Get(0).(status.Object)
+		r0 = ret.Get(0).(commondto.UploadResponse)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*dto.MangaCoverUpdateInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
 }
 
 // MangaMock_UpdateMangaCover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMangaCover'
@@ -1198,12 +1847,12 @@ func (_c *MangaMock_UpdateMangaCover_Call) Run(run func(input *dto.MangaCoverUpd
 	return _c
 }
 
-func (_c *MangaMock_UpdateMangaCover_Call) Return(_a0 status.Object) *MangaMock_UpdateMangaCover_Call {
-	_c.Call.Return(_a0)
+func (_c *MangaMock_UpdateMangaCover_Call) Return(_a0 commondto.UploadResponse, _a1 status.Object) *MangaMock_UpdateMangaCover_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MangaMock_UpdateMangaCover_Call) RunAndReturn(run func(*dto.MangaCoverUpdateInput) status.Object) *MangaMock_UpdateMangaCover_Call {
+func (_c *MangaMock_UpdateMangaCover_Call) RunAndReturn(run func(*dto.MangaCoverUpdateInput) (commondto.UploadResponse, status.Object)) *MangaMock_UpdateMangaCover_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1254,6 +1903,62 @@ func (_c *MangaMock_UpdateTranslation_Call) RunAndReturn(run func(*dto.Translati
 	return _c
 }
 
+// UpdateVolumeCover provides a mock function with given fields: input
+func (_m *MangaMock) UpdateVolumeCover(input *dto.VolumeCoverUpdateInput) (commondto.UploadResponse, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVolumeCover")
+	}
+
+	var r0 commondto.UploadResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.VolumeCoverUpdateInput) (commondto.UploadResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.VolumeCoverUpdateInput) commondto.UploadResponse); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(commondto.UploadResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.VolumeCoverUpdateInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// MangaMock_UpdateVolumeCover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVolumeCover'
+type MangaMock_UpdateVolumeCover_Call struct {
+	*mock.Call
+}
+
+// UpdateVolumeCover is a helper method to define mock.On call
+//   - input *dto.VolumeCoverUpdateInput
+func (_e *MangaMock_Expecter) UpdateVolumeCover(input interface{}) *MangaMock_UpdateVolumeCover_Call {
+	return &MangaMock_UpdateVolumeCover_Call{Call: _e.mock.On("UpdateVolumeCover", input)}
+}
+
+func (_c *MangaMock_UpdateVolumeCover_Call) Run(run func(input *dto.VolumeCoverUpdateInput)) *MangaMock_UpdateVolumeCover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.VolumeCoverUpdateInput))
+	})
+	return _c
+}
+
+func (_c *MangaMock_UpdateVolumeCover_Call) Return(_a0 commondto.UploadResponse, _a1 status.Object) *MangaMock_UpdateVolumeCover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MangaMock_UpdateVolumeCover_Call) RunAndReturn(run func(*dto.VolumeCoverUpdateInput) (commondto.UploadResponse, status.Object)) *MangaMock_UpdateVolumeCover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertMangaRating provides a mock function with given fields: input
 func (_m *MangaMock) UpsertMangaRating(input *dto.RateUpsertInput) status.Object {
 	ret := _m.Called(input)
@@ -0,0 +1,300 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package service
+
+import (
+	commondto "manga-explorer/internal/common/dto"
+	dto "manga-explorer/internal/domain/mangas/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "manga-explorer/internal/common/status"
+)
+
+// PersonMock is an autogenerated mock type for the IPerson type
+type PersonMock struct {
+	mock.Mock
+}
+
+type PersonMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PersonMock) EXPECT() *PersonMock_Expecter {
+	return &PersonMock_Expecter{mock: &_m.Mock}
+}
+
+// CreatePerson provides a mock function with given fields: input
+func (_m *PersonMock) CreatePerson(input *dto.PersonCreateInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePerson")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PersonCreateInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// PersonMock_CreatePerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePerson'
+type PersonMock_CreatePerson_Call struct {
+	*mock.Call
+}
+
+// CreatePerson is a helper method to define mock.On call
+//   - input *dto.PersonCreateInput
+func (_e *PersonMock_Expecter) CreatePerson(input interface{}) *PersonMock_CreatePerson_Call {
+	return &PersonMock_CreatePerson_Call{Call: _e.mock.On("CreatePerson", input)}
+}
+
+func (_c *PersonMock_CreatePerson_Call) Run(run func(input *dto.PersonCreateInput)) *PersonMock_CreatePerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PersonCreateInput))
+	})
+	return _c
+}
+
+func (_c *PersonMock_CreatePerson_Call) Return(_a0 status.Object) *PersonMock_CreatePerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_CreatePerson_Call) RunAndReturn(run func(*dto.PersonCreateInput) status.Object) *PersonMock_CreatePerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePerson provides a mock function with given fields: personId
+func (_m *PersonMock) DeletePerson(personId string) status.Object {
+	ret := _m.Called(personId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePerson")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(string) status.Object); ok {
+		r0 = rf(personId)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// PersonMock_DeletePerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePerson'
+type PersonMock_DeletePerson_Call struct {
+	*mock.Call
+}
+
+// DeletePerson is a helper method to define mock.On call
+//   - personId string
+func (_e *PersonMock_Expecter) DeletePerson(personId interface{}) *PersonMock_DeletePerson_Call {
+	return &PersonMock_DeletePerson_Call{Call: _e.mock.On("DeletePerson", personId)}
+}
+
+func (_c *PersonMock_DeletePerson_Call) Run(run func(personId string)) *PersonMock_DeletePerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonMock_DeletePerson_Call) Return(_a0 status.Object) *PersonMock_DeletePerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_DeletePerson_Call) RunAndReturn(run func(string) status.Object) *PersonMock_DeletePerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPersonById provides a mock function with given fields: personId
+func (_m *PersonMock) FindPersonById(personId string) (dto.PersonResponse, status.Object) {
+	ret := _m.Called(personId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPersonById")
+	}
+
+	var r0 dto.PersonResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(string) (dto.PersonResponse, status.Object)); ok {
+		return rf(personId)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.PersonResponse); ok {
+		r0 = rf(personId)
+	} else {
+		r0 = ret.Get(0).(dto.PersonResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) status.Object); ok {
+		r1 = rf(personId)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// PersonMock_FindPersonById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPersonById'
+type PersonMock_FindPersonById_Call struct {
+	*mock.Call
+}
+
+// FindPersonById is a helper method to define mock.On call
+//   - personId string
+func (_e *PersonMock_Expecter) FindPersonById(personId interface{}) *PersonMock_FindPersonById_Call {
+	return &PersonMock_FindPersonById_Call{Call: _e.mock.On("FindPersonById", personId)}
+}
+
+func (_c *PersonMock_FindPersonById_Call) Run(run func(personId string)) *PersonMock_FindPersonById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonMock_FindPersonById_Call) Return(_a0 dto.PersonResponse, _a1 status.Object) *PersonMock_FindPersonById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonMock_FindPersonById_Call) RunAndReturn(run func(string) (dto.PersonResponse, status.Object)) *PersonMock_FindPersonById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPeople provides a mock function with given fields: input
+func (_m *PersonMock) ListPeople(input *dto.PersonListInput) ([]dto.PersonResponse, *commondto.ResponsePage, status.Object) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPeople")
+	}
+
+	var r0 []dto.PersonResponse
+	var r1 *commondto.ResponsePage
+	var r2 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PersonListInput) ([]dto.PersonResponse, *commondto.ResponsePage, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.PersonListInput) []dto.PersonResponse); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PersonResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.PersonListInput) *commondto.ResponsePage); ok {
+		r1 = rf(input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*commondto.ResponsePage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*dto.PersonListInput) status.Object); ok {
+		r2 = rf(input)
+	} else {
+		r2 = ret.Get(2).(status.Object)
+	}
+
+	return r0, r1, r2
+}
+
+// PersonMock_ListPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPeople'
+type PersonMock_ListPeople_Call struct {
+	*mock.Call
+}
+
+// ListPeople is a helper method to define mock.On call
+//   - input *dto.PersonListInput
+func (_e *PersonMock_Expecter) ListPeople(input interface{}) *PersonMock_ListPeople_Call {
+	return &PersonMock_ListPeople_Call{Call: _e.mock.On("ListPeople", input)}
+}
+
+func (_c *PersonMock_ListPeople_Call) Run(run func(input *dto.PersonListInput)) *PersonMock_ListPeople_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PersonListInput))
+	})
+	return _c
+}
+
+func (_c *PersonMock_ListPeople_Call) Return(_a0 []dto.PersonResponse, _a1 *commondto.ResponsePage, _a2 status.Object) *PersonMock_ListPeople_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *PersonMock_ListPeople_Call) RunAndReturn(run func(*dto.PersonListInput) ([]dto.PersonResponse, *commondto.ResponsePage, status.Object)) *PersonMock_ListPeople_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePerson provides a mock function with given fields: input
+func (_m *PersonMock) UpdatePerson(input *dto.PersonEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePerson")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.PersonEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// PersonMock_UpdatePerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePerson'
+type PersonMock_UpdatePerson_Call struct {
+	*mock.Call
+}
+
+// UpdatePerson is a helper method to define mock.On call
+//   - input *dto.PersonEditInput
+func (_e *PersonMock_Expecter) UpdatePerson(input interface{}) *PersonMock_UpdatePerson_Call {
+	return &PersonMock_UpdatePerson_Call{Call: _e.mock.On("UpdatePerson", input)}
+}
+
+func (_c *PersonMock_UpdatePerson_Call) Run(run func(input *dto.PersonEditInput)) *PersonMock_UpdatePerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.PersonEditInput))
+	})
+	return _c
+}
+
+func (_c *PersonMock_UpdatePerson_Call) Return(_a0 status.Object) *PersonMock_UpdatePerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonMock_UpdatePerson_Call) RunAndReturn(run func(*dto.PersonEditInput) status.Object) *PersonMock_UpdatePerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPersonMock creates a new instance of PersonMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPersonMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PersonMock {
+	mock := &PersonMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,233 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package service
+
+import (
+	dto "manga-explorer/internal/domain/mangas/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "manga-explorer/internal/common/status"
+)
+
+// TagMock is an autogenerated mock type for the ITag type
+type TagMock struct {
+	mock.Mock
+}
+
+type TagMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagMock) EXPECT() *TagMock_Expecter {
+	return &TagMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: input
+func (_m *TagMock) CreateTag(input *dto.TagCreateInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.TagCreateInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// TagMock_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagMock_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - input *dto.TagCreateInput
+func (_e *TagMock_Expecter) CreateTag(input interface{}) *TagMock_CreateTag_Call {
+	return &TagMock_CreateTag_Call{Call: _e.mock.On("CreateTag", input)}
+}
+
+func (_c *TagMock_CreateTag_Call) Run(run func(input *dto.TagCreateInput)) *TagMock_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.TagCreateInput))
+	})
+	return _c
+}
+
+func (_c *TagMock_CreateTag_Call) Return(_a0 status.Object) *TagMock_CreateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_CreateTag_Call) RunAndReturn(run func(*dto.TagCreateInput) status.Object) *TagMock_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: tagId
+func (_m *TagMock) DeleteTag(tagId string) status.Object {
+	ret := _m.Called(tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(string) status.Object); ok {
+		r0 = rf(tagId)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// TagMock_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagMock_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - tagId string
+func (_e *TagMock_Expecter) DeleteTag(tagId interface{}) *TagMock_DeleteTag_Call {
+	return &TagMock_DeleteTag_Call{Call: _e.mock.On("DeleteTag", tagId)}
+}
+
+func (_c *TagMock_DeleteTag_Call) Run(run func(tagId string)) *TagMock_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TagMock_DeleteTag_Call) Return(_a0 status.Object) *TagMock_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_DeleteTag_Call) RunAndReturn(run func(string) status.Object) *TagMock_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTag provides a mock function with given fields:
+func (_m *TagMock) ListTag() ([]dto.TagResponse, status.Object) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTag")
+	}
+
+	var r0 []dto.TagResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func() ([]dto.TagResponse, status.Object)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []dto.TagResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() status.Object); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// TagMock_ListTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTag'
+type TagMock_ListTag_Call struct {
+	*mock.Call
+}
+
+// ListTag is a helper method to define mock.On call
+func (_e *TagMock_Expecter) ListTag() *TagMock_ListTag_Call {
+	return &TagMock_ListTag_Call{Call: _e.mock.On("ListTag")}
+}
+
+func (_c *TagMock_ListTag_Call) Run(run func()) *TagMock_ListTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TagMock_ListTag_Call) Return(_a0 []dto.TagResponse, _a1 status.Object) *TagMock_ListTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagMock_ListTag_Call) RunAndReturn(run func() ([]dto.TagResponse, status.Object)) *TagMock_ListTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: input
+func (_m *TagMock) UpdateTag(input *dto.TagEditInput) status.Object {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.TagEditInput) status.Object); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// TagMock_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type TagMock_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - input *dto.TagEditInput
+func (_e *TagMock_Expecter) UpdateTag(input interface{}) *TagMock_UpdateTag_Call {
+	return &TagMock_UpdateTag_Call{Call: _e.mock.On("UpdateTag", input)}
+}
+
+func (_c *TagMock_UpdateTag_Call) Run(run func(input *dto.TagEditInput)) *TagMock_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.TagEditInput))
+	})
+	return _c
+}
+
+func (_c *TagMock_UpdateTag_Call) Return(_a0 status.Object) *TagMock_UpdateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagMock_UpdateTag_Call) RunAndReturn(run func(*dto.TagEditInput) status.Object) *TagMock_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagMock creates a new instance of TagMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagMock {
+	mock := &TagMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
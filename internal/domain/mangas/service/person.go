@@ -0,0 +1,20 @@
+package service
+
+import (
+  dto2 "manga-explorer/internal/common/dto"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+)
+
+type IPerson interface {
+  // CreatePerson create new person which could be credited on mangas
+  CreatePerson(input *dto.PersonCreateInput) status.Object
+  // UpdatePerson update person details
+  UpdatePerson(input *dto.PersonEditInput) status.Object
+  // DeletePerson delete person by the id, it will also remove the person from all credited mangas
+  DeletePerson(personId string) status.Object
+  // FindPersonById get person details with the mangas credited to
+  FindPersonById(personId string) (dto.PersonResponse, status.Object)
+  // ListPeople get all people, filtered by the name when it is provided
+  ListPeople(input *dto.PersonListInput) ([]dto.PersonResponse, *dto2.ResponsePage, status.Object)
+}
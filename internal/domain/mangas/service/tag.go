@@ -0,0 +1,17 @@
+package service
+
+import (
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/domain/mangas/dto"
+)
+
+type ITag interface {
+  // CreateTag create new tag
+  CreateTag(input *dto.TagCreateInput) status.Object
+  // DeleteTag delete tag by the id
+  DeleteTag(tagId string) status.Object
+  // UpdateTag update tag name and group
+  UpdateTag(input *dto.TagEditInput) status.Object
+  // ListTag get all available tags ordered by the group
+  ListTag() ([]dto.TagResponse, status.Object)
+}
@@ -0,0 +1,46 @@
+package mangas
+
+import (
+  "github.com/google/uuid"
+  "github.com/uptrace/bun"
+  "time"
+)
+
+type Tag struct {
+  bun.BaseModel `bun:"table:tags"`
+  Id            string   `bun:",pk,type:uuid"`
+  Name          string   `bun:",nullzero,notnull,unique"`
+  Group         TagGroup `bun:",notnull"`
+
+  UpdatedAt time.Time `bun:",nullzero,default:current_timestamp"`
+  CreatedAt time.Time `bun:",nullzero,notnull"`
+  Mangas    []Manga   `bun:"m2m:manga_tags,join:Tag=Manga"`
+}
+
+func NewTag(name string, group TagGroup) Tag {
+  ct := time.Now()
+  return Tag{
+    Id:        uuid.NewString(),
+    Name:      name,
+    Group:     group,
+    UpdatedAt: ct,
+    CreatedAt: ct,
+  }
+}
+
+// MangaTag used for tags on each manga
+type MangaTag struct {
+  bun.BaseModel `bun:"table:manga_tags"`
+  MangaId       string `bun:",pk,type:uuid"`
+  TagId         string `bun:",pk,type:uuid"`
+
+  Manga *Manga `bun:"rel:belongs-to,join:manga_id=id,on_delete:CASCADE"`
+  Tag   *Tag   `bun:"rel:belongs-to,join:tag_id=id,on_delete:CASCADE"`
+}
+
+func NewMangaTag(mangaId, tagId string) MangaTag {
+  return MangaTag{
+    MangaId: mangaId,
+    TagId:   tagId,
+  }
+}
@@ -7,6 +7,16 @@ import (
 )
 
 var ErrUnknownStatus = errors.New("status unknown")
+var ErrUnknownContentRating = errors.New("content rating unknown")
+var ErrUnknownDemographic = errors.New("demographic unknown")
+var ErrUnknownTagGroup = errors.New("tag group unknown")
+var ErrUnknownStaffRole = errors.New("staff role unknown")
+var ErrUnknownTitleKind = errors.New("title kind unknown")
+var ErrUnknownRelationKind = errors.New("relation kind unknown")
+var ErrUnknownChapterKind = errors.New("chapter kind unknown")
+var ErrUnknownGroupRole = errors.New("group role unknown")
+var ErrUnknownChapterOrder = errors.New("chapter order unknown")
+var ErrUnknownOrderDirection = errors.New("order direction unknown")
 
 func NewStatus(val string) (Status, error) {
   switch val {
@@ -64,10 +74,462 @@ func (s Status) Validate() error {
   return nil
 }
 
+func NewContentRating(val string) (ContentRating, error) {
+  switch val {
+  case "safe":
+    return ContentRatingSafe, nil
+  case "suggestive":
+    return ContentRatingSuggestive, nil
+  case "erotica":
+    return ContentRatingErotica, nil
+  case "pornographic":
+    return ContentRatingPornographic, nil
+  default:
+    return ContentRating(math.MaxUint8), ErrUnknownContentRating
+  }
+}
+
+// NewContentRatings parse the content ratings, unknown values will be ignored
+func NewContentRatings(vals []string) []ContentRating {
+  var result []ContentRating
+  for _, val := range vals {
+    rating, err := NewContentRating(val)
+    if err != nil {
+      continue
+    }
+    result = append(result, rating)
+  }
+  return result
+}
+
+const (
+  ContentRatingSafe ContentRating = iota
+  ContentRatingSuggestive
+  ContentRatingErotica
+  ContentRatingPornographic
+)
+
+// DefaultContentRatings used for anonymous users and users which has no content rating preference
+var DefaultContentRatings = []ContentRating{ContentRatingSafe}
+
+type ContentRating uint8
+
+func (c ContentRating) String() string {
+  switch c {
+  case ContentRatingSafe:
+    return "safe"
+  case ContentRatingSuggestive:
+    return "suggestive"
+  case ContentRatingErotica:
+    return "erotica"
+  case ContentRatingPornographic:
+    return "pornographic"
+  default:
+    return "unknown"
+  }
+}
+
+func (c ContentRating) Underlying() uint8 {
+  return (uint8)(c)
+}
+
+func (c ContentRating) Validate() error {
+  val := c.Underlying()
+  if val > 3 {
+    return ErrUnknownContentRating
+  }
+  return nil
+}
+
+// AgeRating convert the content rating into ComicInfo age rating
+func (c ContentRating) AgeRating() string {
+  switch c {
+  case ContentRatingSafe:
+    return "Everyone"
+  case ContentRatingSuggestive:
+    return "Teen"
+  case ContentRatingErotica:
+    return "Mature 17+"
+  case ContentRatingPornographic:
+    return "Adults Only 18+"
+  default:
+    return "Unknown"
+  }
+}
+
+func NewDemographic(val string) (Demographic, error) {
+  switch val {
+  case "none":
+    return DemographicNone, nil
+  case "shounen":
+    return DemographicShounen, nil
+  case "shoujo":
+    return DemographicShoujo, nil
+  case "seinen":
+    return DemographicSeinen, nil
+  case "josei":
+    return DemographicJosei, nil
+  default:
+    return Demographic(math.MaxUint8), ErrUnknownDemographic
+  }
+}
+
+const (
+  DemographicNone Demographic = iota
+  DemographicShounen
+  DemographicShoujo
+  DemographicSeinen
+  DemographicJosei
+)
+
+type Demographic uint8
+
+func (d Demographic) String() string {
+  switch d {
+  case DemographicNone:
+    return "none"
+  case DemographicShounen:
+    return "shounen"
+  case DemographicShoujo:
+    return "shoujo"
+  case DemographicSeinen:
+    return "seinen"
+  case DemographicJosei:
+    return "josei"
+  default:
+    return "unknown"
+  }
+}
+
+func (d Demographic) Underlying() uint8 {
+  return (uint8)(d)
+}
+
+func (d Demographic) Validate() error {
+  val := d.Underlying()
+  if val > 4 {
+    return ErrUnknownDemographic
+  }
+  return nil
+}
+
+func NewTagGroup(val string) (TagGroup, error) {
+  switch val {
+  case "theme":
+    return TagGroupTheme, nil
+  case "format":
+    return TagGroupFormat, nil
+  case "content_warning":
+    return TagGroupContentWarning, nil
+  default:
+    return TagGroup(math.MaxUint8), ErrUnknownTagGroup
+  }
+}
+
+const (
+  TagGroupTheme TagGroup = iota
+  TagGroupFormat
+  TagGroupContentWarning
+)
+
+type TagGroup uint8
+
+func (t TagGroup) String() string {
+  switch t {
+  case TagGroupTheme:
+    return "theme"
+  case TagGroupFormat:
+    return "format"
+  case TagGroupContentWarning:
+    return "content_warning"
+  default:
+    return "unknown"
+  }
+}
+
+func (t TagGroup) Underlying() uint8 {
+  return (uint8)(t)
+}
+
+func (t TagGroup) Validate() error {
+  val := t.Underlying()
+  if val > 2 {
+    return ErrUnknownTagGroup
+  }
+  return nil
+}
+
+func NewStaffRole(val string) (StaffRole, error) {
+  switch val {
+  case "story":
+    return StaffRoleStory, nil
+  case "art":
+    return StaffRoleArt, nil
+  case "original_creator":
+    return StaffRoleOriginalCreator, nil
+  case "editor":
+    return StaffRoleEditor, nil
+  default:
+    return StaffRole(math.MaxUint8), ErrUnknownStaffRole
+  }
+}
+
+const (
+  StaffRoleStory StaffRole = iota
+  StaffRoleArt
+  StaffRoleOriginalCreator
+  StaffRoleEditor
+)
+
+type StaffRole uint8
+
+func (s StaffRole) String() string {
+  switch s {
+  case StaffRoleStory:
+    return "story"
+  case StaffRoleArt:
+    return "art"
+  case StaffRoleOriginalCreator:
+    return "original_creator"
+  case StaffRoleEditor:
+    return "editor"
+  default:
+    return "unknown"
+  }
+}
+
+func (s StaffRole) Underlying() uint8 {
+  return (uint8)(s)
+}
+
+func (s StaffRole) Validate() error {
+  val := s.Underlying()
+  if val > 3 {
+    return ErrUnknownStaffRole
+  }
+  return nil
+}
+
+func NewTitleKind(val string) (TitleKind, error) {
+  switch val {
+  case "romaji":
+    return TitleKindRomaji, nil
+  case "abbreviation":
+    return TitleKindAbbreviation, nil
+  case "synonym":
+    return TitleKindSynonym, nil
+  default:
+    return TitleKind(math.MaxUint8), ErrUnknownTitleKind
+  }
+}
+
+const (
+  TitleKindRomaji TitleKind = iota
+  TitleKindAbbreviation
+  TitleKindSynonym
+)
+
+type TitleKind uint8
+
+func (t TitleKind) String() string {
+  switch t {
+  case TitleKindRomaji:
+    return "romaji"
+  case TitleKindAbbreviation:
+    return "abbreviation"
+  case TitleKindSynonym:
+    return "synonym"
+  default:
+    return "unknown"
+  }
+}
+
+func (t TitleKind) Underlying() uint8 {
+  return (uint8)(t)
+}
+
+func (t TitleKind) Validate() error {
+  val := t.Underlying()
+  if val > 2 {
+    return ErrUnknownTitleKind
+  }
+  return nil
+}
+
+func NewRelationKind(val string) (RelationKind, error) {
+  switch val {
+  case "sequel":
+    return RelationSequel, nil
+  case "prequel":
+    return RelationPrequel, nil
+  case "spin_off":
+    return RelationSpinOff, nil
+  case "side_story":
+    return RelationSideStory, nil
+  case "alternate_version":
+    return RelationAlternateVersion, nil
+  case "shares_universe":
+    return RelationSharesUniverse, nil
+  default:
+    return RelationKind(math.MaxUint8), ErrUnknownRelationKind
+  }
+}
+
+const (
+  RelationSequel RelationKind = iota
+  RelationPrequel
+  RelationSpinOff
+  RelationSideStory
+  RelationAlternateVersion
+  RelationSharesUniverse
+)
+
+type RelationKind uint8
+
+func (r RelationKind) String() string {
+  switch r {
+  case RelationSequel:
+    return "sequel"
+  case RelationPrequel:
+    return "prequel"
+  case RelationSpinOff:
+    return "spin_off"
+  case RelationSideStory:
+    return "side_story"
+  case RelationAlternateVersion:
+    return "alternate_version"
+  case RelationSharesUniverse:
+    return "shares_universe"
+  default:
+    return "unknown"
+  }
+}
+
+// Inverse get the opposite kind of relation, spin off and side story doesn't have inverse kind
+func (r RelationKind) Inverse() (RelationKind, bool) {
+  switch r {
+  case RelationSequel:
+    return RelationPrequel, true
+  case RelationPrequel:
+    return RelationSequel, true
+  case RelationAlternateVersion, RelationSharesUniverse:
+    return r, true
+  default:
+    return r, false
+  }
+}
+
+func (r RelationKind) Underlying() uint8 {
+  return (uint8)(r)
+}
+
+func (r RelationKind) Validate() error {
+  val := r.Underlying()
+  if val > 5 {
+    return ErrUnknownRelationKind
+  }
+  return nil
+}
+
+func NewChapterKind(val string) (ChapterKind, error) {
+  switch val {
+  case "regular":
+    return ChapterKindRegular, nil
+  case "extra":
+    return ChapterKindExtra, nil
+  case "prologue":
+    return ChapterKindPrologue, nil
+  case "oneshot":
+    return ChapterKindOneshot, nil
+  default:
+    return ChapterKind(math.MaxUint8), ErrUnknownChapterKind
+  }
+}
+
+const (
+  ChapterKindRegular ChapterKind = iota
+  ChapterKindExtra
+  ChapterKindPrologue
+  ChapterKindOneshot
+)
+
+type ChapterKind uint8
+
+func (c ChapterKind) String() string {
+  switch c {
+  case ChapterKindRegular:
+    return "regular"
+  case ChapterKindExtra:
+    return "extra"
+  case ChapterKindPrologue:
+    return "prologue"
+  case ChapterKindOneshot:
+    return "oneshot"
+  default:
+    return "unknown"
+  }
+}
+
+func (c ChapterKind) Underlying() uint8 {
+  return (uint8)(c)
+}
+
+func (c ChapterKind) Validate() error {
+  val := c.Underlying()
+  if val > 3 {
+    return ErrUnknownChapterKind
+  }
+  return nil
+}
+
+func NewGroupRole(val string) (GroupRole, error) {
+  switch val {
+  case "leader":
+    return GroupRoleLeader, nil
+  case "member":
+    return GroupRoleMember, nil
+  default:
+    return GroupRole(math.MaxUint8), ErrUnknownGroupRole
+  }
+}
+
+const (
+  GroupRoleLeader GroupRole = iota
+  GroupRoleMember
+)
+
+type GroupRole uint8
+
+func (g GroupRole) String() string {
+  switch g {
+  case GroupRoleLeader:
+    return "leader"
+  case GroupRoleMember:
+    return "member"
+  default:
+    return "unknown"
+  }
+}
+
+func (g GroupRole) Underlying() uint8 {
+  return (uint8)(g)
+}
+
+func (g GroupRole) Validate() error {
+  val := g.Underlying()
+  if val > 1 {
+    return ErrUnknownGroupRole
+  }
+  return nil
+}
+
 // TODO: Move it, it should not be belongs here
 type SearchFilter struct {
   Title           string
+  Staff           string // Name of the person credited on the manga
+  ContentRatings  []ContentRating
   Genres          common.CriterionOption[string]
+  Tags            common.CriterionOption[string]
   Origins         []common.Country
   IsOriginInclude bool
 }
@@ -90,6 +552,69 @@ func (f *SearchFilter) HasTitle() bool {
   return len(f.Title) != 0
 }
 
+func (f *SearchFilter) HasStaff() bool {
+  return len(f.Staff) != 0
+}
+
+// ChapterFilter used to filter chapters of single manga, empty field means the filter is not used
+type ChapterFilter struct {
+  MangaId      string
+  UserId       string // Used to flag chapters which are already read by the user
+  Language     common.Language
+  VolumeId     string
+  TranslatorId string
+  Order        ChapterOrder
+  IsDescending bool
+}
+
+func (f *ChapterFilter) HasUser() bool {
+  return len(f.UserId) != 0
+}
+
+func (f *ChapterFilter) HasLanguage() bool {
+  return len(f.Language) != 0
+}
+
+func (f *ChapterFilter) HasVolume() bool {
+  return len(f.VolumeId) != 0
+}
+
+func (f *ChapterFilter) HasTranslator() bool {
+  return len(f.TranslatorId) != 0
+}
+
+func NewChapterOrder(val string) (ChapterOrder, error) {
+  order := ChapterOrder(val)
+  if err := order.Validate(); err != nil {
+    return "", err
+  }
+  return order, nil
+}
+
+type ChapterOrder string
+
+const (
+  ChapterOrderNumber      = ChapterOrder("number")
+  ChapterOrderPublishDate = ChapterOrder("publish_date")
+)
+
+func (c ChapterOrder) String() string {
+  return c.Underlying()
+}
+
+func (c ChapterOrder) Underlying() string {
+  return string(c)
+}
+
+func (c ChapterOrder) Validate() error {
+  switch c {
+  case ChapterOrderNumber, ChapterOrderPublishDate:
+    return nil
+  default:
+    return ErrUnknownChapterOrder
+  }
+}
+
 type CommentObject string
 
 const (
@@ -9,6 +9,8 @@ type InternalProfileResponse struct {
   LastName  string `json:"last_name"`
   PhotoURL  string `json:"photo_url"`
   Bio       string `json:"bio"`
+
+  AllowedRatings []string `json:"allowed_ratings"`
 }
 
 type ProfileResponse struct {
@@ -21,6 +23,8 @@ type ProfileEditInput struct {
   FirstName string `json:"first_name" binding:"required,gte=5"`
   LastName  string `json:"last_name" binding:"required"`
   Bio       string `json:"bio" binding:"required"`
+
+  AllowedRatings []string `json:"allowed_ratings" binding:"omitempty,dive,content_rating"`
 }
 
 type ProfileImageUpdateInput struct {
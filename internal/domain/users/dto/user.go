@@ -82,6 +82,8 @@ type ProfileEditExtendedInput struct {
   LastName  string `json:"last_name"`
   Bio       string `json:"bio"`
   PhotoURL  string `json:"photo_url"`
+
+  AllowedRatings []string `json:"allowed_ratings" binding:"omitempty,dive,content_rating"`
 }
 
 func (p *ProfileEditExtendedInput) ConstructURI(ctx *gin.Context) {
@@ -15,6 +15,8 @@ func toProfileResponse(profile *users.Profile, fl fileService.IFile) dto.Interna
     LastName:  profile.LastName,
     PhotoURL:  fl.GetFullpath(file.ProfileAsset, profile.PhotoURL),
     Bio:       profile.Bio,
+
+    AllowedRatings: profile.AllowedRatings,
   }
 }
 
@@ -55,6 +57,8 @@ func MapProfileUpdateExtendedInput(input *dto.ProfileEditExtendedInput) users.Pr
     PhotoURL:  file.Name(input.PhotoURL),
     Bio:       input.Bio,
     UpdatedAt: time.Now(),
+
+    AllowedRatings: mapAllowedRatingsUpdate(input.AllowedRatings),
   }
 }
 
@@ -65,5 +69,16 @@ func MapProfileUpdateInput(input *dto.ProfileEditInput) users.Profile {
     LastName:  input.LastName,
     Bio:       input.Bio,
     UpdatedAt: time.Now(),
+
+    AllowedRatings: mapAllowedRatingsUpdate(input.AllowedRatings),
+  }
+}
+
+// mapAllowedRatingsUpdate keep the ratings nil when it is not sent, so it is not updated. Explicitly empty ratings is
+// kept non-nil, so it is reset and the default ratings are used
+func mapAllowedRatingsUpdate(ratings []string) []string {
+  if ratings == nil {
+    return nil
   }
+  return append([]string{}, ratings...)
 }
@@ -28,6 +28,10 @@ type Profile struct {
   PhotoURL  file.Name `bun:",type:text"`
   Bio       string    `bun:",type:text"`
 
+  // AllowedRatings content ratings of mangas which could be shown for the user, the default ratings are used when it
+  // is empty
+  AllowedRatings []string `bun:",array"`
+
   UpdatedAt time.Time `bun:",nullzero,notnull"`
 
   User *User `bun:"rel:belongs-to,join:user_id=id,on_delete:CASCADE"`
@@ -3,6 +3,7 @@
 package service
 
 import (
+	commondto "manga-explorer/internal/common/dto"
 	dto "manga-explorer/internal/domain/users/dto"
 
 	mock "github.com/stretchr/testify/mock"
@@ -719,21 +720,31 @@ func (_c *UserMock_UpdateProfileExtended_Call) RunAndReturn(run func(*dto.Profil
 }
 
 // UpdateProfileImage provides a mock function with given fields: input
-func (_m *UserMock) UpdateProfileImage(input *dto.ProfileImageUpdateInput) status.Object {
+func (_m *UserMock) UpdateProfileImage(input *dto.ProfileImageUpdateInput) (commondto.UploadResponse, status.Object) {
 	ret := _m.Called(input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateProfileImage")
 	}
 
-	var r0 status.Object
-	if rf, ok := ret.Get(0).(func(*dto.ProfileImageUpdateInput) status.Object); ok {
+	var r0 commondto.UploadResponse
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(*dto.ProfileImageUpdateInput) (commondto.UploadResponse, status.Object)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.ProfileImageUpdateInput) commondto.UploadResponse); ok {
 		r0 = rf(input)
 	} else {
-		r0 = ret.Get(0).(status.Object)
+		r0 = ret.Get(0).(commondto.UploadResponse)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*dto.ProfileImageUpdateInput) status.Object); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
 }
 
 // UserMock_UpdateProfileImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfileImage'
@@ -754,12 +765,12 @@ func (_c *UserMock_UpdateProfileImage_Call) Run(run func(input *dto.ProfileImage
 	return _c
 }
 
-func (_c *UserMock_UpdateProfileImage_Call) Return(_a0 status.Object) *UserMock_UpdateProfileImage_Call {
-	_c.Call.Return(_a0)
+func (_c *UserMock_UpdateProfileImage_Call) Return(_a0 commondto.UploadResponse, _a1 status.Object) *UserMock_UpdateProfileImage_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UserMock_UpdateProfileImage_Call) RunAndReturn(run func(*dto.ProfileImageUpdateInput) status.Object) *UserMock_UpdateProfileImage_Call {
+func (_c *UserMock_UpdateProfileImage_Call) RunAndReturn(run func(*dto.ProfileImageUpdateInput) (commondto.UploadResponse, status.Object)) *UserMock_UpdateProfileImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
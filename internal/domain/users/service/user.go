@@ -1,6 +1,7 @@
 package service
 
 import (
+  commonDto "manga-explorer/internal/common/dto"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/domain/users/dto"
 )
@@ -26,7 +27,8 @@ type IUser interface {
   UpdateUserExtended(input *dto.UserEditExtendedInput) status.Object
   // UpdateProfile update user profile based on user id (not profile id) and the input
   UpdateProfile(input *dto.ProfileEditInput) status.Object
-  UpdateProfileImage(input *dto.ProfileImageUpdateInput) status.Object
+  // UpdateProfileImage upload new profile image and replace the previous one
+  UpdateProfileImage(input *dto.ProfileImageUpdateInput) (commonDto.UploadResponse, status.Object)
   DeleteProfileImage(userId string) status.Object
   // UpdateProfileExtended update all possible field on user profile
   UpdateProfileExtended(input *dto.ProfileEditExtendedInput) status.Object
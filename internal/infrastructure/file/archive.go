@@ -0,0 +1,162 @@
+package file
+
+import (
+  "archive/zip"
+  "errors"
+  "io"
+  "manga-explorer/internal/util"
+  "mime/multipart"
+  "path"
+  "slices"
+  "strings"
+  "time"
+)
+
+// MaxArchiveImageSize maximum uncompressed size of each image inside the archive
+const MaxArchiveImageSize = 50 << 20
+
+var (
+  ErrArchiveInvalid = errors.New("file is not a valid zip archive")
+  ErrArchiveEmpty   = errors.New("archive doesn't contain any image")
+  ErrImageInvalid   = errors.New("file is not a valid image")
+  ErrImageTooLarge  = errors.New("image is too large")
+)
+
+// Archive zip based archive like CBZ which contains the images of a chapter
+type Archive struct {
+  src    multipart.File
+  Images []*zip.File // Sorted by natural order of the path
+}
+
+// OpenArchive open the uploaded archive, directories, hidden files and non-image files like ComicInfo.xml are ignored
+func OpenArchive(header *multipart.FileHeader) (*Archive, error) {
+  src, err := header.Open()
+  if err != nil {
+    return nil, err
+  }
+
+  reader, err := zip.NewReader(src, header.Size)
+  if err != nil {
+    src.Close()
+    return nil, ErrArchiveInvalid
+  }
+
+  images := []*zip.File{}
+  for _, entry := range reader.File {
+    if entry.FileInfo().IsDir() || isHiddenPath(entry.Name) {
+      continue
+    }
+    format, err := ParseFileFormat(entry.Name)
+    if err != nil || !format.Validate() {
+      continue
+    }
+    images = append(images, entry)
+  }
+
+  if len(images) == 0 {
+    src.Close()
+    return nil, ErrArchiveEmpty
+  }
+
+  slices.SortFunc(images, func(a, b *zip.File) int {
+    if util.NaturalLess(a.Name, b.Name) {
+      return -1
+    }
+    if util.NaturalLess(b.Name, a.Name) {
+      return 1
+    }
+    return 0
+  })
+
+  return &Archive{src: src, Images: images}, nil
+}
+
+// ReadImage read and validate the image entry, the content should match the format of the entry name
+func (a *Archive) ReadImage(entry *zip.File) ([]byte, Format, error) {
+  if entry.UncompressedSize64 > MaxArchiveImageSize {
+    return nil, FormatUnknown, ErrImageTooLarge
+  }
+
+  reader, err := entry.Open()
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+  defer reader.Close()
+
+  // Limit the reader in case the header is lying
+  data, err := io.ReadAll(io.LimitReader(reader, MaxArchiveImageSize+1))
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+  if len(data) > MaxArchiveImageSize {
+    return nil, FormatUnknown, ErrImageTooLarge
+  }
+
+  // Make sure the content is decodable image with the same format as the name
+  _, detected, err := DecodeImageConfig(data)
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+  format, _ := ParseFileFormat(entry.Name)
+  if !format.Equal(detected) {
+    return nil, FormatUnknown, ErrFormatMismatch
+  }
+  return data, format, nil
+}
+
+func (a *Archive) Close() error {
+  return a.src.Close()
+}
+
+// isHiddenPath check if the path or any of its parent directories is hidden, e.g. __MACOSX/ or .DS_Store
+func isHiddenPath(filepath string) bool {
+  for _, part := range strings.Split(path.Clean(filepath), "/") {
+    if strings.HasPrefix(part, ".") || strings.HasPrefix(part, "__") {
+      return true
+    }
+  }
+  return false
+}
+
+// ArchiveWriter write zip based archive, the entries are stored without compression because the images are already
+// compressed
+type ArchiveWriter struct {
+  zw *zip.Writer
+}
+
+func NewArchiveWriter(writer io.Writer) *ArchiveWriter {
+  return &ArchiveWriter{zw: zip.NewWriter(writer)}
+}
+
+// Create add new entry and return the writer of the entry, the entry should be written before creating the next one
+func (a *ArchiveWriter) Create(name string) (io.Writer, error) {
+  return a.zw.CreateHeader(&zip.FileHeader{
+    Name:     name,
+    Method:   zip.Store,
+    Modified: time.Now(),
+  })
+}
+
+// Add add new entry with the content of the reader
+func (a *ArchiveWriter) Add(name string, src io.Reader) error {
+  writer, err := a.Create(name)
+  if err != nil {
+    return err
+  }
+  _, err = io.Copy(writer, src)
+  return err
+}
+
+func (a *ArchiveWriter) Close() error {
+  return a.zw.Close()
+}
+
+// SanitizeFilename replace characters which are not allowed on most of file systems
+func SanitizeFilename(name string) string {
+  return strings.Map(func(r rune) rune {
+    if strings.ContainsRune(`/\:*?"<>|`, r) || r < 0x20 {
+      return '_'
+    }
+    return r
+  }, strings.TrimSpace(name))
+}
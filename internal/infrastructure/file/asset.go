@@ -13,17 +13,20 @@ const (
   MangaAsset   AssetType = "mangas"
   CoverAsset             = "covers"
   ProfileAsset           = "profiles"
+  VolumeAsset            = "volumes"
   UnknownAsset           = ""
 )
 
 var ErrNoFormat = errors.New("file has no format")
 
+// ParseFileFormat get the format from the extension of the filename, the extension is not trusted for uploaded file,
+// use DetectFormat or CheckFormat to make sure the content is matched
 func ParseFileFormat(filename string) (Format, error) {
   split := strings.Split(filename, ".")
   if len(split) <= 1 {
     return FormatUnknown, ErrNoFormat
   }
-  return Format(split[len(split)-1]), nil
+  return Format(strings.ToLower(split[len(split)-1])), nil
 }
 
 type Format string
@@ -32,6 +35,9 @@ const (
   FormatJPG     Format = "jpg"
   FormatJPEG           = "jpeg"
   FormatPNG            = "png"
+  FormatGIF            = "gif"
+  FormatWebP           = "webp"
+  FormatAVIF           = "avif"
   FormatUnknown        = ""
 )
 
@@ -39,12 +45,43 @@ func (f Format) String() string {
   return string(f)
 }
 
+// Canonical get the single format used for the same type of content, so the same content always has the same extension
+func (f Format) Canonical() Format {
+  if f == FormatJPEG {
+    return FormatJPG
+  }
+  return f
+}
+
 func (f Format) Filename(name string) Name {
   return Name(fmt.Sprintf("%s.%s", name, f.String()))
 }
 
+// MimeType media type of the format, it will return application/octet-stream for unknown format
+func (f Format) MimeType() string {
+  switch f {
+  case FormatJPG, FormatJPEG:
+    return "image/jpeg"
+  case FormatPNG:
+    return "image/png"
+  case FormatGIF:
+    return "image/gif"
+  case FormatWebP:
+    return "image/webp"
+  case FormatAVIF:
+    return "image/avif"
+  default:
+    return "application/octet-stream"
+  }
+}
+
+// Equal check if both formats are the same, e.g. jpg and jpeg
+func (f Format) Equal(other Format) bool {
+  return f.MimeType() == other.MimeType()
+}
+
 func (f Format) Validate() bool {
-  return util.IsOneOf(f, FormatJPG, FormatJPEG, FormatPNG)
+  return util.IsOneOf(f, FormatJPG, FormatJPEG, FormatPNG, FormatGIF, FormatWebP, FormatAVIF)
 }
 
 func (a AssetType) String() string {
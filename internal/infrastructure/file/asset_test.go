@@ -1,18 +1,18 @@
-package util
+package file
 
 import (
   "github.com/stretchr/testify/assert"
   "testing"
 )
 
-func TestGetFileFormat(t *testing.T) {
+func TestParseFileFormat(t *testing.T) {
   type args struct {
     filename string
   }
   tests := []struct {
     name    string
     args    args
-    want    string
+    want    Format
     wantErr error
   }{
     {
@@ -20,15 +20,15 @@ func TestGetFileFormat(t *testing.T) {
       args: args{
         filename: "",
       },
-      want:    "",
-      wantErr: NoFormatErr,
+      want:    FormatUnknown,
+      wantErr: ErrNoFormat,
     },
     {
       name: "Normal",
       args: args{
         filename: "format.png",
       },
-      want:    "png",
+      want:    FormatPNG,
       wantErr: nil,
     },
     {
@@ -36,26 +36,31 @@ func TestGetFileFormat(t *testing.T) {
       args: args{
         filename: "format",
       },
-      want:    "",
-      wantErr: NoFormatErr,
+      want:    FormatUnknown,
+      wantErr: ErrNoFormat,
     },
     {
       name: "Multiple dot",
       args: args{
         filename: "format1.format2.png",
       },
-      want:    "png",
+      want:    FormatPNG,
+      wantErr: nil,
+    },
+    {
+      name: "Upper case",
+      args: args{
+        filename: "format.JPG",
+      },
+      want:    FormatJPG,
       wantErr: nil,
     },
   }
   for _, tt := range tests {
     t.Run(tt.name, func(t *testing.T) {
-      got, err := GetFileFormat(tt.args.filename)
-      if tt.wantErr != nil {
-        assert.Equalf(t, err, tt.wantErr, "GetFileFormat(%v)", tt.args.filename)
-        return
-      }
-      assert.Equalf(t, tt.want, got, "GetFileFormat(%v)", tt.args.filename)
+      got, err := ParseFileFormat(tt.args.filename)
+      assert.ErrorIsf(t, err, tt.wantErr, "ParseFileFormat(%v)", tt.args.filename)
+      assert.Equalf(t, tt.want, got, "ParseFileFormat(%v)", tt.args.filename)
     })
   }
 }
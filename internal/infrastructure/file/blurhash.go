@@ -0,0 +1,105 @@
+package file
+
+import (
+  "image"
+  "math"
+  "strings"
+)
+
+const blurHashCharacters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// blurHashSamples maximum number of sampled pixels on each axis, the placeholder is blurry anyway, so sampling the
+// whole image is only wasting time
+const blurHashSamples = 64
+
+// EncodeBlurHash create BlurHash placeholder of the image, the components should be between 1 and 9.
+// See https://github.com/woltapp/blurhash/blob/master/Algorithm.md
+func EncodeBlurHash(img image.Image, xComponents, yComponents int) string {
+  bounds := img.Bounds()
+  width, height := bounds.Dx(), bounds.Dy()
+  if width == 0 || height == 0 {
+    return ""
+  }
+  stepX := max(1, width/blurHashSamples)
+  stepY := max(1, height/blurHashSamples)
+
+  factors := make([][3]float64, 0, xComponents*yComponents)
+  for j := 0; j < yComponents; j++ {
+    for i := 0; i < xComponents; i++ {
+      var factor [3]float64
+      count := 0
+      for y := 0; y < height; y += stepY {
+        basisY := math.Cos(math.Pi * float64(j) * float64(y) / float64(height))
+        for x := 0; x < width; x += stepX {
+          basis := basisY * math.Cos(math.Pi*float64(i)*float64(x)/float64(width))
+          r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+          factor[0] += basis * sRGBToLinear(r>>8)
+          factor[1] += basis * sRGBToLinear(g>>8)
+          factor[2] += basis * sRGBToLinear(b>>8)
+          count++
+        }
+      }
+
+      normalisation := 2.0
+      if i == 0 && j == 0 {
+        normalisation = 1
+      }
+      scale := normalisation / float64(count)
+      factors = append(factors, [3]float64{factor[0] * scale, factor[1] * scale, factor[2] * scale})
+    }
+  }
+
+  builder := strings.Builder{}
+  encode83(&builder, (xComponents-1)+(yComponents-1)*9, 1)
+
+  dc, ac := factors[0], factors[1:]
+  maximum := 1.0
+  if len(ac) > 0 {
+    actualMaximum := 0.0
+    for _, factor := range ac {
+      actualMaximum = max(actualMaximum, math.Abs(factor[0]), math.Abs(factor[1]), math.Abs(factor[2]))
+    }
+    quantisedMaximum := int(max(0, min(82, math.Floor(actualMaximum*166-0.5))))
+    maximum = float64(quantisedMaximum+1) / 166
+    encode83(&builder, quantisedMaximum, 1)
+  } else {
+    encode83(&builder, 0, 1)
+  }
+
+  encode83(&builder, linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4)
+  for _, factor := range ac {
+    quantR := quantiseAC(factor[0], maximum)
+    quantG := quantiseAC(factor[1], maximum)
+    quantB := quantiseAC(factor[2], maximum)
+    encode83(&builder, quantR*19*19+quantG*19+quantB, 2)
+  }
+  return builder.String()
+}
+
+func encode83(builder *strings.Builder, value, length int) {
+  for i := 1; i <= length; i++ {
+    digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+    builder.WriteByte(blurHashCharacters[digit])
+  }
+}
+
+func quantiseAC(value, maximum float64) int {
+  signed := math.Copysign(math.Pow(math.Abs(value/maximum), 0.5), value)
+  return int(max(0, min(18, math.Floor(signed*9+9.5))))
+}
+
+func sRGBToLinear(value uint32) float64 {
+  v := float64(value) / 255
+  if v <= 0.04045 {
+    return v / 12.92
+  }
+  return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+  v := max(0, min(1, value))
+  if v <= 0.0031308 {
+    return int(v*12.92*255 + 0.5)
+  }
+  return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
@@ -0,0 +1,265 @@
+package file
+
+import (
+  "archive/zip"
+  "bytes"
+  "encoding/xml"
+  "fmt"
+  "html"
+  "io"
+  "strings"
+  "time"
+)
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+const epubPage = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops">
+<head>
+  <title>%s</title>
+  <meta name="viewport" content="width=%d, height=%d"/>
+  <style>html, body { margin: 0; padding: 0; } img { display: block; width: 100%%; height: 100%%; }</style>
+</head>
+<body>
+  <img src="../images/%s" alt="%s"/>
+</body>
+</html>
+`
+
+// EpubMetadata metadata of the book, Language should be BCP 47 language tag
+type EpubMetadata struct {
+  Id          string
+  Title       string
+  Language    string
+  Description string
+  Creators    []string
+  Subjects    []string
+}
+
+// EpubSection used for table of contents, the section starts at the page of the index
+type EpubSection struct {
+  Title string
+  Page  int
+}
+
+type epubItem struct {
+  XMLName    xml.Name `xml:"item"`
+  Id         string   `xml:"id,attr"`
+  Href       string   `xml:"href,attr"`
+  MediaType  string   `xml:"media-type,attr"`
+  Properties string   `xml:"properties,attr,omitempty"`
+}
+
+// EpubWriter write EPUB 3 fixed-layout book where each page is single image
+type EpubWriter struct {
+  zw       *zip.Writer
+  metadata EpubMetadata
+  items    []epubItem
+  spine    []string
+  sections []EpubSection
+}
+
+// NewEpubWriter create the writer, the mimetype entry is written immediately because it should be the first entry
+func NewEpubWriter(writer io.Writer, metadata EpubMetadata) (*EpubWriter, error) {
+  zw := zip.NewWriter(writer)
+  // mimetype should be uncompressed
+  entry, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+  if err != nil {
+    return nil, err
+  }
+  if _, err = io.WriteString(entry, "application/epub+zip"); err != nil {
+    return nil, err
+  }
+
+  entry, err = zw.Create("META-INF/container.xml")
+  if err != nil {
+    return nil, err
+  }
+  if _, err = io.WriteString(entry, epubContainer); err != nil {
+    return nil, err
+  }
+
+  return &EpubWriter{zw: zw, metadata: metadata}, nil
+}
+
+// SetCover add the cover image, it is not part of the reading order
+func (e *EpubWriter) SetCover(format Format, src io.Reader) error {
+  name := format.Filename("cover").String()
+  if err := e.add("OEBPS/images/"+name, src); err != nil {
+    return err
+  }
+  e.items = append(e.items, epubItem{Id: "cover-image", Href: "images/" + name, MediaType: format.MimeType(), Properties: "cover-image"})
+  return nil
+}
+
+// AddSection mark the next page as the start of the section on table of contents
+func (e *EpubWriter) AddSection(title string) {
+  e.sections = append(e.sections, EpubSection{Title: title, Page: len(e.spine)})
+}
+
+// AddPage add the image as new page, the image is read entirely to get the dimension used for the viewport
+func (e *EpubWriter) AddPage(format Format, src io.Reader) error {
+  data, err := io.ReadAll(src)
+  if err != nil {
+    return err
+  }
+  config, _, err := DecodeImageConfig(data)
+  if err != nil {
+    return err
+  }
+
+  number := len(e.spine) + 1
+  id := fmt.Sprintf("page-%04d", number)
+  imageName := format.Filename(id).String()
+  if err = e.add("OEBPS/images/"+imageName, bytes.NewReader(data)); err != nil {
+    return err
+  }
+
+  title := fmt.Sprintf("Page %d", number)
+  page := fmt.Sprintf(epubPage, title, config.Width, config.Height, imageName, title)
+  if err = e.add("OEBPS/pages/"+id+".xhtml", strings.NewReader(page)); err != nil {
+    return err
+  }
+
+  e.items = append(e.items,
+    epubItem{Id: "image-" + id, Href: "images/" + imageName, MediaType: format.MimeType()},
+    epubItem{Id: id, Href: "pages/" + id + ".xhtml", MediaType: "application/xhtml+xml"},
+  )
+  e.spine = append(e.spine, id)
+  return nil
+}
+
+// Close write the navigation and package document, then close the archive
+func (e *EpubWriter) Close() error {
+  if err := e.add("OEBPS/nav.xhtml", strings.NewReader(e.navigation())); err != nil {
+    return err
+  }
+  e.items = append(e.items, epubItem{Id: "nav", Href: "nav.xhtml", MediaType: "application/xhtml+xml", Properties: "nav"})
+
+  pkg, err := e.packageDocument()
+  if err != nil {
+    return err
+  }
+  if err = e.add("OEBPS/content.opf", bytes.NewReader(pkg)); err != nil {
+    return err
+  }
+  return e.zw.Close()
+}
+
+func (e *EpubWriter) add(name string, src io.Reader) error {
+  entry, err := e.zw.Create(name)
+  if err != nil {
+    return err
+  }
+  _, err = io.Copy(entry, src)
+  return err
+}
+
+func (e *EpubWriter) navigation() string {
+  // Use the first page when there is no section
+  sections := e.sections
+  if len(sections) == 0 {
+    sections = []EpubSection{{Title: e.metadata.Title, Page: 0}}
+  }
+
+  builder := strings.Builder{}
+  for _, section := range sections {
+    if section.Page >= len(e.spine) {
+      continue
+    }
+    builder.WriteString(fmt.Sprintf("      <li><a href=\"pages/%s.xhtml\">%s</a></li>\n", e.spine[section.Page], html.EscapeString(section.Title)))
+  }
+
+  return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops">
+<head>
+  <title>%s</title>
+</head>
+<body>
+  <nav epub:type="toc">
+    <ol>
+%s    </ol>
+  </nav>
+</body>
+</html>
+`, html.EscapeString(e.metadata.Title), builder.String())
+}
+
+func (e *EpubWriter) packageDocument() ([]byte, error) {
+  type meta struct {
+    Property string `xml:"property,attr,omitempty"`
+    Name     string `xml:"name,attr,omitempty"`
+    Content  string `xml:"content,attr,omitempty"`
+    Value    string `xml:",chardata"`
+  }
+  type identifier struct {
+    Id    string `xml:"id,attr"`
+    Value string `xml:",chardata"`
+  }
+  type itemref struct {
+    IdRef string `xml:"idref,attr"`
+  }
+  type spine struct {
+    Direction string    `xml:"page-progression-direction,attr"`
+    Items     []itemref `xml:"itemref"`
+  }
+  type pkg struct {
+    XMLName     xml.Name   `xml:"http://www.idpf.org/2007/opf package"`
+    Version     string     `xml:"version,attr"`
+    UniqueId    string     `xml:"unique-identifier,attr"`
+    Prefix      string     `xml:"prefix,attr"`
+    DC          string     `xml:"xmlns:dc,attr"`
+    Identifier  identifier `xml:"metadata>dc:identifier"`
+    Title       string     `xml:"metadata>dc:title"`
+    Language    string     `xml:"metadata>dc:language"`
+    Description string     `xml:"metadata>dc:description,omitempty"`
+    Creators    []string   `xml:"metadata>dc:creator"`
+    Subjects    []string   `xml:"metadata>dc:subject"`
+    Metas       []meta     `xml:"metadata>meta"`
+    Items       []epubItem `xml:"manifest>item"`
+    Spine       spine      `xml:"spine"`
+  }
+
+  document := pkg{
+    Version:     "3.0",
+    UniqueId:    "uid",
+    Prefix:      "rendition: http://www.idpf.org/vocab/rendition/#",
+    DC:          "http://purl.org/dc/elements/1.1/",
+    Identifier:  identifier{Id: "uid", Value: "urn:uuid:" + e.metadata.Id},
+    Title:       e.metadata.Title,
+    Language:    e.metadata.Language,
+    Description: e.metadata.Description,
+    Creators:    e.metadata.Creators,
+    Subjects:    e.metadata.Subjects,
+    Metas: []meta{
+      {Property: "dcterms:modified", Value: time.Now().UTC().Format("2006-01-02T15:04:05Z")},
+      {Property: "rendition:layout", Value: "pre-paginated"},
+      {Property: "rendition:orientation", Value: "auto"},
+      {Property: "rendition:spread", Value: "landscape"},
+    },
+    Items:       e.items,
+    Spine:       spine{Direction: "rtl"},
+  }
+  for _, item := range e.items {
+    if item.Properties == "cover-image" {
+      document.Metas = append(document.Metas, meta{Name: "cover", Content: item.Id})
+    }
+  }
+  for _, id := range e.spine {
+    document.Spine.Items = append(document.Spine.Items, itemref{IdRef: id})
+  }
+
+  data, err := xml.MarshalIndent(document, "", "  ")
+  if err != nil {
+    return nil, err
+  }
+  return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,150 @@
+package file
+
+import (
+  "bytes"
+  "encoding/binary"
+  "errors"
+  "image"
+  _ "image/gif"
+  _ "image/jpeg"
+  _ "image/png"
+
+  _ "golang.org/x/image/webp"
+)
+
+// SniffLength number of bytes needed by DetectFormat
+const SniffLength = 32
+
+var (
+  ErrFormatUnsupported = errors.New("file is not supported image")
+  ErrFormatMismatch    = errors.New("file extension doesn't match the content")
+)
+
+// DetectFormat detect the image format based on the magic bytes instead of the extension, it only needs the first
+// SniffLength bytes of the file
+func DetectFormat(header []byte) (Format, error) {
+  switch {
+  case bytes.HasPrefix(header, []byte{0xFF, 0xD8, 0xFF}):
+    return FormatJPEG, nil
+  case bytes.HasPrefix(header, []byte("\x89PNG\r\n\x1a\n")):
+    return FormatPNG, nil
+  case bytes.HasPrefix(header, []byte("GIF87a")), bytes.HasPrefix(header, []byte("GIF89a")):
+    return FormatGIF, nil
+  case len(header) >= 12 && bytes.Equal(header[:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP")):
+    return FormatWebP, nil
+  case isAVIF(header):
+    return FormatAVIF, nil
+  }
+  return FormatUnknown, ErrFormatUnsupported
+}
+
+// CheckFormat make sure the content is supported image with the same format as the expected one
+func CheckFormat(header []byte, expected Format) error {
+  format, err := DetectFormat(header)
+  if err != nil {
+    return err
+  }
+  if !format.Equal(expected) {
+    return ErrFormatMismatch
+  }
+  return nil
+}
+
+// DecodeImageConfig get the dimension of the image, WebP and AVIF are parsed from the header because there is no
+// decoder for them in the standard library
+func DecodeImageConfig(data []byte) (image.Config, Format, error) {
+  format, err := DetectFormat(data)
+  if err != nil {
+    return image.Config{}, FormatUnknown, err
+  }
+
+  var config image.Config
+  switch format {
+  case FormatWebP:
+    config, err = decodeWebPConfig(data)
+  case FormatAVIF:
+    config, err = decodeAVIFConfig(data)
+  default:
+    config, _, err = image.DecodeConfig(bytes.NewReader(data))
+  }
+  if err != nil || config.Width == 0 || config.Height == 0 {
+    return image.Config{}, FormatUnknown, ErrImageInvalid
+  }
+  return config, format, nil
+}
+
+// isAVIF check the brands of ISO BMFF ftyp box
+func isAVIF(header []byte) bool {
+  if len(header) < 12 || !bytes.Equal(header[4:8], []byte("ftyp")) {
+    return false
+  }
+  size := min(int(binary.BigEndian.Uint32(header[:4])), len(header))
+  // Major brand, then skip minor version and check the compatible brands
+  for i := 8; i+4 <= size; i += 4 {
+    if i == 12 {
+      continue
+    }
+    brand := string(header[i : i+4])
+    if brand == "avif" || brand == "avis" {
+      return true
+    }
+  }
+  return false
+}
+
+// decodeWebPConfig read the dimension from the first chunk.
+// See https://developers.google.com/speed/webp/docs/riff_container
+func decodeWebPConfig(data []byte) (image.Config, error) {
+  if len(data) < 30 {
+    return image.Config{}, ErrImageInvalid
+  }
+
+  var width, height int
+  switch string(data[12:16]) {
+  case "VP8 ":
+    if !bytes.Equal(data[23:26], []byte{0x9D, 0x01, 0x2A}) {
+      return image.Config{}, ErrImageInvalid
+    }
+    width = int(binary.LittleEndian.Uint16(data[26:28]) & 0x3FFF)
+    height = int(binary.LittleEndian.Uint16(data[28:30]) & 0x3FFF)
+  case "VP8L":
+    if data[20] != 0x2F {
+      return image.Config{}, ErrImageInvalid
+    }
+    bits := binary.LittleEndian.Uint32(data[21:25])
+    width = int(bits&0x3FFF) + 1
+    height = int(bits>>14&0x3FFF) + 1
+  case "VP8X":
+    width = int(uint32(data[24])|uint32(data[25])<<8|uint32(data[26])<<16) + 1
+    height = int(uint32(data[27])|uint32(data[28])<<8|uint32(data[29])<<16) + 1
+  default:
+    return image.Config{}, ErrImageInvalid
+  }
+  return image.Config{Width: width, Height: height}, nil
+}
+
+// decodeAVIFConfig read the dimension from the image spatial extents (ispe) property, the largest one is used because
+// grid image has the extents of each tile too
+func decodeAVIFConfig(data []byte) (image.Config, error) {
+  config := image.Config{}
+  for offset := 0; ; {
+    index := bytes.Index(data[offset:], []byte("ispe"))
+    if index < 0 {
+      break
+    }
+    start := offset + index + 8 // Skip the box type, version and flags
+    if start+8 > len(data) {
+      break
+    }
+    width := int(binary.BigEndian.Uint32(data[start : start+4]))
+    height := int(binary.BigEndian.Uint32(data[start+4 : start+8]))
+    if width > config.Width {
+      config.Width, config.Height = width, height
+    }
+    offset = start
+  }
+  if config.Width == 0 {
+    return config, ErrImageInvalid
+  }
+  return config, nil
+}
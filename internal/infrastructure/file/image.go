@@ -0,0 +1,60 @@
+package file
+
+import (
+  "bytes"
+  "image"
+)
+
+// MaxImagePixels maximum number of pixels of the image which is decoded, the dimension is read from the header before
+// the image is decoded, so a small file claiming huge dimension can't allocate the memory of the whole image
+const MaxImagePixels = 50_000_000
+
+// ImageInfo metadata of the image, used by readers to lay out the pages before the images are loaded
+type ImageInfo struct {
+  Width    uint32
+  Height   uint32
+  Size     int64
+  MimeType string
+  BlurHash string
+}
+
+// ReadImageInfo decode the image to get the dimension and the placeholder, the mime type is based on the content
+// instead of the filename
+func ReadImageInfo(data []byte) (ImageInfo, error) {
+  config, format, err := DecodeImageConfig(data)
+  if err != nil {
+    return ImageInfo{}, err
+  }
+  if int64(config.Width)*int64(config.Height) > MaxImagePixels {
+    return ImageInfo{}, ErrImageTooLarge
+  }
+
+  info := ImageInfo{
+    Width:    uint32(config.Width),
+    Height:   uint32(config.Height),
+    Size:     int64(len(data)),
+    MimeType: format.MimeType(),
+  }
+  // The placeholder is only created for the formats which could be decoded, like JPEG, PNG, GIF and WebP
+  if img, err := decodeImage(data); err == nil {
+    info.BlurHash = EncodeBlurHash(img, 4, 3)
+  }
+  return info, nil
+}
+
+// decodeImage decode the image after checking the dimension against MaxImagePixels
+func decodeImage(data []byte) (image.Image, error) {
+  config, _, err := image.DecodeConfig(bytes.NewReader(data))
+  if err != nil {
+    return nil, ErrImageInvalid
+  }
+  if int64(config.Width)*int64(config.Height) > MaxImagePixels {
+    return nil, ErrImageTooLarge
+  }
+
+  img, _, err := image.Decode(bytes.NewReader(data))
+  if err != nil {
+    return nil, ErrImageInvalid
+  }
+  return img, nil
+}
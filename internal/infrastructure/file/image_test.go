@@ -0,0 +1,48 @@
+package file
+
+import (
+  "bytes"
+  "encoding/binary"
+  "hash/crc32"
+  "image"
+  "image/png"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+)
+
+// testHugePNG create PNG claiming huge dimension in the header, the IHDR checksum is updated so only the dimension
+// is invalid
+func testHugePNG(t *testing.T) []byte {
+  encoded := bytes.Buffer{}
+  require.NoError(t, png.Encode(&encoded, image.NewGray(image.Rect(0, 0, 16, 16))))
+  data := encoded.Bytes()
+  binary.BigEndian.PutUint32(data[16:], 100_000)
+  binary.BigEndian.PutUint32(data[20:], 100_000)
+  binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+  return data
+}
+
+func TestReadImageInfo(t *testing.T) {
+  encoded := bytes.Buffer{}
+  require.NoError(t, png.Encode(&encoded, image.NewGray(image.Rect(0, 0, 64, 32))))
+  data := encoded.Bytes()
+
+  info, err := ReadImageInfo(data)
+  require.NoError(t, err)
+  assert.EqualValues(t, 64, info.Width)
+  assert.EqualValues(t, 32, info.Height)
+  assert.EqualValues(t, len(data), info.Size)
+  assert.Equal(t, "image/png", info.MimeType)
+  assert.NotEmpty(t, info.BlurHash)
+}
+
+func TestReadImageInfo_TooLarge(t *testing.T) {
+  // The image is rejected before it is decoded
+  _, err := ReadImageInfo(testHugePNG(t))
+  assert.ErrorIs(t, err, ErrImageTooLarge)
+
+  _, err = decodeImage(testHugePNG(t))
+  assert.ErrorIs(t, err, ErrImageTooLarge)
+}
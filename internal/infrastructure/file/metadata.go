@@ -0,0 +1,532 @@
+package file
+
+import (
+  "bytes"
+  "encoding/binary"
+)
+
+// StripMetadata remove the metadata like EXIF, XMP and comments from the image without re-encoding it. The color
+// profile is kept, and the EXIF orientation of JPEG is preserved, so the image is still displayed the same way.
+// The metadata of AVIF is cleared instead of removed, see stripAVIF.
+func StripMetadata(data []byte, format Format) ([]byte, error) {
+  switch format {
+  case FormatJPG, FormatJPEG:
+    return stripJPEG(data)
+  case FormatPNG:
+    return stripPNG(data)
+  case FormatWebP:
+    return stripWebP(data)
+  case FormatGIF:
+    return stripGIF(data)
+  case FormatAVIF:
+    return stripAVIF(data)
+  }
+  return data, nil
+}
+
+// stripJPEG remove APP1 (EXIF and XMP), APP3 until APP13, APP15 and COM segments. APP0 (JFIF), APP2 (ICC profile)
+// and APP14 (Adobe color transform) are needed to decode the image correctly.
+func stripJPEG(data []byte) ([]byte, error) {
+  if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+    return nil, ErrImageInvalid
+  }
+
+  result := make([]byte, 0, len(data))
+  result = append(result, data[:2]...)
+  for i := 2; i < len(data); {
+    if data[i] != 0xFF || i+1 >= len(data) {
+      return nil, ErrImageInvalid
+    }
+    marker := data[i+1]
+    switch {
+    case marker == 0xFF: // Fill byte
+      i++
+      continue
+    case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD8):
+      result = append(result, data[i:i+2]...)
+      i += 2
+      continue
+    case marker == 0xD9:
+      return append(result, data[i:]...), nil
+    }
+
+    if i+4 > len(data) {
+      return nil, ErrImageInvalid
+    }
+    end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:i+4]))
+    if end < i+4 || end > len(data) {
+      return nil, ErrImageInvalid
+    }
+    segment := data[i:end]
+
+    switch {
+    case marker == 0xDA:
+      // Start of scan, the rest is entropy coded data
+      return append(result, data[i:]...), nil
+    case marker == 0xE1:
+      if orientation := exifOrientation(segment[4:]); orientation > 1 {
+        result = append(result, orientationSegment(orientation)...)
+      }
+    case marker == 0xFE, marker == 0xEF, marker >= 0xE3 && marker <= 0xED:
+    default:
+      result = append(result, segment...)
+    }
+    i = end
+  }
+  return nil, ErrImageInvalid
+}
+
+// jpegOrientation get the EXIF orientation of JPEG, it will return 0 for other formats or when there is no orientation
+func jpegOrientation(data []byte) uint16 {
+  if !bytes.HasPrefix(data, []byte{0xFF, 0xD8}) {
+    return 0
+  }
+  for i := 2; i+4 <= len(data) && data[i] == 0xFF; {
+    marker := data[i+1]
+    end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:i+4]))
+    if marker == 0xDA || end > len(data) {
+      return 0
+    }
+    if marker == 0xE1 {
+      if orientation := exifOrientation(data[i+4 : end]); orientation > 0 {
+        return orientation
+      }
+    }
+    i = end
+  }
+  return 0
+}
+
+// exifOrientation read the orientation tag from IFD0 of EXIF, it will return 0 when there is no orientation
+func exifOrientation(exif []byte) uint16 {
+  if !bytes.HasPrefix(exif, []byte("Exif\x00\x00")) {
+    return 0
+  }
+  tiff := exif[6:]
+  if len(tiff) < 8 {
+    return 0
+  }
+
+  var order binary.ByteOrder
+  switch string(tiff[:2]) {
+  case "II":
+    order = binary.LittleEndian
+  case "MM":
+    order = binary.BigEndian
+  default:
+    return 0
+  }
+
+  offset := int(order.Uint32(tiff[4:8]))
+  if offset < 8 || offset+2 > len(tiff) {
+    return 0
+  }
+  count := int(order.Uint16(tiff[offset:]))
+  for i := 0; i < count; i++ {
+    entry := offset + 2 + i*12
+    if entry+12 > len(tiff) {
+      return 0
+    }
+    if order.Uint16(tiff[entry:]) == 0x0112 {
+      return order.Uint16(tiff[entry+8:])
+    }
+  }
+  return 0
+}
+
+// orientationSegment create APP1 segment containing EXIF with only the orientation tag
+func orientationSegment(orientation uint16) []byte {
+  segment := []byte{
+    0xFF, 0xE1, 0x00, 0x22,
+    'E', 'x', 'i', 'f', 0x00, 0x00,
+    'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // TIFF header
+    0x00, 0x01, // Number of entries
+    0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, // Orientation, SHORT, 1 value
+    0x00, 0x00, 0x00, 0x00, // No next IFD
+  }
+  binary.BigEndian.PutUint16(segment[28:], orientation)
+  return segment
+}
+
+// stripPNG remove the text, EXIF and modification time chunks
+func stripPNG(data []byte) ([]byte, error) {
+  const signatureLength = 8
+  if len(data) < signatureLength {
+    return nil, ErrImageInvalid
+  }
+
+  result := make([]byte, 0, len(data))
+  result = append(result, data[:signatureLength]...)
+  for i := signatureLength; i < len(data); {
+    if i+8 > len(data) {
+      return nil, ErrImageInvalid
+    }
+    end := i + 12 + int(binary.BigEndian.Uint32(data[i:i+4]))
+    if end < i+12 || end > len(data) {
+      return nil, ErrImageInvalid
+    }
+
+    switch string(data[i+4 : i+8]) {
+    case "tEXt", "zTXt", "iTXt", "eXIf", "tIME":
+    default:
+      result = append(result, data[i:end]...)
+    }
+    i = end
+  }
+  return result, nil
+}
+
+// stripWebP remove the EXIF and XMP chunks, they are only allowed on the extended format (VP8X).
+// See https://developers.google.com/speed/webp/docs/riff_container
+func stripWebP(data []byte) ([]byte, error) {
+  const headerLength = 12
+  if len(data) < headerLength+8 {
+    return nil, ErrImageInvalid
+  }
+  if string(data[12:16]) != "VP8X" {
+    return data, nil
+  }
+
+  result := make([]byte, 0, len(data))
+  result = append(result, data[:headerLength]...)
+  for i := headerLength; i < len(data); {
+    if i+8 > len(data) {
+      return nil, ErrImageInvalid
+    }
+    size := int(binary.LittleEndian.Uint32(data[i+4 : i+8]))
+    end := i + 8 + size + size%2
+    if end < i+8 || end > len(data) {
+      return nil, ErrImageInvalid
+    }
+
+    switch string(data[i : i+4]) {
+    case "EXIF", "XMP ":
+    case "VP8X":
+      start := len(result)
+      result = append(result, data[i:end]...)
+      result[start+8] &^= 0x08 | 0x04 // EXIF and XMP flags
+    default:
+      result = append(result, data[i:end]...)
+    }
+    i = end
+  }
+  binary.LittleEndian.PutUint32(result[4:8], uint32(len(result)-8))
+  return result, nil
+}
+
+// stripGIF remove the comment extensions and the application extensions other than the animation loop
+func stripGIF(data []byte) ([]byte, error) {
+  const headerLength = 13
+  if len(data) < headerLength {
+    return nil, ErrImageInvalid
+  }
+
+  i := headerLength
+  if data[10]&0x80 != 0 {
+    i += 3 << (data[10]&0x07 + 1) // Global color table
+  }
+  if i > len(data) {
+    return nil, ErrImageInvalid
+  }
+
+  result := make([]byte, 0, len(data))
+  result = append(result, data[:i]...)
+  for i < len(data) {
+    start := i
+    switch data[i] {
+    case 0x3B: // Trailer
+      return append(result, data[i:]...), nil
+    case 0x21: // Extension
+      if i+2 > len(data) {
+        return nil, ErrImageInvalid
+      }
+      label := data[i+1]
+      end, ok := skipGIFSubBlocks(data, i+2)
+      if !ok {
+        return nil, ErrImageInvalid
+      }
+      i = end
+
+      application := data[start+2:]
+      if label == 0xFE || (label == 0xFF && !bytes.HasPrefix(application, []byte("\x0BNETSCAPE2.0")) &&
+        !bytes.HasPrefix(application, []byte("\x0BANIMEXTS1.0"))) {
+        continue
+      }
+    case 0x2C: // Image descriptor
+      if i+10 > len(data) {
+        return nil, ErrImageInvalid
+      }
+      flags := data[i+9]
+      i += 10
+      if flags&0x80 != 0 {
+        i += 3 << (flags&0x07 + 1) // Local color table
+      }
+      end, ok := skipGIFSubBlocks(data, i+1) // Skip LZW minimum code size
+      if !ok {
+        return nil, ErrImageInvalid
+      }
+      i = end
+    default:
+      return nil, ErrImageInvalid
+    }
+    result = append(result, data[start:i]...)
+  }
+  return nil, ErrImageInvalid
+}
+
+// skipGIFSubBlocks get the position after the block terminator
+func skipGIFSubBlocks(data []byte, i int) (int, bool) {
+  for i < len(data) {
+    size := int(data[i])
+    i += size + 1
+    if size == 0 {
+      return i, true
+    }
+  }
+  return 0, false
+}
+
+// xmpContentType content type of XMP item of HEIF
+const xmpContentType = "application/rdf+xml"
+
+// stripAVIF clear the payload of the EXIF and XMP items. The items are kept, so the location of the other items
+// doesn't need to be changed. The image which stores the metadata on other way than the file offset or the idat box is
+// rejected, because the metadata could not be cleared.
+// See ISO/IEC 14496-12 (ISO base media file format) and ISO/IEC 23008-12 (HEIF)
+func stripAVIF(data []byte) ([]byte, error) {
+  boxes, ok := readISOBoxes(data, 0, len(data))
+  if !ok {
+    return nil, ErrImageInvalid
+  }
+  meta, ok := findISOBox(boxes, "meta")
+  if !ok || meta.end-meta.start < 4 {
+    return nil, ErrImageInvalid
+  }
+  // Skip the version and flags of the full box
+  children, ok := readISOBoxes(data, meta.start+4, meta.end)
+  if !ok {
+    return nil, ErrImageInvalid
+  }
+
+  iinf, ok := findISOBox(children, "iinf")
+  if !ok {
+    return data, nil
+  }
+  items, err := avifMetadataItems(data, iinf)
+  if err != nil {
+    return nil, err
+  }
+  if len(items) == 0 {
+    return data, nil
+  }
+
+  iloc, ok := findISOBox(children, "iloc")
+  if !ok {
+    return nil, ErrImageInvalid
+  }
+  // The box is empty when it is not exists, so the items on it are never valid
+  idat, _ := findISOBox(children, "idat")
+
+  result := bytes.Clone(data)
+  err = clearAVIFItems(result, iloc, idat, items)
+  if err != nil {
+    return nil, err
+  }
+  return result, nil
+}
+
+// avifMetadataItems get the id of the EXIF and XMP items from the item info (iinf) box
+func avifMetadataItems(data []byte, iinf isoBox) (map[uint32]struct{}, error) {
+  reader := boxReader{data: data[iinf.start:iinf.end]}
+  version := reader.uint(1)
+  reader.uint(3) // Flags
+  if version == 0 {
+    reader.uint(2)
+  } else {
+    reader.uint(4)
+  }
+  if reader.failed {
+    return nil, ErrImageInvalid
+  }
+  entries, ok := readISOBoxes(data, iinf.start+reader.pos, iinf.end)
+  if !ok {
+    return nil, ErrImageInvalid
+  }
+
+  items := map[uint32]struct{}{}
+  for _, entry := range entries {
+    if entry.kind != "infe" {
+      continue
+    }
+    reader = boxReader{data: data[entry.start:entry.end]}
+    version = reader.uint(1)
+    reader.uint(3) // Flags
+    // Item info entry before version 2 doesn't have the item type
+    if version < 2 {
+      continue
+    }
+    var id uint64
+    if version == 2 {
+      id = reader.uint(2)
+    } else {
+      id = reader.uint(4)
+    }
+    reader.uint(2) // Protection index
+    itemType := reader.bytes(4)
+    if reader.failed {
+      return nil, ErrImageInvalid
+    }
+
+    switch string(itemType) {
+    case "Exif":
+      items[uint32(id)] = struct{}{}
+    case "mime":
+      // The item name and the content type are null terminated
+      fields := bytes.SplitN(reader.data[reader.pos:], []byte{0}, 3)
+      if len(fields) >= 2 && string(fields[1]) == xmpContentType {
+        items[uint32(id)] = struct{}{}
+      }
+    }
+  }
+  return items, nil
+}
+
+// clearAVIFItems fill the extents of the items with zero based on the item location (iloc) box
+func clearAVIFItems(data []byte, iloc, idat isoBox, items map[uint32]struct{}) error {
+  reader := boxReader{data: data[iloc.start:iloc.end]}
+  version := reader.uint(1)
+  reader.uint(3) // Flags
+  sizes := reader.uint(1)
+  offsetSize, lengthSize := int(sizes>>4), int(sizes&0x0F)
+  sizes = reader.uint(1)
+  baseOffsetSize, indexSize := int(sizes>>4), 0
+  if version == 1 || version == 2 {
+    indexSize = int(sizes & 0x0F)
+  }
+  var count uint64
+  if version < 2 {
+    count = reader.uint(2)
+  } else {
+    count = reader.uint(4)
+  }
+
+  for n := uint64(0); n < count && !reader.failed; n++ {
+    var id uint64
+    if version < 2 {
+      id = reader.uint(2)
+    } else {
+      id = reader.uint(4)
+    }
+    var method uint64
+    if version == 1 || version == 2 {
+      method = reader.uint(2) & 0x0F
+    }
+    reader.uint(2) // Data reference index
+    baseOffset := reader.uint(baseOffsetSize)
+    extentCount := reader.uint(2)
+
+    for e := uint64(0); e < extentCount && !reader.failed; e++ {
+      reader.uint(indexSize)
+      offset := reader.uint(offsetSize)
+      length := reader.uint(lengthSize)
+      if _, ok := items[uint32(id)]; !ok || reader.failed {
+        continue
+      }
+
+      // Construction method 0 is the offset of the file and 1 is the offset of the idat box
+      var region []byte
+      switch method {
+      case 0:
+        region = data
+      case 1:
+        region = data[idat.start:idat.end]
+      default:
+        return ErrImageInvalid
+      }
+      start := baseOffset + offset
+      end := start + length
+      if length == 0 || end < start || end > uint64(len(region)) {
+        return ErrImageInvalid
+      }
+      clear(region[start:end])
+    }
+  }
+  if reader.failed {
+    return ErrImageInvalid
+  }
+  return nil
+}
+
+// isoBox box of ISO base media file format, the payload is between start and end
+type isoBox struct {
+  kind  string
+  start int
+  end   int
+}
+
+// readISOBoxes get the boxes between start and end, it will return false when one of them is exceeding the end
+func readISOBoxes(data []byte, start, end int) ([]isoBox, bool) {
+  var boxes []isoBox
+  for i := start; i < end; {
+    if i+8 > end {
+      return nil, false
+    }
+    size := uint64(binary.BigEndian.Uint32(data[i : i+4]))
+    headerLength := 8
+    switch size {
+    case 0: // Extended until the end
+      size = uint64(end - i)
+    case 1: // 64 bits size
+      if i+16 > end {
+        return nil, false
+      }
+      size = binary.BigEndian.Uint64(data[i+8 : i+16])
+      headerLength = 16
+    }
+    if size < uint64(headerLength) || size > uint64(end-i) {
+      return nil, false
+    }
+    boxes = append(boxes, isoBox{kind: string(data[i+4 : i+8]), start: i + headerLength, end: i + int(size)})
+    i += int(size)
+  }
+  return boxes, true
+}
+
+func findISOBox(boxes []isoBox, kind string) (isoBox, bool) {
+  for _, box := range boxes {
+    if box.kind == kind {
+      return box, true
+    }
+  }
+  return isoBox{}, false
+}
+
+// boxReader read big endian values from the payload of the box, the reader is failed when it is reading after the end
+type boxReader struct {
+  data   []byte
+  pos    int
+  failed bool
+}
+
+func (r *boxReader) bytes(size int) []byte {
+  if r.failed || r.pos+size > len(r.data) {
+    r.failed = true
+    return nil
+  }
+  result := r.data[r.pos : r.pos+size]
+  r.pos += size
+  return result
+}
+
+// uint read unsigned integer of the size in bytes, zero size is allowed and always returns 0
+func (r *boxReader) uint(size int) uint64 {
+  if size > 8 {
+    r.failed = true
+    return 0
+  }
+  var value uint64
+  for _, b := range r.bytes(size) {
+    value = value<<8 | uint64(b)
+  }
+  return value
+}
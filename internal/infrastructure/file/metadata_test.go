@@ -0,0 +1,515 @@
+package file
+
+import (
+  "bytes"
+  "encoding/binary"
+  "hash/crc32"
+  "image"
+  "image/color"
+  "image/gif"
+  "image/jpeg"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+  "golang.org/x/image/webp"
+)
+
+func testImage() image.Image {
+  return testQuadrantImage(16, 8)
+}
+
+func jpegSegment(marker byte, payload string) []byte {
+  segment := []byte{0xFF, marker, 0, 0}
+  binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+  return append(segment, payload...)
+}
+
+// testJPEGWith insert the segments after SOI of the encoded JPEG
+func testJPEGWith(t *testing.T, segments ...[]byte) []byte {
+  encoded := testEncode(t, testImage(), FormatJPEG)
+  return bytes.Join(append(append([][]byte{encoded[:2]}, segments...), encoded[2:]), nil)
+}
+
+func pngChunk(kind, payload string) []byte {
+  chunk := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+  chunk = append(chunk, kind...)
+  chunk = append(chunk, payload...)
+  return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE([]byte(kind+payload)))
+}
+
+// testPNGWith insert the chunks after IHDR of the encoded PNG
+func testPNGWith(t *testing.T, chunks ...[]byte) []byte {
+  encoded := testEncode(t, testImage(), FormatPNG)
+  const afterHeader = 8 + 25
+  return bytes.Join(append(append([][]byte{encoded[:afterHeader]}, chunks...), encoded[afterHeader:]), nil)
+}
+
+func riffChunk(kind string, payload []byte) []byte {
+  chunk := append([]byte(kind), binary.LittleEndian.AppendUint32(nil, uint32(len(payload)))...)
+  chunk = append(chunk, payload...)
+  if len(payload)%2 == 1 {
+    chunk = append(chunk, 0)
+  }
+  return chunk
+}
+
+// testWebPWith create extended WebP which has the chunks around the image
+func testWebPWith(t *testing.T, flags byte, before, after [][]byte) []byte {
+  encoded := testEncode(t, testImage(), FormatWebP)
+  image := encoded[12:] // VP8L chunk
+
+  header := []byte{flags, 0, 0, 0, 15, 0, 0, 7, 0, 0} // Canvas size minus one, 24 bits each
+  chunks := [][]byte{riffChunk("VP8X", header)}
+  chunks = append(chunks, before...)
+  chunks = append(chunks, image)
+  chunks = append(chunks, after...)
+  body := append([]byte("WEBP"), bytes.Join(chunks, nil)...)
+  return append(append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)))...), body...)
+}
+
+func gifExtension(label byte, blocks ...string) []byte {
+  extension := []byte{0x21, label}
+  for _, block := range blocks {
+    extension = append(extension, byte(len(block)))
+    extension = append(extension, block...)
+  }
+  return append(extension, 0)
+}
+
+// testGIFWith insert the extensions before the image descriptor of the encoded GIF
+func testGIFWith(t *testing.T, extensions ...[]byte) []byte {
+  palette := color.Palette{testRed, testBlue}
+  img := image.NewPaletted(image.Rect(0, 0, 16, 8), palette)
+  buf := &bytes.Buffer{}
+  require.NoError(t, gif.Encode(buf, img, nil))
+  encoded := buf.Bytes()
+
+  i := 13 + 3<<(encoded[10]&0x07+1)
+  return bytes.Join(append(append([][]byte{encoded[:i]}, extensions...), encoded[i:]), nil)
+}
+
+func TestStripMetadata_JPEG(t *testing.T) {
+  app0 := jpegSegment(0xE0, "JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")
+  icc := jpegSegment(0xE2, "ICC_PROFILE\x00\x01\x01profile")
+  adobe := jpegSegment(0xEE, "Adobe\x00\x64\x00\x00\x00\x00\x01")
+  exif := jpegSegment(0xE1, "Exif\x00\x00MM\x00\x2A\x00\x00\x00\x08\x00\x00\x00\x00\x00\x00")
+  xmp := jpegSegment(0xE1, "http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>")
+  comment := jpegSegment(0xFE, "comment")
+  iptc := jpegSegment(0xED, "Photoshop 3.0\x00")
+  rotated := orientationSegment(6)
+
+  tests := []struct {
+    name     string
+    data     []byte
+    kept     [][]byte
+    removed  [][]byte
+    rotation uint16
+    wantErr  error
+  }{
+    {
+      name:    "Remove APP1, APP13 and COM",
+      data:    testJPEGWith(t, app0, exif, xmp, iptc, comment),
+      kept:    [][]byte{app0},
+      removed: [][]byte{exif, xmp, iptc, comment},
+    },
+    {
+      name:    "Keep ICC profile and Adobe",
+      data:    testJPEGWith(t, icc, adobe, comment),
+      kept:    [][]byte{icc, adobe},
+      removed: [][]byte{comment},
+    },
+    {
+      name:     "Keep orientation only",
+      data:     testJPEGWith(t, rotated, comment),
+      kept:     [][]byte{rotated},
+      removed:  [][]byte{comment},
+      rotation: 6,
+    },
+    {
+      name:    "Truncated segment",
+      data:    testJPEGWith(t, comment)[:8],
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Truncated before scan",
+      data:    testJPEGWith(t, comment)[:len(comment)+2],
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Not JPEG",
+      data:    []byte("\x89PNG\r\n\x1a\n"),
+      wantErr: ErrImageInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      got, err := StripMetadata(tt.data, FormatJPEG)
+      require.ErrorIs(t, err, tt.wantErr)
+      if tt.wantErr != nil {
+        return
+      }
+      for _, segment := range tt.kept {
+        assert.True(t, bytes.Contains(got, segment))
+      }
+      for _, segment := range tt.removed {
+        assert.False(t, bytes.Contains(got, segment))
+      }
+      assert.Equal(t, tt.rotation, jpegOrientation(got))
+
+      _, err = jpeg.Decode(bytes.NewReader(got))
+      assert.NoError(t, err)
+    })
+  }
+}
+
+func TestStripMetadata_PNG(t *testing.T) {
+  icc := pngChunk("iCCP", "profile\x00\x00compressed")
+  gamma := pngChunk("gAMA", "\x00\x00\xb1\x8f")
+  text := pngChunk("tEXt", "Comment\x00text")
+  compressedText := pngChunk("zTXt", "Comment\x00\x00text")
+  internationalText := pngChunk("iTXt", "XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta/>")
+  exif := pngChunk("eXIf", "MM\x00\x2A\x00\x00\x00\x08")
+  modified := pngChunk("tIME", "\x07\xe8\x01\x01\x00\x00\x00")
+
+  tests := []struct {
+    name    string
+    data    []byte
+    kept    [][]byte
+    removed [][]byte
+    wantErr error
+  }{
+    {
+      name:    "Remove text, EXIF and time",
+      data:    testPNGWith(t, text, compressedText, internationalText, exif, modified),
+      removed: [][]byte{text, compressedText, internationalText, exif, modified},
+    },
+    {
+      name:    "Keep ICC profile and gamma",
+      data:    testPNGWith(t, icc, gamma, text),
+      kept:    [][]byte{icc, gamma},
+      removed: [][]byte{text},
+    },
+    {
+      name:    "Truncated chunk",
+      data:    testPNGWith(t, text)[:40],
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Truncated signature",
+      data:    []byte("\x89PNG"),
+      wantErr: ErrImageInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      got, err := StripMetadata(tt.data, FormatPNG)
+      require.ErrorIs(t, err, tt.wantErr)
+      if tt.wantErr != nil {
+        return
+      }
+      for _, chunk := range tt.kept {
+        assert.True(t, bytes.Contains(got, chunk))
+      }
+      for _, chunk := range tt.removed {
+        assert.False(t, bytes.Contains(got, chunk))
+      }
+      assert.Len(t, got, len(tt.data)-len(bytes.Join(tt.removed, nil)))
+    })
+  }
+}
+
+func TestStripMetadata_WebP(t *testing.T) {
+  const (
+    flagICC  = 0x20
+    flagEXIF = 0x08
+    flagXMP  = 0x04
+  )
+  icc := riffChunk("ICCP", []byte("profile"))
+  exif := riffChunk("EXIF", []byte("MM\x00\x2A\x00\x00\x00\x08"))
+  xmp := riffChunk("XMP ", []byte("<x:xmpmeta/>"))
+
+  tests := []struct {
+    name      string
+    data      []byte
+    kept      [][]byte
+    removed   [][]byte
+    wantFlags byte
+    wantErr   error
+  }{
+    {
+      name:      "Remove EXIF and XMP",
+      data:      testWebPWith(t, flagICC|flagEXIF|flagXMP, [][]byte{icc}, [][]byte{exif, xmp}),
+      kept:      [][]byte{icc},
+      removed:   [][]byte{exif, xmp},
+      wantFlags: flagICC,
+    },
+    {
+      name:      "Without metadata",
+      data:      testWebPWith(t, flagICC, [][]byte{icc}, nil),
+      kept:      [][]byte{icc},
+      wantFlags: flagICC,
+    },
+    {
+      name: "Simple format",
+      data: testEncode(t, testImage(), FormatWebP),
+    },
+    {
+      name:    "Truncated chunk",
+      data:    testWebPWith(t, flagEXIF, nil, [][]byte{exif})[:40],
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Truncated header",
+      data:    []byte("RIFF\x00\x00\x00\x00WEBP"),
+      wantErr: ErrImageInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      got, err := StripMetadata(tt.data, FormatWebP)
+      require.ErrorIs(t, err, tt.wantErr)
+      if tt.wantErr != nil {
+        return
+      }
+      for _, chunk := range tt.kept {
+        assert.True(t, bytes.Contains(got, chunk))
+      }
+      for _, chunk := range tt.removed {
+        assert.False(t, bytes.Contains(got, chunk))
+      }
+      assert.EqualValues(t, len(got)-8, binary.LittleEndian.Uint32(got[4:8]))
+      if string(got[12:16]) == "VP8X" {
+        assert.Equal(t, tt.wantFlags, got[20])
+      }
+
+      img, err := webp.Decode(bytes.NewReader(got))
+      require.NoError(t, err)
+      assert.Equal(t, testImage().Bounds(), img.Bounds())
+    })
+  }
+}
+
+func TestStripMetadata_GIF(t *testing.T) {
+  loop := gifExtension(0xFF, "NETSCAPE2.0", "\x01\x00\x00")
+  comment := gifExtension(0xFE, "comment")
+  xmp := gifExtension(0xFF, "XMP DataXMP", "<x:xmpmeta/>")
+
+  tests := []struct {
+    name    string
+    data    []byte
+    kept    [][]byte
+    removed [][]byte
+    wantErr error
+  }{
+    {
+      name:    "Remove comment and XMP",
+      data:    testGIFWith(t, loop, comment, xmp),
+      kept:    [][]byte{loop},
+      removed: [][]byte{comment, xmp},
+    },
+    {
+      name: "Without metadata",
+      data: testGIFWith(t),
+    },
+    {
+      name:    "Truncated extension",
+      data:    testGIFWith(t, comment)[:13+6+4],
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Missing trailer",
+      data:    bytes.TrimSuffix(testGIFWith(t), []byte{0x3B}),
+      wantErr: ErrImageInvalid,
+    },
+    {
+      name:    "Truncated header",
+      data:    []byte("GIF89a"),
+      wantErr: ErrImageInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      got, err := StripMetadata(tt.data, FormatGIF)
+      require.ErrorIs(t, err, tt.wantErr)
+      if tt.wantErr != nil {
+        return
+      }
+      for _, extension := range tt.kept {
+        assert.True(t, bytes.Contains(got, extension))
+      }
+      for _, extension := range tt.removed {
+        assert.False(t, bytes.Contains(got, extension))
+      }
+
+      _, err = gif.Decode(bytes.NewReader(got))
+      assert.NoError(t, err)
+    })
+  }
+}
+
+func isoBoxBytes(kind string, payload ...[]byte) []byte {
+  body := bytes.Join(payload, nil)
+  return append(append(binary.BigEndian.AppendUint32(nil, uint32(len(body)+8)), kind...), body...)
+}
+
+func isoFullBox(kind string, version byte, payload ...[]byte) []byte {
+  return isoBoxBytes(kind, append([][]byte{{version, 0, 0, 0}}, payload...)...)
+}
+
+func avifItemInfo(id uint16, itemType, contentType string) []byte {
+  payload := append(binary.BigEndian.AppendUint16(nil, id), 0, 0)
+  payload = append(payload, itemType...)
+  payload = append(payload, 0) // Empty item name
+  if itemType == "mime" {
+    payload = append(append(payload, contentType...), 0)
+  }
+  return isoFullBox("infe", 2, payload)
+}
+
+// avifItemLocation item with single extent, the offset and length are 4 bytes
+type avifItemLocation struct {
+  id     uint16
+  method uint16
+  offset uint32
+  length uint32
+}
+
+// testAVIF create AVIF which the items are stored on mdat, or idat for construction method 1. The payloads are
+// placed in order of the items, the offsets of the locations are filled when it is zero.
+func testAVIF(infos [][]byte, locations []avifItemLocation, payloads [][]byte) []byte {
+  ftyp := isoBoxBytes("ftyp", []byte("avif\x00\x00\x00\x00avifmif1miaf"))
+  iinf := isoFullBox("iinf", 0, binary.BigEndian.AppendUint16(nil, uint16(len(infos))), bytes.Join(infos, nil))
+
+  build := func(mdatOffset int) ([]byte, []byte) {
+    iloc := []byte{0x44, 0x00} // Offset and length size, base offset size
+    iloc = binary.BigEndian.AppendUint16(iloc, uint16(len(locations)))
+    var idat []byte
+    offset := mdatOffset
+    for i, location := range locations {
+      iloc = binary.BigEndian.AppendUint16(iloc, location.id)
+      iloc = binary.BigEndian.AppendUint16(iloc, location.method)
+      iloc = append(iloc, 0, 0, 0, 1) // Data reference index and extent count
+      itemOffset := location.offset
+      if itemOffset == 0 && location.method == 0 {
+        itemOffset = uint32(offset)
+        offset += len(payloads[i])
+      } else if itemOffset == 0 {
+        itemOffset = uint32(len(idat))
+        idat = append(idat, payloads[i]...)
+      }
+      length := location.length
+      if length == 0 {
+        length = uint32(len(payloads[i]))
+      }
+      iloc = binary.BigEndian.AppendUint32(iloc, itemOffset)
+      iloc = binary.BigEndian.AppendUint32(iloc, length)
+    }
+
+    children := [][]byte{isoFullBox("hdlr", 0, make([]byte, 4), []byte("pict"), make([]byte, 13)), iinf, isoFullBox("iloc", 1, iloc)}
+    if len(idat) != 0 {
+      children = append(children, isoBoxBytes("idat", idat))
+    }
+    meta := isoFullBox("meta", 0, children...)
+
+    var mdat [][]byte
+    for i, location := range locations {
+      if location.method == 0 {
+        mdat = append(mdat, payloads[i])
+      }
+    }
+    return meta, isoBoxBytes("mdat", mdat...)
+  }
+
+  // The offset of mdat is only known after the size of meta is known, which is not changed by the offsets
+  meta, _ := build(0)
+  meta, mdat := build(len(ftyp) + len(meta) + 8)
+  return bytes.Join([][]byte{ftyp, meta, mdat}, nil)
+}
+
+func TestStripMetadata_AVIF(t *testing.T) {
+  coded := []byte("coded image payload")
+  exif := []byte("\x00\x00\x00\x00MM\x00\x2A\x00\x00\x00\x08")
+  xmp := []byte("<x:xmpmeta/>")
+  other := []byte("other mime payload")
+
+  infos := [][]byte{
+    avifItemInfo(1, "av01", ""),
+    avifItemInfo(2, "Exif", ""),
+    avifItemInfo(3, "mime", xmpContentType),
+    avifItemInfo(4, "mime", "text/plain"),
+  }
+
+  tests := []struct {
+    name      string
+    infos     [][]byte
+    locations []avifItemLocation
+    kept      [][]byte
+    removed   [][]byte
+    wantErr   error
+  }{
+    {
+      name:      "Clear EXIF and XMP on mdat",
+      infos:     infos,
+      locations: []avifItemLocation{{id: 1}, {id: 2}, {id: 3}, {id: 4}},
+      kept:      [][]byte{coded, other},
+      removed:   [][]byte{exif, xmp},
+    },
+    {
+      name:      "Clear EXIF and XMP on idat",
+      infos:     infos,
+      locations: []avifItemLocation{{id: 1}, {id: 2, method: 1}, {id: 3, method: 1}, {id: 4}},
+      kept:      [][]byte{coded, other},
+      removed:   [][]byte{exif, xmp},
+    },
+    {
+      name:      "Without metadata",
+      infos:     infos[:1],
+      locations: []avifItemLocation{{id: 1}},
+      kept:      [][]byte{coded},
+    },
+    {
+      name:      "Metadata constructed from other item",
+      infos:     infos,
+      locations: []avifItemLocation{{id: 1}, {id: 2, method: 2}, {id: 3}, {id: 4}},
+      wantErr:   ErrImageInvalid,
+    },
+    {
+      name:      "Metadata exceeding the file",
+      infos:     infos,
+      locations: []avifItemLocation{{id: 1}, {id: 2, length: 1 << 20}, {id: 3}, {id: 4}},
+      wantErr:   ErrImageInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      data := testAVIF(tt.infos, tt.locations, [][]byte{coded, exif, xmp, other}[:len(tt.locations)])
+      for _, payload := range append(tt.kept, tt.removed...) {
+        require.True(t, bytes.Contains(data, payload))
+      }
+
+      got, err := StripMetadata(data, FormatAVIF)
+      require.ErrorIs(t, err, tt.wantErr)
+      if tt.wantErr != nil {
+        return
+      }
+      // The items are cleared in place, so the location of the other items are still valid
+      assert.Len(t, got, len(data))
+      for _, payload := range tt.kept {
+        assert.True(t, bytes.Contains(got, payload))
+      }
+      for _, payload := range tt.removed {
+        assert.False(t, bytes.Contains(got, payload))
+      }
+      format, err := DetectFormat(got)
+      assert.NoError(t, err)
+      assert.EqualValues(t, FormatAVIF, format)
+    })
+  }
+
+  for _, length := range []int{0, 12, 40, 80} {
+    data := testAVIF(infos, []avifItemLocation{{id: 1}, {id: 2}, {id: 3}, {id: 4}}, [][]byte{coded, exif, xmp, other})
+    _, err := StripMetadata(data[:length], FormatAVIF)
+    assert.ErrorIs(t, err, ErrImageInvalid, "truncated to %d bytes", length)
+  }
+}
@@ -1,6 +1,10 @@
 package file
 
-import "manga-explorer/internal/util/opt"
+import (
+  "crypto/sha256"
+  "encoding/hex"
+  "manga-explorer/internal/util/opt"
+)
 
 type Name string
 
@@ -11,3 +15,18 @@ func (f Name) String() string {
 var NullName = opt.Null[Name]()
 
 var NoFile = Name(" ")
+
+// ContentName create the name of the file from SHA-256 hash of the content, so the same content is always stored under
+// the same name regardless of the extension of the uploaded file
+func ContentName(format Format, data []byte) Name {
+  sum := sha256.Sum256(data)
+  return format.Canonical().Filename(hex.EncodeToString(sum[:]))
+}
+
+// UploadInfo result of the uploaded file, the stored file could have different format and size after it is processed
+type UploadInfo struct {
+  Name         Name
+  Format       Format
+  OriginalSize int64
+  Size         int64
+}
@@ -0,0 +1,60 @@
+package file
+
+import (
+  "bytes"
+  "errors"
+  "manga-explorer/internal/util"
+)
+
+// WebPMode how the uploaded image is re-encoded into WebP. Both modes produce lossless WebP (VP8L), there is no lossy
+// (VP8) encoder, the near-lossless mode only reduces the precision of the colors, so the image is still much larger
+// than lossy WebP of the same quality.
+type WebPMode string
+
+const (
+  WebPNone         WebPMode = ""
+  WebPLossless              = "lossless"
+  WebPNearLossless          = "near-lossless"
+)
+
+func (m WebPMode) Validate() bool {
+  return util.IsOneOf(m, WebPNone, WebPLossless, WebPNearLossless)
+}
+
+// ProcessOption processing applied to the uploaded image, the quality is only used by near-lossless mode
+type ProcessOption struct {
+  WebP    WebPMode
+  Quality int
+}
+
+// ProcessImage strip the metadata of the image and re-encode it into WebP when it is enabled. Only JPEG and PNG are
+// re-encoded, except rotated JPEG because the orientation is not applied when decoding. The result is only used when
+// it is smaller than the stripped image, so the returned format could still be the original one.
+func ProcessImage(data []byte, format Format, option ProcessOption) ([]byte, Format, error) {
+  stripped, err := StripMetadata(data, format)
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+  if option.WebP == WebPNone || !util.IsOneOf(format, FormatJPG, FormatJPEG, FormatPNG) || jpegOrientation(stripped) > 1 {
+    return stripped, format, nil
+  }
+
+  img, err := decodeImage(stripped)
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+
+  quality := 100
+  if option.WebP == WebPNearLossless {
+    quality = option.Quality
+  }
+  encoded := bytes.Buffer{}
+  err = EncodeWebP(&encoded, img, quality)
+  if errors.Is(err, ErrWebPTooLarge) || (err == nil && encoded.Len() >= len(stripped)) {
+    return stripped, format, nil
+  }
+  if err != nil {
+    return nil, FormatUnknown, err
+  }
+  return encoded.Bytes(), FormatWebP, nil
+}
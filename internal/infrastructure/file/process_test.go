@@ -0,0 +1,87 @@
+package file
+
+import (
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+)
+
+func TestProcessImage(t *testing.T) {
+  // Image with large flat area, so the lossless WebP is always smaller than the JPEG
+  encoded := testEncode(t, testQuadrantImage(256, 128), FormatJPEG)
+  rotated := append(append(append([]byte{}, encoded[:2]...), orientationSegment(6)...), encoded[2:]...)
+  png := testEncode(t, testQuadrantImage(256, 128), FormatPNG)
+
+  tests := []struct {
+    name            string
+    data            []byte
+    format          Format
+    option          ProcessOption
+    wantFormat      Format
+    wantOrientation uint16
+  }{
+    {
+      name:       "WebP disabled",
+      data:       encoded,
+      format:     FormatJPEG,
+      option:     ProcessOption{WebP: WebPNone},
+      wantFormat: FormatJPEG,
+    },
+    {
+      name:       "JPEG is re-encoded",
+      data:       encoded,
+      format:     FormatJPEG,
+      option:     ProcessOption{WebP: WebPLossless},
+      wantFormat: FormatWebP,
+    },
+    {
+      name:       "PNG is re-encoded",
+      data:       png,
+      format:     FormatPNG,
+      option:     ProcessOption{WebP: WebPNearLossless, Quality: 80},
+      wantFormat: FormatWebP,
+    },
+    {
+      name:            "Rotated JPEG is not re-encoded",
+      data:            rotated,
+      format:          FormatJPEG,
+      option:          ProcessOption{WebP: WebPLossless},
+      wantFormat:      FormatJPEG,
+      wantOrientation: 6,
+    },
+    {
+      name:            "Rotated JPEG is not re-encoded on near-lossless",
+      data:            rotated,
+      format:          FormatJPEG,
+      option:          ProcessOption{WebP: WebPNearLossless, Quality: 50},
+      wantFormat:      FormatJPEG,
+      wantOrientation: 6,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      got, format, err := ProcessImage(tt.data, tt.format, tt.option)
+      require.NoError(t, err)
+      assert.Equal(t, tt.wantFormat, format)
+
+      detected, err := DetectFormat(got)
+      require.NoError(t, err)
+      assert.True(t, detected.Equal(tt.wantFormat))
+      // The orientation must be kept when the image is not re-encoded, otherwise it is displayed wrongly
+      assert.Equal(t, tt.wantOrientation, jpegOrientation(got))
+    })
+  }
+}
+
+func TestProcessImage_TooLarge(t *testing.T) {
+  data := testHugePNG(t)
+  _, _, err := ProcessImage(data, FormatPNG, ProcessOption{WebP: WebPLossless})
+  assert.ErrorIs(t, err, ErrImageTooLarge)
+
+  // The image is not decoded when it is kept
+  got, format, err := ProcessImage(data, FormatPNG, ProcessOption{WebP: WebPNone})
+  require.NoError(t, err)
+  assert.Equal(t, Format(FormatPNG), format)
+  assert.Equal(t, data, got)
+}
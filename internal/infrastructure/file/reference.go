@@ -0,0 +1,30 @@
+package file
+
+import (
+  "github.com/uptrace/bun"
+  "time"
+)
+
+// Reference number of records which use the stored file. The file is named by the hash of the content, so uploading
+// the same content again only adds the reference and the file is deleted when the last reference is released.
+type Reference struct {
+  bun.BaseModel `bun:"table:file_references,alias:ref"`
+
+  AssetType AssetType `bun:",pk,type:text"`
+  Name      Name      `bun:",pk,type:text"`
+  RefCount  uint32    `bun:",notnull"`
+
+  UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"` // Last time the reference is acquired
+  CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+func NewReference(types AssetType, name Name) Reference {
+  currentTime := time.Now()
+  return Reference{
+    AssetType: types,
+    Name:      name,
+    RefCount:  1,
+    UpdatedAt: currentTime,
+    CreatedAt: currentTime,
+  }
+}
@@ -0,0 +1,244 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package repository
+
+import (
+	file "manga-explorer/internal/infrastructure/file"
+
+	mock "github.com/stretchr/testify/mock"
+	bun "github.com/uptrace/bun"
+
+	repository "manga-explorer/internal/infrastructure/file/repository"
+
+	time "time"
+)
+
+// ReferenceMock is an autogenerated mock type for the IReference type
+type ReferenceMock struct {
+	mock.Mock
+}
+
+type ReferenceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReferenceMock) EXPECT() *ReferenceMock_Expecter {
+	return &ReferenceMock_Expecter{mock: &_m.Mock}
+}
+
+// Acquire provides a mock function with given fields: types, name, store
+func (_m *ReferenceMock) Acquire(types file.AssetType, name file.Name, store func() error) error {
+	ret := _m.Called(types, name, store)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name, func() error) error); ok {
+		r0 = rf(types, name, store)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReferenceMock_Acquire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acquire'
+type ReferenceMock_Acquire_Call struct {
+	*mock.Call
+}
+
+// Acquire is a helper method to define mock.On call
+//   - types file.AssetType
+//   - name file.Name
+//   - store func() error
+func (_e *ReferenceMock_Expecter) Acquire(types interface{}, name interface{}, store interface{}) *ReferenceMock_Acquire_Call {
+	return &ReferenceMock_Acquire_Call{Call: _e.mock.On("Acquire", types, name, store)}
+}
+
+func (_c *ReferenceMock_Acquire_Call) Run(run func(types file.AssetType, name file.Name, store func() error)) *ReferenceMock_Acquire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *ReferenceMock_Acquire_Call) Return(_a0 error) *ReferenceMock_Acquire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReferenceMock_Acquire_Call) RunAndReturn(run func(file.AssetType, file.Name, func() error) error) *ReferenceMock_Acquire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Collect provides a mock function with given fields: types, name, before, remove
+func (_m *ReferenceMock) Collect(types file.AssetType, name file.Name, before time.Time, remove func() error) (bool, error) {
+	ret := _m.Called(types, name, before, remove)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collect")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name, time.Time, func() error) (bool, error)); ok {
+		return rf(types, name, before, remove)
+	}
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name, time.Time, func() error) bool); ok {
+		r0 = rf(types, name, before, remove)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(file.AssetType, file.Name, time.Time, func() error) error); ok {
+		r1 = rf(types, name, before, remove)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReferenceMock_Collect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collect'
+type ReferenceMock_Collect_Call struct {
+	*mock.Call
+}
+
+// Collect is a helper method to define mock.On call
+//   - types file.AssetType
+//   - name file.Name
+//   - before time.Time
+//   - remove func() error
+func (_e *ReferenceMock_Expecter) Collect(types interface{}, name interface{}, before interface{}, remove interface{}) *ReferenceMock_Collect_Call {
+	return &ReferenceMock_Collect_Call{Call: _e.mock.On("Collect", types, name, before, remove)}
+}
+
+func (_c *ReferenceMock_Collect_Call) Run(run func(types file.AssetType, name file.Name, before time.Time, remove func() error)) *ReferenceMock_Collect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name), args[2].(time.Time), args[3].(func() error))
+	})
+	return _c
+}
+
+func (_c *ReferenceMock_Collect_Call) Return(_a0 bool, _a1 error) *ReferenceMock_Collect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReferenceMock_Collect_Call) RunAndReturn(run func(file.AssetType, file.Name, time.Time, func() error) (bool, error)) *ReferenceMock_Collect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: types, name, remove
+func (_m *ReferenceMock) Release(types file.AssetType, name file.Name, remove func() error) error {
+	ret := _m.Called(types, name, remove)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name, func() error) error); ok {
+		r0 = rf(types, name, remove)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReferenceMock_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type ReferenceMock_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - types file.AssetType
+//   - name file.Name
+//   - remove func() error
+func (_e *ReferenceMock_Expecter) Release(types interface{}, name interface{}, remove interface{}) *ReferenceMock_Release_Call {
+	return &ReferenceMock_Release_Call{Call: _e.mock.On("Release", types, name, remove)}
+}
+
+func (_c *ReferenceMock_Release_Call) Run(run func(types file.AssetType, name file.Name, remove func() error)) *ReferenceMock_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *ReferenceMock_Release_Call) Return(_a0 error) *ReferenceMock_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReferenceMock_Release_Call) RunAndReturn(run func(file.AssetType, file.Name, func() error) error) *ReferenceMock_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *ReferenceMock) WithTx(tx bun.IDB) repository.IReference {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.IReference
+	if rf, ok := ret.Get(0).(func(bun.IDB) repository.IReference); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.IReference)
+		}
+	}
+
+	return r0
+}
+
+// ReferenceMock_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type ReferenceMock_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx bun.IDB
+func (_e *ReferenceMock_Expecter) WithTx(tx interface{}) *ReferenceMock_WithTx_Call {
+	return &ReferenceMock_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *ReferenceMock_WithTx_Call) Run(run func(tx bun.IDB)) *ReferenceMock_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bun.IDB))
+	})
+	return _c
+}
+
+func (_c *ReferenceMock_WithTx_Call) Return(_a0 repository.IReference) *ReferenceMock_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReferenceMock_WithTx_Call) RunAndReturn(run func(bun.IDB) repository.IReference) *ReferenceMock_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReferenceMock creates a new instance of ReferenceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReferenceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReferenceMock {
+	mock := &ReferenceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/infrastructure/file"
+  "time"
+)
+
+type IReference interface {
+  // Acquire add the reference of the file, store is called to write the file when it is the first reference. The
+  // reference is not added when store returns error.
+  Acquire(types file.AssetType, name file.Name, store func() error) error
+  // Release remove the reference of the file, remove is called to delete the file when it is the last reference or the
+  // file doesn't have reference at all, e.g. uploaded before the references are counted. The reference is kept when
+  // remove returns error.
+  Release(types file.AssetType, name file.Name, remove func() error) error
+  // Collect remove the reference of the file which is no longer used by any record regardless of the count and call
+  // remove to delete the file. It is skipped when the reference is acquired after the time, because the record using
+  // it may not be inserted yet. It returns false when the file is skipped.
+  Collect(types file.AssetType, name file.Name, before time.Time, remove func() error) (bool, error)
+  // WithTx get the repository which runs inside the transaction, so the changes of the references are rolled back
+  // together with the transaction
+  WithTx(tx bun.IDB) IReference
+}
@@ -0,0 +1,345 @@
+package s3
+
+import (
+  "bytes"
+  "context"
+  "crypto/hmac"
+  "crypto/sha256"
+  "encoding/hex"
+  "encoding/xml"
+  "errors"
+  "fmt"
+  "io"
+  "net/http"
+  "net/url"
+  "sort"
+  "strconv"
+  "strings"
+  "time"
+)
+
+var (
+  ErrNotFound      = errors.New("object not found")
+  ErrInvalidConfig = errors.New("s3 endpoint and bucket are required")
+)
+
+const (
+  emptyPayloadHash   = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+  maxPresignDuration = 7 * 24 * time.Hour
+)
+
+// Object summary of the stored object
+type Object struct {
+  Key          string    `xml:"Key"`
+  Size         int64     `xml:"Size"`
+  LastModified time.Time `xml:"LastModified"`
+}
+
+type Config struct {
+  Endpoint  string // e.g. https://s3.us-east-1.amazonaws.com or http://localhost:9000
+  Region    string
+  Bucket    string
+  AccessKey string
+  SecretKey string
+  // PathStyle use http://endpoint/bucket/key instead of http://bucket.endpoint/key, most S3-compatible storage like
+  // MinIO needs it
+  PathStyle bool
+}
+
+// NewClient create minimal S3 client which only supports the object operations needed to store the files, the
+// requests are signed with AWS Signature Version 4
+func NewClient(config Config, client *http.Client) (*Client, error) {
+  endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+  if err != nil || len(endpoint.Host) == 0 || len(config.Bucket) == 0 {
+    return nil, ErrInvalidConfig
+  }
+  if len(config.Region) == 0 {
+    config.Region = "us-east-1"
+  }
+  if client == nil {
+    client = http.DefaultClient
+  }
+
+  return &Client{
+    config:   config,
+    endpoint: endpoint,
+    client:   client,
+    now:      time.Now,
+  }, nil
+}
+
+type Client struct {
+  config   Config
+  endpoint *url.URL
+  client   *http.Client
+  now      func() time.Time
+}
+
+// BucketURL get the url of the bucket without trailing slash
+func (c *Client) BucketURL() string {
+  if c.config.PathStyle {
+    return fmt.Sprintf("%s://%s/%s", c.endpoint.Scheme, c.endpoint.Host, c.config.Bucket)
+  }
+  return fmt.Sprintf("%s://%s.%s", c.endpoint.Scheme, c.config.Bucket, c.endpoint.Host)
+}
+
+// ObjectURL get the url of the object, the object is only accessible when the bucket allows public read
+func (c *Client) ObjectURL(key string) string {
+  return c.BucketURL() + "/" + escape(key, false)
+}
+
+// PutObject upload the object, the existing object with the same key will be replaced
+func (c *Client) PutObject(ctx context.Context, key string, body []byte, contentType string, cacheControl string) error {
+  header := http.Header{}
+  header.Set("Content-Type", contentType)
+  if len(cacheControl) > 0 {
+    header.Set("Cache-Control", cacheControl)
+  }
+  response, err := c.do(ctx, http.MethodPut, c.ObjectURL(key), header, body)
+  if err != nil {
+    return err
+  }
+  return response.Body.Close()
+}
+
+// GetObject download the object, the caller should close the reader. It will return ErrNotFound when the object
+// doesn't exist
+func (c *Client) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+  response, err := c.do(ctx, http.MethodGet, c.ObjectURL(key), http.Header{}, nil)
+  if err != nil {
+    return nil, err
+  }
+  return response.Body, nil
+}
+
+// CopyObject copy the object into another key of the same bucket, the metadata like content type is copied as well
+func (c *Client) CopyObject(ctx context.Context, source, destination string) error {
+  header := http.Header{}
+  header.Set("X-Amz-Copy-Source", "/"+c.config.Bucket+"/"+escape(source, false))
+  response, err := c.do(ctx, http.MethodPut, c.ObjectURL(destination), header, nil)
+  if err != nil {
+    return err
+  }
+  defer response.Body.Close()
+
+  // The error could happen while the object is copied, which is returned on the body of 200 response
+  result := struct {
+    XMLName xml.Name
+    Code    string `xml:"Code"`
+    Message string `xml:"Message"`
+  }{}
+  err = xml.NewDecoder(io.LimitReader(response.Body, 4096)).Decode(&result)
+  if err != nil {
+    return err
+  }
+  if result.XMLName.Local == "Error" {
+    return fmt.Errorf("s3: copy %s failed: %s %s", source, result.Code, result.Message)
+  }
+  return nil
+}
+
+// DeleteObject delete the object, deleting object which doesn't exist is not an error
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+  response, err := c.do(ctx, http.MethodDelete, c.ObjectURL(key), http.Header{}, nil)
+  if errors.Is(err, ErrNotFound) {
+    return nil
+  }
+  if err != nil {
+    return err
+  }
+  return response.Body.Close()
+}
+
+// ListObjects list all objects which key starts with the prefix ordered by the key, fn is called for each object and
+// the listing is stopped when it returns error
+func (c *Client) ListObjects(ctx context.Context, prefix string, fn func(object Object) error) error {
+  token := ""
+  for {
+    // The query should be sorted by the name
+    query := []string{}
+    if len(token) > 0 {
+      query = append(query, "continuation-token="+escape(token, true))
+    }
+    query = append(query, "list-type=2", "prefix="+escape(prefix, true))
+
+    response, err := c.do(ctx, http.MethodGet, c.BucketURL()+"/?"+strings.Join(query, "&"), http.Header{}, nil)
+    if err != nil {
+      return err
+    }
+    result := struct {
+      Contents              []Object `xml:"Contents"`
+      IsTruncated           bool     `xml:"IsTruncated"`
+      NextContinuationToken string   `xml:"NextContinuationToken"`
+    }{}
+    err = xml.NewDecoder(response.Body).Decode(&result)
+    response.Body.Close()
+    if err != nil {
+      return err
+    }
+
+    for _, object := range result.Contents {
+      if err = fn(object); err != nil {
+        return err
+      }
+    }
+    if !result.IsTruncated || len(result.NextContinuationToken) == 0 {
+      return nil
+    }
+    token = result.NextContinuationToken
+  }
+}
+
+func (c *Client) do(ctx context.Context, method, rawURL string, header http.Header, body []byte) (*http.Response, error) {
+  request, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+  if err != nil {
+    return nil, err
+  }
+  request.Header = header
+  request.ContentLength = int64(len(body))
+  if body == nil {
+    request.Body = nil
+  }
+  c.sign(request, body)
+
+  response, err := c.client.Do(request)
+  if err != nil {
+    return nil, err
+  }
+  if response.StatusCode/100 == 2 {
+    return response, nil
+  }
+  defer response.Body.Close()
+
+  if response.StatusCode == http.StatusNotFound {
+    return nil, ErrNotFound
+  }
+  apiError := struct {
+    Code    string `xml:"Code"`
+    Message string `xml:"Message"`
+  }{}
+  _ = xml.NewDecoder(io.LimitReader(response.Body, 4096)).Decode(&apiError)
+  return nil, fmt.Errorf("s3: %s %s failed with status %d: %s %s", method, request.URL.Path, response.StatusCode, apiError.Code, apiError.Message)
+}
+
+// sign add the authorization header of AWS Signature Version 4.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (c *Client) sign(request *http.Request, body []byte) {
+  now := c.now().UTC()
+  amzDate := now.Format("20060102T150405Z")
+
+  payloadHash := emptyPayloadHash
+  if len(body) > 0 {
+    payloadHash = hashHex(body)
+  }
+  request.Header.Set("X-Amz-Date", amzDate)
+  request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+  canonicalRequest, signedHeaders := headerCanonicalRequest(request, payloadHash)
+
+  scope := c.scope(now.Format("20060102"))
+  request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+    c.config.AccessKey, scope, signedHeaders, c.signature(amzDate, scope, canonicalRequest)))
+}
+
+// headerCanonicalRequest create the canonical request of the request which is signed on the header, all headers are
+// signed. It returns the canonical request and the signed headers.
+func headerCanonicalRequest(request *http.Request, payloadHash string) (string, string) {
+  // Canonical headers should be sorted by the lowercase name
+  headers := map[string]string{"host": request.URL.Host}
+  for name, values := range request.Header {
+    headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+  }
+  names := make([]string, 0, len(headers))
+  for name := range headers {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+
+  canonicalHeaders := strings.Builder{}
+  for _, name := range names {
+    canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+  }
+  signedHeaders := strings.Join(names, ";")
+
+  return strings.Join([]string{
+    request.Method,
+    request.URL.EscapedPath(),
+    request.URL.RawQuery,
+    canonicalHeaders.String(),
+    signedHeaders,
+    payloadHash,
+  }, "\n"), signedHeaders
+}
+
+// PresignGetObject create url to download the object without credential, the url is valid from the start time until
+// the duration which is at most 7 days.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func (c *Client) PresignGetObject(key string, start time.Time, duration time.Duration) string {
+  start = start.UTC()
+  amzDate := start.Format("20060102T150405Z")
+  scope := c.scope(start.Format("20060102"))
+
+  // The query should be sorted by the name
+  query := strings.Join([]string{
+    "X-Amz-Algorithm=AWS4-HMAC-SHA256",
+    "X-Amz-Credential=" + escape(c.config.AccessKey+"/"+scope, true),
+    "X-Amz-Date=" + amzDate,
+    "X-Amz-Expires=" + strconv.Itoa(int(min(duration, maxPresignDuration).Seconds())),
+    "X-Amz-SignedHeaders=host",
+  }, "&")
+
+  canonicalRequest := c.presignCanonicalRequest(key, query)
+  return fmt.Sprintf("%s?%s&X-Amz-Signature=%s", c.ObjectURL(key), query, c.signature(amzDate, scope, canonicalRequest))
+}
+
+// presignCanonicalRequest create the canonical request of the presigned GET, only the host is signed
+func (c *Client) presignCanonicalRequest(key string, query string) string {
+  objectURL, _ := url.Parse(c.ObjectURL(key))
+  return strings.Join([]string{
+    http.MethodGet,
+    objectURL.EscapedPath(),
+    query,
+    "host:" + objectURL.Host + "\n",
+    "host",
+    "UNSIGNED-PAYLOAD",
+  }, "\n")
+}
+
+func (c *Client) scope(date string) string {
+  return date + "/" + c.config.Region + "/s3/aws4_request"
+}
+
+func (c *Client) signature(amzDate, scope, canonicalRequest string) string {
+  stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+  key := hmacSHA256([]byte("AWS4"+c.config.SecretKey), scope[:8])
+  key = hmacSHA256(key, c.config.Region)
+  key = hmacSHA256(key, "s3")
+  key = hmacSHA256(key, "aws4_request")
+  return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hashHex(data []byte) string {
+  sum := sha256.Sum256(data)
+  return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+  mac := hmac.New(sha256.New, key)
+  mac.Write([]byte(data))
+  return mac.Sum(nil)
+}
+
+// escape encode the string with only the unreserved characters as expected by S3, the slash of the object key is kept
+func escape(value string, encodeSlash bool) string {
+  builder := strings.Builder{}
+  for _, char := range []byte(value) {
+    switch {
+    case 'a' <= char && char <= 'z', 'A' <= char && char <= 'Z', '0' <= char && char <= '9',
+      char == '-', char == '_', char == '.', char == '~', char == '/' && !encodeSlash:
+      builder.WriteByte(char)
+    default:
+      fmt.Fprintf(&builder, "%%%02X", char)
+    }
+  }
+  return builder.String()
+}
@@ -0,0 +1,153 @@
+package s3
+
+import (
+  "context"
+  "fmt"
+  "io"
+  "manga-explorer/internal/infrastructure/file/s3/s3test"
+  "net/http"
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+)
+
+func testClient(t *testing.T, server *s3test.Server, bucket string) *Client {
+  client, err := NewClient(Config{
+    Endpoint:  server.URL,
+    Bucket:    bucket,
+    AccessKey: s3test.AccessKey,
+    SecretKey: s3test.SecretKey,
+    PathStyle: true,
+  }, server.Client())
+  require.NoError(t, err)
+  return client
+}
+
+func TestClient_Objects(t *testing.T) {
+  server := s3test.NewServer()
+  defer server.Close()
+  server.CreateBucket("bucket", false)
+  client := testClient(t, server, "bucket")
+  ctx := context.Background()
+
+  // Put
+  const key = "mangas/name with space+plus.webp"
+  require.NoError(t, client.PutObject(ctx, key, []byte("content"), "image/webp", "max-age=60"))
+  object, ok := server.Object("bucket", key)
+  require.True(t, ok)
+  assert.Equal(t, []byte("content"), object.Data)
+  assert.Equal(t, "image/webp", object.ContentType)
+  assert.Equal(t, "max-age=60", object.CacheControl)
+
+  // Get
+  reader, err := client.GetObject(ctx, key)
+  require.NoError(t, err)
+  data, err := io.ReadAll(reader)
+  require.NoError(t, reader.Close())
+  require.NoError(t, err)
+  assert.Equal(t, []byte("content"), data)
+
+  _, err = client.GetObject(ctx, "mangas/missing.webp")
+  assert.ErrorIs(t, err, ErrNotFound)
+
+  // Copy
+  require.NoError(t, client.CopyObject(ctx, key, "mangas/copy.webp"))
+  copied, ok := server.Object("bucket", "mangas/copy.webp")
+  require.True(t, ok)
+  assert.Equal(t, object.Data, copied.Data)
+  assert.Equal(t, object.ContentType, copied.ContentType)
+  assert.Error(t, client.CopyObject(ctx, "mangas/missing.webp", "mangas/other.webp"))
+
+  // Delete
+  require.NoError(t, client.DeleteObject(ctx, key))
+  _, ok = server.Object("bucket", key)
+  assert.False(t, ok)
+  assert.NoError(t, client.DeleteObject(ctx, key))
+  assert.Equal(t, []string{"mangas/copy.webp"}, server.Keys("bucket"))
+}
+
+func TestClient_ListObjects(t *testing.T) {
+  server := s3test.NewServer()
+  defer server.Close()
+  server.CreateBucket("bucket", false)
+  server.PageSize = 2
+  client := testClient(t, server, "bucket")
+
+  modified := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+  var want []string
+  for i := 0; i < 5; i++ {
+    key := fmt.Sprintf("covers/%d.png", i)
+    want = append(want, key)
+    server.PutObject("bucket", key, s3test.Object{Data: []byte("data"), LastModified: modified})
+  }
+  server.PutObject("bucket", "mangas/0.png", s3test.Object{Data: []byte("data")})
+
+  var got []string
+  err := client.ListObjects(context.Background(), "covers/", func(object Object) error {
+    got = append(got, object.Key)
+    assert.EqualValues(t, 4, object.Size)
+    assert.True(t, modified.Equal(object.LastModified))
+    return nil
+  })
+  require.NoError(t, err)
+  assert.Equal(t, want, got)
+
+  // The listing is stopped on error
+  stop := fmt.Errorf("stop")
+  count := 0
+  err = client.ListObjects(context.Background(), "covers/", func(object Object) error {
+    count++
+    return stop
+  })
+  assert.ErrorIs(t, err, stop)
+  assert.Equal(t, 1, count)
+}
+
+func TestClient_PresignGetObject_Request(t *testing.T) {
+  server := s3test.NewServer()
+  defer server.Close()
+  server.CreateBucket("private", false)
+  server.PutObject("private", "mangas/page.png", s3test.Object{Data: []byte("page")})
+  client := testClient(t, server, "private")
+
+  // Private object could not be read without credential
+  response, err := server.Client().Get(client.ObjectURL("mangas/page.png"))
+  require.NoError(t, err)
+  response.Body.Close()
+  assert.Equal(t, http.StatusForbidden, response.StatusCode)
+
+  start := time.Now().Add(-time.Minute)
+  response, err = server.Client().Get(client.PresignGetObject("mangas/page.png", start, time.Hour))
+  require.NoError(t, err)
+  data, err := io.ReadAll(response.Body)
+  response.Body.Close()
+  require.NoError(t, err)
+  assert.Equal(t, http.StatusOK, response.StatusCode)
+  assert.Equal(t, []byte("page"), data)
+
+  // Expired url
+  response, err = server.Client().Get(client.PresignGetObject("mangas/page.png", start.Add(-2*time.Hour), time.Hour))
+  require.NoError(t, err)
+  response.Body.Close()
+  assert.Equal(t, http.StatusForbidden, response.StatusCode)
+}
+
+func TestClient_InvalidCredential(t *testing.T) {
+  server := s3test.NewServer()
+  defer server.Close()
+  server.CreateBucket("bucket", false)
+  client, err := NewClient(Config{
+    Endpoint:  server.URL,
+    Bucket:    "bucket",
+    AccessKey: "other",
+    SecretKey: s3test.SecretKey,
+    PathStyle: true,
+  }, server.Client())
+  require.NoError(t, err)
+
+  err = client.PutObject(context.Background(), "covers/a.png", []byte("data"), "image/png", "")
+  assert.ErrorContains(t, err, "InvalidAccessKeyId")
+  assert.Empty(t, server.Keys("bucket"))
+}
@@ -0,0 +1,271 @@
+// Package s3test provides in-memory S3-compatible server for testing the S3 client and the storage which uses it.
+// Only the path style url is supported.
+package s3test
+
+import (
+  "crypto/sha256"
+  "encoding/hex"
+  "encoding/xml"
+  "io"
+  "net/http"
+  "net/http/httptest"
+  "net/url"
+  "sort"
+  "strconv"
+  "strings"
+  "sync"
+  "time"
+)
+
+const (
+  AccessKey = "access_key"
+  SecretKey = "secret_key"
+)
+
+// Object stored object on the fake server
+type Object struct {
+  Data         []byte
+  ContentType  string
+  CacheControl string
+  LastModified time.Time
+}
+
+type bucket struct {
+  public  bool
+  objects map[string]Object
+}
+
+// Server fake S3 server, the objects of public bucket could be read without credential like a bucket with public read
+// policy while the private bucket only allows signed requests or presigned url. The signature itself is not verified,
+// only the credential and the payload hash, so the signing should be tested separately.
+type Server struct {
+  *httptest.Server
+  // PageSize maximum number of objects returned by each list request
+  PageSize int
+  // Now current time of the server which is used to check the expiry of presigned url
+  Now func() time.Time
+
+  mutex   sync.Mutex
+  buckets map[string]*bucket
+}
+
+// NewServer start the fake server, the caller should close it
+func NewServer() *Server {
+  server := &Server{
+    PageSize: 1000,
+    Now:      time.Now,
+    buckets:  map[string]*bucket{},
+  }
+  server.Server = httptest.NewServer(server)
+  return server
+}
+
+// CreateBucket create empty bucket, public bucket allows anyone to read the objects
+func (s *Server) CreateBucket(name string, public bool) {
+  s.mutex.Lock()
+  defer s.mutex.Unlock()
+  s.buckets[name] = &bucket{public: public, objects: map[string]Object{}}
+}
+
+// Object get the stored object
+func (s *Server) Object(bucketName, key string) (Object, bool) {
+  s.mutex.Lock()
+  defer s.mutex.Unlock()
+  current, ok := s.buckets[bucketName]
+  if !ok {
+    return Object{}, false
+  }
+  object, ok := current.objects[key]
+  return object, ok
+}
+
+// PutObject store the object directly without request
+func (s *Server) PutObject(bucketName, key string, object Object) {
+  s.mutex.Lock()
+  defer s.mutex.Unlock()
+  s.buckets[bucketName].objects[key] = object
+}
+
+// Keys get the keys of all objects in the bucket ordered by the key
+func (s *Server) Keys(bucketName string) []string {
+  s.mutex.Lock()
+  defer s.mutex.Unlock()
+  return s.keys(s.buckets[bucketName], "")
+}
+
+func (s *Server) keys(current *bucket, prefix string) []string {
+  keys := []string{}
+  if current == nil {
+    return keys
+  }
+  for key := range current.objects {
+    if strings.HasPrefix(key, prefix) {
+      keys = append(keys, key)
+    }
+  }
+  sort.Strings(keys)
+  return keys
+}
+
+func (s *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+  bucketName, key, _ := strings.Cut(strings.TrimPrefix(request.URL.Path, "/"), "/")
+
+  s.mutex.Lock()
+  defer s.mutex.Unlock()
+
+  current, ok := s.buckets[bucketName]
+  if !ok {
+    writeError(writer, http.StatusNotFound, "NoSuchBucket")
+    return
+  }
+
+  body, err := io.ReadAll(request.Body)
+  if err != nil {
+    writeError(writer, http.StatusBadRequest, "IncompleteBody")
+    return
+  }
+  if code := s.authorize(request, current, key, body); len(code) > 0 {
+    writeError(writer, http.StatusForbidden, code)
+    return
+  }
+
+  switch {
+  case request.Method == http.MethodGet && len(key) == 0:
+    s.list(writer, request, current)
+  case request.Method == http.MethodGet:
+    object, ok := current.objects[key]
+    if !ok {
+      writeError(writer, http.StatusNotFound, "NoSuchKey")
+      return
+    }
+    writer.Header().Set("Content-Type", object.ContentType)
+    writer.Header().Set("Cache-Control", object.CacheControl)
+    writer.Write(object.Data)
+  case request.Method == http.MethodPut && len(request.Header.Get("X-Amz-Copy-Source")) > 0:
+    s.copy(writer, request, current, key)
+  case request.Method == http.MethodPut:
+    current.objects[key] = Object{
+      Data:         body,
+      ContentType:  request.Header.Get("Content-Type"),
+      CacheControl: request.Header.Get("Cache-Control"),
+      LastModified: s.Now().UTC().Truncate(time.Second),
+    }
+  case request.Method == http.MethodDelete:
+    delete(current.objects, key)
+    writer.WriteHeader(http.StatusNoContent)
+  default:
+    writeError(writer, http.StatusMethodNotAllowed, "MethodNotAllowed")
+  }
+}
+
+// authorize check the credential of the request, it returns the error code when the request is not allowed
+func (s *Server) authorize(request *http.Request, current *bucket, key string, body []byte) string {
+  query := request.URL.Query()
+  switch {
+  case len(request.Header.Get("Authorization")) > 0:
+    if !strings.HasPrefix(request.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+AccessKey+"/") {
+      return "InvalidAccessKeyId"
+    }
+    sum := sha256.Sum256(body)
+    if request.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+      return "XAmzContentSHA256Mismatch"
+    }
+    return ""
+  case len(query.Get("X-Amz-Signature")) > 0:
+    if request.Method != http.MethodGet || !strings.HasPrefix(query.Get("X-Amz-Credential"), AccessKey+"/") {
+      return "InvalidAccessKeyId"
+    }
+    date, err := time.Parse("20060102T150405Z", query.Get("X-Amz-Date"))
+    if err != nil {
+      return "AuthorizationQueryParametersError"
+    }
+    expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+    if err != nil || s.Now().After(date.Add(time.Duration(expires)*time.Second)) {
+      return "AccessDenied"
+    }
+    return ""
+  case current.public && request.Method == http.MethodGet && len(key) > 0:
+    return ""
+  }
+  return "AccessDenied"
+}
+
+func (s *Server) list(writer http.ResponseWriter, request *http.Request, current *bucket) {
+  query := request.URL.Query()
+  if query.Get("list-type") != "2" {
+    writeError(writer, http.StatusNotImplemented, "NotImplemented")
+    return
+  }
+
+  type content struct {
+    Key          string
+    Size         int
+    LastModified string
+  }
+  result := struct {
+    XMLName               xml.Name `xml:"ListBucketResult"`
+    Contents              []content
+    IsTruncated           bool
+    NextContinuationToken string `xml:",omitempty"`
+  }{}
+
+  // The continuation token is the last key of the previous page
+  token := query.Get("continuation-token")
+  for _, key := range s.keys(current, query.Get("prefix")) {
+    if key <= token {
+      continue
+    }
+    if len(result.Contents) == s.PageSize {
+      result.IsTruncated = true
+      result.NextContinuationToken = result.Contents[len(result.Contents)-1].Key
+      break
+    }
+    object := current.objects[key]
+    result.Contents = append(result.Contents, content{
+      Key:          key,
+      Size:         len(object.Data),
+      LastModified: object.LastModified.Format(time.RFC3339),
+    })
+  }
+  writeXML(writer, http.StatusOK, result)
+}
+
+func (s *Server) copy(writer http.ResponseWriter, request *http.Request, current *bucket, key string) {
+  source, err := url.PathUnescape(request.Header.Get("X-Amz-Copy-Source"))
+  if err != nil {
+    writeError(writer, http.StatusBadRequest, "InvalidArgument")
+    return
+  }
+  sourceBucket, sourceKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+  from, ok := s.buckets[sourceBucket]
+  if !ok {
+    writeError(writer, http.StatusNotFound, "NoSuchBucket")
+    return
+  }
+  object, ok := from.objects[sourceKey]
+  if !ok {
+    writeError(writer, http.StatusNotFound, "NoSuchKey")
+    return
+  }
+
+  object.LastModified = s.Now().UTC().Truncate(time.Second)
+  current.objects[key] = object
+  writeXML(writer, http.StatusOK, struct {
+    XMLName      xml.Name `xml:"CopyObjectResult"`
+    LastModified string
+  }{LastModified: object.LastModified.Format(time.RFC3339)})
+}
+
+func writeError(writer http.ResponseWriter, statusCode int, code string) {
+  writeXML(writer, statusCode, struct {
+    XMLName xml.Name `xml:"Error"`
+    Code    string
+    Message string
+  }{Code: code, Message: code})
+}
+
+func writeXML(writer http.ResponseWriter, statusCode int, value any) {
+  writer.Header().Set("Content-Type", "application/xml")
+  writer.WriteHeader(statusCode)
+  xml.NewEncoder(writer).Encode(value)
+}
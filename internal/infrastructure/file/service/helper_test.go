@@ -0,0 +1,147 @@
+package service
+
+import (
+  "bytes"
+  "fmt"
+  "image"
+  "image/color"
+  "image/jpeg"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
+  "mime/multipart"
+  "os"
+  "sync"
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/require"
+  "github.com/uptrace/bun"
+)
+
+func testConfig() *common.Config {
+  return &common.Config{
+    FileSigningKey:  "secret",
+    FileURLDuration: time.Hour,
+    MaxPageSize:     1,
+    MaxCoverSize:    1,
+    MaxVolumeSize:   1,
+    MaxProfileSize:  1,
+  }
+}
+
+// testDir create relative directory for the stored files, because the local paths are relative to the working directory
+func testDir(t *testing.T) string {
+  dir, err := os.MkdirTemp(".", "files-")
+  require.NoError(t, err)
+  t.Cleanup(func() { os.RemoveAll(dir) })
+  return dir
+}
+
+func testJPEG(t *testing.T, width, height int) []byte {
+  img := image.NewRGBA(image.Rect(0, 0, width, height))
+  for y := 0; y < height; y++ {
+    for x := 0; x < width; x++ {
+      img.Set(x, y, color.RGBA{R: uint8(x * 255 / width), G: uint8(y * 255 / height), B: 128, A: 255})
+    }
+  }
+  buf := &bytes.Buffer{}
+  require.NoError(t, jpeg.Encode(buf, img, nil))
+  return buf.Bytes()
+}
+
+// testFileHeaders create the multipart files the same way as they are parsed from the request
+func testFileHeaders(t *testing.T, filenames []string, contents [][]byte) []multipart.FileHeader {
+  body := &bytes.Buffer{}
+  writer := multipart.NewWriter(body)
+  for i, filename := range filenames {
+    part, err := writer.CreateFormFile("files", filename)
+    require.NoError(t, err)
+    _, err = part.Write(contents[i])
+    require.NoError(t, err)
+  }
+  require.NoError(t, writer.Close())
+
+  form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+  require.NoError(t, err)
+  t.Cleanup(func() { form.RemoveAll() })
+
+  headers := make([]multipart.FileHeader, 0, len(filenames))
+  for _, header := range form.File["files"] {
+    headers = append(headers, *header)
+  }
+  return headers
+}
+
+// memoryReference reference repository which counts the references in memory
+type memoryReference struct {
+  mutex  sync.Mutex
+  counts map[string]uint32
+}
+
+func newMemoryReference() *memoryReference {
+  return &memoryReference{counts: map[string]uint32{}}
+}
+
+func (m *memoryReference) key(types file.AssetType, name file.Name) string {
+  return fmt.Sprintf("%s/%s", types, name)
+}
+
+func (m *memoryReference) Count(types file.AssetType, name file.Name) uint32 {
+  m.mutex.Lock()
+  defer m.mutex.Unlock()
+  return m.counts[m.key(types, name)]
+}
+
+func (m *memoryReference) Acquire(types file.AssetType, name file.Name, store func() error) error {
+  m.mutex.Lock()
+  defer m.mutex.Unlock()
+
+  key := m.key(types, name)
+  m.counts[key]++
+  if m.counts[key] > 1 {
+    return nil
+  }
+  if err := store(); err != nil {
+    delete(m.counts, key)
+    return err
+  }
+  return nil
+}
+
+func (m *memoryReference) Release(types file.AssetType, name file.Name, remove func() error) error {
+  m.mutex.Lock()
+  defer m.mutex.Unlock()
+
+  key := m.key(types, name)
+  count, ok := m.counts[key]
+  if !ok {
+    return remove()
+  }
+  if count > 1 {
+    m.counts[key]--
+    return nil
+  }
+  if err := remove(); err != nil {
+    return err
+  }
+  delete(m.counts, key)
+  return nil
+}
+
+func (m *memoryReference) WithTx(tx bun.IDB) repository.IReference {
+  return m
+}
+
+func (m *memoryReference) Collect(types file.AssetType, name file.Name, before time.Time, remove func() error) (bool, error) {
+  m.mutex.Lock()
+  defer m.mutex.Unlock()
+
+  if m.counts[m.key(types, name)] > 0 {
+    return false, nil
+  }
+  if err := remove(); err != nil {
+    return false, err
+  }
+  return true, nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+  "errors"
   "fmt"
   "github.com/gin-gonic/gin"
   "io"
@@ -8,91 +9,242 @@ import (
   "manga-explorer/internal/common"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
   "manga-explorer/internal/util"
   "mime/multipart"
+  "net/http"
   "os"
+  "path"
   "path/filepath"
+  "strconv"
   "strings"
+  "time"
 )
 
-func NewLocalFileService(config *common.Config, host, endpoint, dir string, routes gin.IRouter) IFile {
+// NewLocalFileService create file service which stores the files on the directory and serves them on the endpoint, the
+// files with the same content are stored once and counted on the reference repository
+func NewLocalFileService(config *common.Config, host, endpoint, dir string, routes gin.IRouter, references repository.IReference) IFile {
   dir = filepath.Dir(dir + "/") // Append '/' so /file would do
   err := os.MkdirAll(dir, fs.ModePerm)
   util.DoNothing(err)
 
-  for _, asset := range util.SliceWrap(file.MangaAsset, file.ProfileAsset, file.CoverAsset) {
+  for _, asset := range util.SliceWrap(file.MangaAsset, file.ProfileAsset, file.CoverAsset, file.VolumeAsset) {
     path := filepath.Join(dir, asset.String())
     err = os.MkdirAll(path, fs.ModePerm)
     if err != nil {
       panic(fmt.Sprintf("Failed to create directory: %s", err))
     }
   }
+  service := &serverFileService{
+    uploadProcessor: newUploadProcessor(config),
+    signer:          file.NewURLSigner(config.URLSigningKey(), config.FileURLDuration),
+    references:      references,
+    Directory:       dir,
+    endpoint:        fmt.Sprintf("%s/%s", host, strings.TrimPrefix(endpoint, "/")),
+  }
   // Serve static
-  routes.Static(endpoint, dir)
+  routes.GET(path.Join(endpoint, "/*filepath"), service.serve)
+  routes.HEAD(path.Join(endpoint, "/*filepath"), service.serve)
 
-  return &serverFileService{
-    Directory: dir,
-    endpoint:  fmt.Sprintf("%s/%s", host, strings.TrimPrefix(endpoint, "/")),
-  }
+  return service
 }
 
 type serverFileService struct {
-  endpoint  string
-  Directory string
+  uploadProcessor
+  signer     file.URLSigner
+  references repository.IReference
+  endpoint   string
+  Directory  string
 }
 
 func (s serverFileService) getLocalPath(types file.AssetType, filename file.Name) string {
   return fmt.Sprintf("./%s/%s/%s", s.Directory, types.String(), filename)
 }
 
-func (s serverFileService) Upload(types file.AssetType, fileHeader *multipart.FileHeader) (file.Name, status.Object) {
-  src, err := fileHeader.Open()
+func (s serverFileService) getVariantLocalPath(types file.AssetType, filename file.Name, variant file.Variant) string {
+  return fmt.Sprintf("./%s/%s/%s/%s", s.Directory, types.String(), variant.String(), filename)
+}
+
+// serve serve the stored files, the variant of the image is created on the first request and reused after that.
+// The variant path is /{asset}/{variant}/{filename}. Private asset needs valid signature created by GetFullpath or
+// GetVariantFullpath.
+func (s serverFileService) serve(ctx *gin.Context) {
+  name := path.Clean("/" + ctx.Param("filepath"))
+
+  parts := strings.Split(strings.TrimPrefix(name, "/"), "/")
+  // Staged files are not stored yet
+  if len(parts) > 1 && parts[1] == stagingDir {
+    ctx.AbortWithStatus(http.StatusNotFound)
+    return
+  }
+  if file.AssetType(parts[0]).IsPrivate() {
+    err := s.signer.Verify(name, ctx.Query("expires"), ctx.Query("signature"))
+    if err != nil {
+      ctx.AbortWithStatus(http.StatusForbidden)
+      return
+    }
+    // The browser should not cache it longer than the url is valid
+    expires, _ := strconv.ParseInt(ctx.Query("expires"), 10, 64)
+    ctx.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", max(0, expires-time.Now().Unix())))
+  }
+
+  if len(parts) == 3 {
+    types := file.AssetType(parts[0])
+    variant, ok := file.ParseVariant(parts[1])
+    if ok && types.HasVariant() {
+      err := s.createVariant(types, file.Name(parts[2]), variant)
+      if errors.Is(err, file.ErrVariantUnsupported) || errors.Is(err, file.ErrNoFormat) || errors.Is(err, fs.ErrNotExist) {
+        ctx.AbortWithStatus(http.StatusNotFound)
+        return
+      }
+      if err != nil {
+        ctx.AbortWithStatus(http.StatusInternalServerError)
+        return
+      }
+    }
+  }
+
+  // The stored files are not listed, the file server would still respond to the directory with empty listing or
+  // redirect
+  if info, err := os.Stat(filepath.Join(s.Directory, filepath.FromSlash(name))); err == nil && info.IsDir() {
+    ctx.AbortWithStatus(http.StatusNotFound)
+    return
+  }
+  ctx.FileFromFS(name, gin.Dir(s.Directory, false))
+}
+
+// createVariant create the variant of the image when it is not exists yet
+func (s serverFileService) createVariant(types file.AssetType, filename file.Name, variant file.Variant) error {
+  variantPath := s.getVariantLocalPath(types, filename, variant)
+  if _, err := os.Stat(variantPath); err == nil {
+    return nil
+  }
+
+  src, err := os.ReadFile(s.getLocalPath(types, filename))
   if err != nil {
-    return "", status.InternalError()
+    return err
+  }
+  format, err := file.ParseFileFormat(filename.String())
+  if err != nil {
+    return err
   }
-  defer src.Close()
 
-  // Get and validate format
-  format, err := file.ParseFileFormat(fileHeader.Filename)
+  err = os.MkdirAll(filepath.Dir(variantPath), fs.ModePerm)
   if err != nil {
-    return "", status.Error(status.BAD_REQUEST_ERROR)
+    return err
   }
+  // Write into temporary file first, so concurrent requests never see partially written variant
+  dst, err := os.CreateTemp(filepath.Dir(variantPath), ".variant-*")
+  if err != nil {
+    return err
+  }
+  defer os.Remove(dst.Name())
 
-  // Make new filename and append the format
-  filename := format.Filename(util.GenerateRandomString(30))
-  localPath := s.getLocalPath(types, filename)
-  // Save file
-  dst, err := os.Create(localPath)
+  err = file.WriteVariant(dst, src, format, variant)
+  if err2 := dst.Close(); err == nil {
+    err = err2
+  }
   if err != nil {
-    return "", status.InternalError()
+    return err
   }
-  defer dst.Close()
+  return os.Rename(dst.Name(), variantPath)
+}
 
-  _, err = io.Copy(dst, src)
+func (s serverFileService) Upload(types file.AssetType, fileHeader *multipart.FileHeader) (file.UploadInfo, status.Object) {
+  format, stat := s.validateHeader(types, fileHeader)
+  if stat.IsError() {
+    return file.UploadInfo{}, stat
+  }
+
+  src, err := fileHeader.Open()
   if err != nil {
-    return "", status.InternalError()
+    return file.UploadInfo{}, status.InternalError()
   }
+  defer src.Close()
 
-  return filename, status.Created()
+  return s.uploadFile(types, format, src)
 }
 
-func (s serverFileService) Uploads(types file.AssetType, files []multipart.FileHeader) ([]file.Name, status.Object) {
-  filenames := []file.Name{}
-  for _, fl := range files {
-    filename, stat := s.Upload(types, &fl)
-    if stat.IsError() {
-      return nil, stat
+// uploadFile save the content of the reader as new file with the format
+func (s serverFileService) uploadFile(types file.AssetType, format file.Format, src io.Reader) (file.UploadInfo, status.Object) {
+  data, info, stat := s.process(types, format, src)
+  if stat.IsError() {
+    return file.UploadInfo{}, stat
+  }
+
+  // Name the file by the content, the file is only written when no one uses the same content yet
+  info.Name = file.ContentName(info.Format, data)
+  localPath := s.getLocalPath(types, info.Name)
+  err := s.references.Acquire(types, info.Name, func() error {
+    err := os.WriteFile(localPath, data, 0644)
+    if err != nil {
+      os.Remove(localPath)
     }
-    filenames = append(filenames, filename)
+    return err
+  })
+  if err != nil {
+    return file.UploadInfo{}, status.InternalError()
+  }
+
+  return info, status.Created()
+}
+
+func (s serverFileService) Uploads(types file.AssetType, files []multipart.FileHeader) (file.IStaged, status.Object) {
+  return stageUploads(s, s.uploadProcessor, s.references, types, files)
+}
+
+func (s serverFileService) UploadFiles(types file.AssetType, sources []file.Source) (file.IStaged, status.Object) {
+  return stageFiles(s, s.uploadProcessor, s.references, types, sources)
+}
+
+// stage write the file into the staging directory of the asset type, the key is the path of the staged file
+func (s serverFileService) stage(types file.AssetType, info file.UploadInfo, data []byte) (string, error) {
+  dir := filepath.Join(s.Directory, types.String(), stagingDir)
+  err := os.MkdirAll(dir, fs.ModePerm)
+  if err != nil {
+    return "", err
   }
 
-  return filenames, status.Success()
+  dst, err := os.CreateTemp(dir, "*."+info.Format.String())
+  if err != nil {
+    return "", err
+  }
+  _, err = dst.Write(data)
+  if err2 := dst.Close(); err == nil {
+    err = err2
+  }
+  if err != nil {
+    os.Remove(dst.Name())
+    return "", err
+  }
+  return dst.Name(), nil
+}
+
+func (s serverFileService) promote(types file.AssetType, key string, info file.UploadInfo) error {
+  // The staging directory is on the same file system, so the file is moved at once
+  return os.Rename(key, s.getLocalPath(types, info.Name))
+}
+
+func (s serverFileService) discard(types file.AssetType, key string) {
+  os.Remove(key)
 }
 
 func (s serverFileService) Delete(types file.AssetType, filename file.Name) status.Object {
-  // Get local path
-  localPath := s.getLocalPath(types, filename)
-  err := os.Remove(localPath)
+  // The file is only removed when it is the last reference
+  err := s.references.Release(types, filename, func() error {
+    err := os.Remove(s.getLocalPath(types, filename))
+    if err != nil {
+      return err
+    }
+
+    // Delete the cached variants
+    if types.HasVariant() {
+      for _, variant := range file.Variants {
+        os.Remove(s.getVariantLocalPath(types, filename, variant))
+      }
+    }
+    return nil
+  })
   if err != nil {
     return status.InternalError()
   }
@@ -100,6 +252,17 @@ func (s serverFileService) Delete(types file.AssetType, filename file.Name) stat
 
 }
 
+func (s serverFileService) Open(types file.AssetType, filename file.Name) (io.ReadCloser, status.Object) {
+  src, err := os.Open(s.getLocalPath(types, filename))
+  if errors.Is(err, fs.ErrNotExist) {
+    return nil, status.Error(status.OBJECT_NOT_FOUND)
+  }
+  if err != nil {
+    return nil, status.InternalError()
+  }
+  return src, status.Success()
+}
+
 func (s serverFileService) Endpoint(types file.AssetType) string {
   return fmt.Sprintf("%s/%s", s.endpoint, types.String())
 }
@@ -107,5 +270,23 @@ func (s serverFileService) GetFullpath(assetType file.AssetType, filename file.N
   if len(filename) == 0 || filename == file.NoFile {
     return ""
   }
-  return fmt.Sprintf("%s/%s", s.Endpoint(assetType), filename)
+  return s.signURL(assetType, fmt.Sprintf("/%s/%s", assetType.String(), filename))
+}
+
+func (s serverFileService) GetVariantFullpath(assetType file.AssetType, filename file.Name, variant file.Variant) string {
+  if !assetType.HasVariant() || len(filename) == 0 || filename == file.NoFile {
+    return s.GetFullpath(assetType, filename)
+  }
+  if format, err := file.ParseFileFormat(filename.String()); err != nil || !format.HasVariant() {
+    return ""
+  }
+  return s.signURL(assetType, fmt.Sprintf("/%s/%s/%s", assetType.String(), variant.String(), filename))
+}
+
+// signURL create the url of the path relative to the endpoint, the signature is only added for private asset
+func (s serverFileService) signURL(assetType file.AssetType, path string) string {
+  if !assetType.IsPrivate() {
+    return s.endpoint + path
+  }
+  return fmt.Sprintf("%s%s?%s", s.endpoint, path, s.signer.Sign(path))
 }
@@ -0,0 +1,152 @@
+package service
+
+import (
+  "bytes"
+  "github.com/gin-gonic/gin"
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+  "image"
+  "image/color"
+  "image/gif"
+  "manga-explorer/internal/infrastructure/file"
+  "net/http"
+  "net/http/httptest"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func TestServerFileService_Uploads_SameContent(t *testing.T) {
+  gin.SetMode(gin.TestMode)
+  dir := testDir(t)
+  references := newMemoryReference()
+  service := NewLocalFileService(testConfig(), "http://localhost", "/static", dir, gin.New(), references)
+
+  data := testJPEG(t, 32, 32)
+  headers := testFileHeaders(t, []string{"first.jpg", "second.jpeg"}, [][]byte{data, data})
+
+  staged, stat := service.Uploads(file.CoverAsset, headers)
+  require.False(t, stat.IsError())
+  require.False(t, staged.Commit(nil).IsError())
+
+  infos := staged.Infos()
+  require.Len(t, infos, 2)
+  assert.Equal(t, infos[0].Name, infos[1].Name)
+  assert.Equal(t, file.FormatJPG, infos[0].Format)
+  assert.True(t, strings.HasSuffix(infos[0].Name.String(), ".jpg"))
+  assert.EqualValues(t, 2, references.Count(file.CoverAsset, infos[0].Name))
+
+  entries, err := os.ReadDir(filepath.Join(dir, string(file.CoverAsset)))
+  require.NoError(t, err)
+  var stored []string
+  for _, entry := range entries {
+    if !entry.IsDir() {
+      stored = append(stored, entry.Name())
+    }
+  }
+  assert.Equal(t, []string{infos[0].Name.String()}, stored)
+
+  // The single upload is named the same way
+  info, stat := service.Upload(file.CoverAsset, &testFileHeaders(t, []string{"third.jpeg"}, [][]byte{data})[0])
+  require.False(t, stat.IsError())
+  assert.Equal(t, infos[0].Name, info.Name)
+  assert.EqualValues(t, 3, references.Count(file.CoverAsset, info.Name))
+}
+
+func TestServerFileService_UploadFiles(t *testing.T) {
+  gin.SetMode(gin.TestMode)
+  dir := testDir(t)
+  references := newMemoryReference()
+  service := NewLocalFileService(testConfig(), "http://localhost", "/static", dir, gin.New(), references)
+  sources := func() []file.Source {
+    return []file.Source{
+      {Format: file.FormatJPG, Reader: bytes.NewReader(testJPEG(t, 32, 32))},
+      {Format: file.FormatJPG, Reader: bytes.NewReader(testJPEG(t, 16, 48))},
+    }
+  }
+
+  // Nothing is stored when the staged files are rolled back
+  staged, stat := service.UploadFiles(file.MangaAsset, sources())
+  require.False(t, stat.IsError())
+  staged.Rollback()
+  for _, info := range staged.Infos() {
+    assert.NoFileExists(t, filepath.Join(dir, string(file.MangaAsset), info.Name.String()))
+    assert.Zero(t, references.Count(file.MangaAsset, info.Name))
+  }
+
+  staged, stat = service.UploadFiles(file.MangaAsset, sources())
+  require.False(t, stat.IsError())
+  require.False(t, staged.Commit(nil).IsError())
+  infos := staged.Infos()
+  require.Len(t, infos, 2)
+  for _, info := range infos {
+    assert.FileExists(t, filepath.Join(dir, string(file.MangaAsset), info.Name.String()))
+    assert.EqualValues(t, 1, references.Count(file.MangaAsset, info.Name))
+  }
+
+  // Invalid content is not staged
+  _, stat = service.UploadFiles(file.MangaAsset, []file.Source{{Format: file.FormatPNG, Reader: strings.NewReader("invalid")}})
+  assert.True(t, stat.IsError())
+}
+
+func TestServerFileService_ServeVariant(t *testing.T) {
+  gin.SetMode(gin.TestMode)
+  dir := testDir(t)
+  router := gin.New()
+  service := NewLocalFileService(testConfig(), "http://localhost", "/static", dir, router, newMemoryReference())
+
+  src := &bytes.Buffer{}
+  require.NoError(t, gif.Encode(src, image.NewPaletted(image.Rect(0, 0, 600, 300), []color.Color{color.Black, color.White}), nil))
+  avif := []byte("\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf")
+  require.NoError(t, os.WriteFile(filepath.Join(dir, string(file.CoverAsset), "image.gif"), src.Bytes(), 0644))
+  require.NoError(t, os.WriteFile(filepath.Join(dir, string(file.CoverAsset), "image.avif"), avif, 0644))
+
+  tests := []struct {
+    name       string
+    filename   file.Name
+    wantStatus int
+    wantWidth  int
+  }{
+    {
+      name:       "Resized GIF",
+      filename:   "image.gif",
+      wantStatus: http.StatusOK,
+      wantWidth:  int(file.VariantSmall),
+    },
+    {
+      name:       "AVIF has no variant",
+      filename:   "image.avif",
+      wantStatus: http.StatusNotFound,
+    },
+    {
+      name:       "Not exists",
+      filename:   "missing.gif",
+      wantStatus: http.StatusNotFound,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      recorder := httptest.NewRecorder()
+      target := "/static/covers/" + file.VariantSmall.String() + "/" + tt.filename.String()
+      router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+      require.Equal(t, tt.wantStatus, recorder.Code)
+      if tt.wantWidth != 0 {
+        config, _, err := image.DecodeConfig(recorder.Body)
+        require.NoError(t, err)
+        assert.Equal(t, tt.wantWidth, config.Width)
+      }
+    })
+  }
+
+  // The directories are not listed
+  for _, target := range []string{"/static/", "/static/covers/", "/static/covers"} {
+    recorder := httptest.NewRecorder()
+    router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+    assert.Equal(t, http.StatusNotFound, recorder.Code, target)
+  }
+
+  // The missing variant is reported instead of using the original
+  assert.Empty(t, service.GetVariantFullpath(file.CoverAsset, "image.avif", file.VariantSmall))
+  assert.NotEmpty(t, service.GetVariantFullpath(file.CoverAsset, "image.gif", file.VariantSmall))
+}
@@ -3,6 +3,7 @@
 package service
 
 import (
+	io "io"
 	file "manga-explorer/internal/infrastructure/file"
 
 	mock "github.com/stretchr/testify/mock"
@@ -165,23 +166,130 @@ func (_c *FileMock_GetFullpath_Call) RunAndReturn(run func(file.AssetType, file.
 	return _c
 }
 
+// GetVariantFullpath provides a mock function with given fields: assetType, filename, variant
+func (_m *FileMock) GetVariantFullpath(assetType file.AssetType, filename file.Name, variant file.Variant) string {
+	ret := _m.Called(assetType, filename, variant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantFullpath")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name, file.Variant) string); ok {
+		r0 = rf(assetType, filename, variant)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// FileMock_GetVariantFullpath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVariantFullpath'
+type FileMock_GetVariantFullpath_Call struct {
+	*mock.Call
+}
+
+// GetVariantFullpath is a helper method to define mock.On call
+//   - assetType file.AssetType
+//   - filename file.Name
+//   - variant file.Variant
+func (_e *FileMock_Expecter) GetVariantFullpath(assetType interface{}, filename interface{}, variant interface{}) *FileMock_GetVariantFullpath_Call {
+	return &FileMock_GetVariantFullpath_Call{Call: _e.mock.On("GetVariantFullpath", assetType, filename, variant)}
+}
+
+func (_c *FileMock_GetVariantFullpath_Call) Run(run func(assetType file.AssetType, filename file.Name, variant file.Variant)) *FileMock_GetVariantFullpath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name), args[2].(file.Variant))
+	})
+	return _c
+}
+
+func (_c *FileMock_GetVariantFullpath_Call) Return(_a0 string) *FileMock_GetVariantFullpath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FileMock_GetVariantFullpath_Call) RunAndReturn(run func(file.AssetType, file.Name, file.Variant) string) *FileMock_GetVariantFullpath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: types, filename
+func (_m *FileMock) Open(types file.AssetType, filename file.Name) (io.ReadCloser, status.Object) {
+	ret := _m.Called(types, filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 io.ReadCloser
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name) (io.ReadCloser, status.Object)); ok {
+		return rf(types, filename)
+	}
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name) io.ReadCloser); ok {
+		r0 = rf(types, filename)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(file.AssetType, file.Name) status.Object); ok {
+		r1 = rf(types, filename)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// FileMock_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type FileMock_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - types file.AssetType
+//   - filename file.Name
+func (_e *FileMock_Expecter) Open(types interface{}, filename interface{}) *FileMock_Open_Call {
+	return &FileMock_Open_Call{Call: _e.mock.On("Open", types, filename)}
+}
+
+func (_c *FileMock_Open_Call) Run(run func(types file.AssetType, filename file.Name)) *FileMock_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name))
+	})
+	return _c
+}
+
+func (_c *FileMock_Open_Call) Return(_a0 io.ReadCloser, _a1 status.Object) *FileMock_Open_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FileMock_Open_Call) RunAndReturn(run func(file.AssetType, file.Name) (io.ReadCloser, status.Object)) *FileMock_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upload provides a mock function with given fields: types, header
-func (_m *FileMock) Upload(types file.AssetType, header *multipart.FileHeader) (file.Name, status.Object) {
+func (_m *FileMock) Upload(types file.AssetType, header *multipart.FileHeader) (file.UploadInfo, status.Object) {
 	ret := _m.Called(types, header)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Upload")
 	}
 
-	var r0 file.Name
+	var r0 file.UploadInfo
 	var r1 status.Object
-	if rf, ok := ret.Get(0).(func(file.AssetType, *multipart.FileHeader) (file.Name, status.Object)); ok {
+	if rf, ok := ret.Get(0).(func(file.AssetType, *multipart.FileHeader) (file.UploadInfo, status.Object)); ok {
 		return rf(types, header)
 	}
-	if rf, ok := ret.Get(0).(func(file.AssetType, *multipart.FileHeader) file.Name); ok {
+	if rf, ok := ret.Get(0).(func(file.AssetType, *multipart.FileHeader) file.UploadInfo); ok {
 		r0 = rf(types, header)
 	} else {
-		r0 = ret.Get(0).(file.Name)
+		r0 = ret.Get(0).(file.UploadInfo)
 	}
 
 	if rf, ok := ret.Get(1).(func(file.AssetType, *multipart.FileHeader) status.Object); ok {
@@ -212,34 +320,93 @@ func (_c *FileMock_Upload_Call) Run(run func(types file.AssetType, header *multi
 	return _c
 }
 
-func (_c *FileMock_Upload_Call) Return(_a0 file.Name, _a1 status.Object) *FileMock_Upload_Call {
+func (_c *FileMock_Upload_Call) Return(_a0 file.UploadInfo, _a1 status.Object) *FileMock_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FileMock_Upload_Call) RunAndReturn(run func(file.AssetType, *multipart.FileHeader) (file.UploadInfo, status.Object)) *FileMock_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadFiles provides a mock function with given fields: types, sources
+func (_m *FileMock) UploadFiles(types file.AssetType, sources []file.Source) (file.IStaged, status.Object) {
+	ret := _m.Called(types, sources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadFiles")
+	}
+
+	var r0 file.IStaged
+	var r1 status.Object
+	if rf, ok := ret.Get(0).(func(file.AssetType, []file.Source) (file.IStaged, status.Object)); ok {
+		return rf(types, sources)
+	}
+	if rf, ok := ret.Get(0).(func(file.AssetType, []file.Source) file.IStaged); ok {
+		r0 = rf(types, sources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(file.IStaged)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(file.AssetType, []file.Source) status.Object); ok {
+		r1 = rf(types, sources)
+	} else {
+		r1 = ret.Get(1).(status.Object)
+	}
+
+	return r0, r1
+}
+
+// FileMock_UploadFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFiles'
+type FileMock_UploadFiles_Call struct {
+	*mock.Call
+}
+
+// UploadFiles is a helper method to define mock.On call
+//   - types file.AssetType
+//   - sources []file.Source
+func (_e *FileMock_Expecter) UploadFiles(types interface{}, sources interface{}) *FileMock_UploadFiles_Call {
+	return &FileMock_UploadFiles_Call{Call: _e.mock.On("UploadFiles", types, sources)}
+}
+
+func (_c *FileMock_UploadFiles_Call) Run(run func(types file.AssetType, sources []file.Source)) *FileMock_UploadFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].([]file.Source))
+	})
+	return _c
+}
+
+func (_c *FileMock_UploadFiles_Call) Return(_a0 file.IStaged, _a1 status.Object) *FileMock_UploadFiles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FileMock_Upload_Call) RunAndReturn(run func(file.AssetType, *multipart.FileHeader) (file.Name, status.Object)) *FileMock_Upload_Call {
+func (_c *FileMock_UploadFiles_Call) RunAndReturn(run func(file.AssetType, []file.Source) (file.IStaged, status.Object)) *FileMock_UploadFiles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Uploads provides a mock function with given fields: types, header
-func (_m *FileMock) Uploads(types file.AssetType, header []multipart.FileHeader) ([]file.Name, status.Object) {
+func (_m *FileMock) Uploads(types file.AssetType, header []multipart.FileHeader) (file.IStaged, status.Object) {
 	ret := _m.Called(types, header)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Uploads")
 	}
 
-	var r0 []file.Name
+	var r0 file.IStaged
 	var r1 status.Object
-	if rf, ok := ret.Get(0).(func(file.AssetType, []multipart.FileHeader) ([]file.Name, status.Object)); ok {
+	if rf, ok := ret.Get(0).(func(file.AssetType, []multipart.FileHeader) (file.IStaged, status.Object)); ok {
 		return rf(types, header)
 	}
-	if rf, ok := ret.Get(0).(func(file.AssetType, []multipart.FileHeader) []file.Name); ok {
+	if rf, ok := ret.Get(0).(func(file.AssetType, []multipart.FileHeader) file.IStaged); ok {
 		r0 = rf(types, header)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]file.Name)
+			r0 = ret.Get(0).(file.IStaged)
 		}
 	}
 
@@ -271,12 +438,12 @@ func (_c *FileMock_Uploads_Call) Run(run func(types file.AssetType, header []mul
 	return _c
 }
 
-func (_c *FileMock_Uploads_Call) Return(_a0 []file.Name, _a1 status.Object) *FileMock_Uploads_Call {
+func (_c *FileMock_Uploads_Call) Return(_a0 file.IStaged, _a1 status.Object) *FileMock_Uploads_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FileMock_Uploads_Call) RunAndReturn(run func(file.AssetType, []multipart.FileHeader) ([]file.Name, status.Object)) *FileMock_Uploads_Call {
+func (_c *FileMock_Uploads_Call) RunAndReturn(run func(file.AssetType, []multipart.FileHeader) (file.IStaged, status.Object)) *FileMock_Uploads_Call {
 	_c.Call.Return(run)
 	return _c
 }
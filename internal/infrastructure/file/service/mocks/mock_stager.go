@@ -0,0 +1,225 @@
+// Code generated by mockery v2.40.1. DO NOT EDIT.
+
+package service
+
+import (
+	file "manga-explorer/internal/infrastructure/file"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "manga-explorer/internal/common/status"
+)
+
+// stagerMock is an autogenerated mock type for the stager type
+type stagerMock struct {
+	mock.Mock
+}
+
+type stagerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stagerMock) EXPECT() *stagerMock_Expecter {
+	return &stagerMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: types, filename
+func (_m *stagerMock) Delete(types file.AssetType, filename file.Name) status.Object {
+	ret := _m.Called(types, filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 status.Object
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.Name) status.Object); ok {
+		r0 = rf(types, filename)
+	} else {
+		r0 = ret.Get(0).(status.Object)
+	}
+
+	return r0
+}
+
+// stagerMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type stagerMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - types file.AssetType
+//   - filename file.Name
+func (_e *stagerMock_Expecter) Delete(types interface{}, filename interface{}) *stagerMock_Delete_Call {
+	return &stagerMock_Delete_Call{Call: _e.mock.On("Delete", types, filename)}
+}
+
+func (_c *stagerMock_Delete_Call) Run(run func(types file.AssetType, filename file.Name)) *stagerMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.Name))
+	})
+	return _c
+}
+
+func (_c *stagerMock_Delete_Call) Return(_a0 status.Object) *stagerMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stagerMock_Delete_Call) RunAndReturn(run func(file.AssetType, file.Name) status.Object) *stagerMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// discard provides a mock function with given fields: types, key
+func (_m *stagerMock) discard(types file.AssetType, key string) {
+	_m.Called(types, key)
+}
+
+// stagerMock_discard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'discard'
+type stagerMock_discard_Call struct {
+	*mock.Call
+}
+
+// discard is a helper method to define mock.On call
+//   - types file.AssetType
+//   - key string
+func (_e *stagerMock_Expecter) discard(types interface{}, key interface{}) *stagerMock_discard_Call {
+	return &stagerMock_discard_Call{Call: _e.mock.On("discard", types, key)}
+}
+
+func (_c *stagerMock_discard_Call) Run(run func(types file.AssetType, key string)) *stagerMock_discard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *stagerMock_discard_Call) Return() *stagerMock_discard_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *stagerMock_discard_Call) RunAndReturn(run func(file.AssetType, string)) *stagerMock_discard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// promote provides a mock function with given fields: types, key, info
+func (_m *stagerMock) promote(types file.AssetType, key string, info file.UploadInfo) error {
+	ret := _m.Called(types, key, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for promote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(file.AssetType, string, file.UploadInfo) error); ok {
+		r0 = rf(types, key, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stagerMock_promote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'promote'
+type stagerMock_promote_Call struct {
+	*mock.Call
+}
+
+// promote is a helper method to define mock.On call
+//   - types file.AssetType
+//   - key string
+//   - info file.UploadInfo
+func (_e *stagerMock_Expecter) promote(types interface{}, key interface{}, info interface{}) *stagerMock_promote_Call {
+	return &stagerMock_promote_Call{Call: _e.mock.On("promote", types, key, info)}
+}
+
+func (_c *stagerMock_promote_Call) Run(run func(types file.AssetType, key string, info file.UploadInfo)) *stagerMock_promote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(string), args[2].(file.UploadInfo))
+	})
+	return _c
+}
+
+func (_c *stagerMock_promote_Call) Return(_a0 error) *stagerMock_promote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stagerMock_promote_Call) RunAndReturn(run func(file.AssetType, string, file.UploadInfo) error) *stagerMock_promote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// stage provides a mock function with given fields: types, info, data
+func (_m *stagerMock) stage(types file.AssetType, info file.UploadInfo, data []byte) (string, error) {
+	ret := _m.Called(types, info, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for stage")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.UploadInfo, []byte) (string, error)); ok {
+		return rf(types, info, data)
+	}
+	if rf, ok := ret.Get(0).(func(file.AssetType, file.UploadInfo, []byte) string); ok {
+		r0 = rf(types, info, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(file.AssetType, file.UploadInfo, []byte) error); ok {
+		r1 = rf(types, info, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// stagerMock_stage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'stage'
+type stagerMock_stage_Call struct {
+	*mock.Call
+}
+
+// stage is a helper method to define mock.On call
+//   - types file.AssetType
+//   - info file.UploadInfo
+//   - data []byte
+func (_e *stagerMock_Expecter) stage(types interface{}, info interface{}, data interface{}) *stagerMock_stage_Call {
+	return &stagerMock_stage_Call{Call: _e.mock.On("stage", types, info, data)}
+}
+
+func (_c *stagerMock_stage_Call) Run(run func(types file.AssetType, info file.UploadInfo, data []byte)) *stagerMock_stage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(file.AssetType), args[1].(file.UploadInfo), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *stagerMock_stage_Call) Return(_a0 string, _a1 error) *stagerMock_stage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *stagerMock_stage_Call) RunAndReturn(run func(file.AssetType, file.UploadInfo, []byte) (string, error)) *stagerMock_stage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStagerMock creates a new instance of stagerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStagerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stagerMock {
+	mock := &stagerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
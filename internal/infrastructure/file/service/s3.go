@@ -0,0 +1,303 @@
+package service
+
+import (
+  "bytes"
+  "context"
+  "errors"
+  "fmt"
+  "io"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
+  "manga-explorer/internal/infrastructure/file/s3"
+  "manga-explorer/internal/util"
+  "mime/multipart"
+  "net/http"
+  "strings"
+  "time"
+)
+
+const (
+  s3Timeout = 30 * time.Second
+  // The objects are content-addressed, so the object of the key is immutable. The private objects are only cached by
+  // the browser, because the presigned url is only valid for a while.
+  s3CacheControl        = "public, max-age=31536000, immutable"
+  s3PrivateCacheControl = "private, max-age=31536000, immutable"
+)
+
+var errS3PrivateBucket = errors.New("s3 private bucket is required and should be different from the public bucket")
+
+// NewS3FileService create file service which stores the files on S3-compatible storage. The public bucket should allow
+// public read or be served behind CDN, while the private asset is stored on the private bucket which doesn't allow it
+// and is only accessed through presigned url. The objects with the same content are stored once and counted on the
+// reference repository. It will panic when the config is invalid.
+func NewS3FileService(config *common.Config, references repository.IReference) IFile {
+  publicClient, privateClient, err := NewS3Clients(config, &http.Client{})
+  if err != nil {
+    panic(fmt.Sprintf("Failed to create S3 client: %s", err))
+  }
+
+  publicURL := strings.TrimSuffix(config.S3PublicURL, "/")
+  if len(publicURL) == 0 {
+    publicURL = publicClient.BucketURL()
+  }
+  return &s3FileService{
+    uploadProcessor: newUploadProcessor(config),
+    publicClient:    publicClient,
+    privateClient:   privateClient,
+    references:      references,
+    publicURL:       publicURL,
+    urlDuration:     config.FileURLDuration,
+  }
+}
+
+// NewS3Clients create the client of the public bucket and the private bucket from the config
+func NewS3Clients(config *common.Config, httpClient *http.Client) (*s3.Client, *s3.Client, error) {
+  if len(config.S3PrivateBucket) == 0 || config.S3PrivateBucket == config.S3Bucket {
+    return nil, nil, errS3PrivateBucket
+  }
+
+  s3Config := s3.Config{
+    Endpoint:  config.S3Endpoint,
+    Region:    config.S3Region,
+    Bucket:    config.S3Bucket,
+    AccessKey: config.S3AccessKey,
+    SecretKey: config.S3SecretKey,
+    PathStyle: config.S3PathStyle,
+  }
+  publicClient, err := s3.NewClient(s3Config, httpClient)
+  if err != nil {
+    return nil, nil, err
+  }
+  s3Config.Bucket = config.S3PrivateBucket
+  privateClient, err := s3.NewClient(s3Config, httpClient)
+  if err != nil {
+    return nil, nil, err
+  }
+  return publicClient, privateClient, nil
+}
+
+type s3FileService struct {
+  uploadProcessor
+  publicClient  *s3.Client
+  privateClient *s3.Client
+  references    repository.IReference
+  publicURL     string
+  urlDuration   time.Duration
+}
+
+// getClient get the client of the bucket where the asset type is stored
+func (s s3FileService) getClient(types file.AssetType) *s3.Client {
+  if types.IsPrivate() {
+    return s.privateClient
+  }
+  return s.publicClient
+}
+
+func (s s3FileService) getCacheControl(types file.AssetType) string {
+  if types.IsPrivate() {
+    return s3PrivateCacheControl
+  }
+  return s3CacheControl
+}
+
+func (s s3FileService) getKey(types file.AssetType, filename file.Name) string {
+  return fmt.Sprintf("%s/%s", types.String(), filename)
+}
+
+func (s s3FileService) getVariantKey(types file.AssetType, filename file.Name, variant file.Variant) string {
+  return fmt.Sprintf("%s/%s/%s", types.String(), variant.String(), filename)
+}
+
+func (s s3FileService) Upload(types file.AssetType, fileHeader *multipart.FileHeader) (file.UploadInfo, status.Object) {
+  format, stat := s.validateHeader(types, fileHeader)
+  if stat.IsError() {
+    return file.UploadInfo{}, stat
+  }
+
+  src, err := fileHeader.Open()
+  if err != nil {
+    return file.UploadInfo{}, status.InternalError()
+  }
+  defer src.Close()
+
+  return s.uploadFile(types, format, src)
+}
+
+// uploadFile save the content of the reader as new file with the format
+func (s s3FileService) uploadFile(types file.AssetType, format file.Format, src io.Reader) (file.UploadInfo, status.Object) {
+  data, info, stat := s.process(types, format, src)
+  if stat.IsError() {
+    return file.UploadInfo{}, stat
+  }
+  info.Name = file.ContentName(info.Format, data)
+
+  // The objects are only uploaded when no one uses the same content yet
+  err := s.references.Acquire(types, info.Name, func() error {
+    ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+    defer cancel()
+
+    err := s.getClient(types).PutObject(ctx, s.getKey(types, info.Name), data, info.Format.MimeType(), s.getCacheControl(types))
+    if err != nil {
+      return err
+    }
+
+    // There is no server to create the variants on the first request like local storage, so create them now
+    if types.HasVariant() && info.Format.HasVariant() {
+      err = s.putVariants(ctx, types, info.Name, info.Format, data)
+      if err != nil {
+        s.deleteObjects(ctx, types, info.Name)
+      }
+    }
+    return err
+  })
+  if err != nil {
+    return file.UploadInfo{}, status.InternalError()
+  }
+  return info, status.Created()
+}
+
+func (s s3FileService) putVariants(ctx context.Context, types file.AssetType, filename file.Name, format file.Format, data []byte) error {
+  for _, variant := range file.Variants {
+    resized := bytes.Buffer{}
+    err := file.WriteVariant(&resized, data, format, variant)
+    if err != nil {
+      return err
+    }
+    err = s.getClient(types).PutObject(ctx, s.getVariantKey(types, filename, variant), resized.Bytes(), format.MimeType(), s.getCacheControl(types))
+    if err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+func (s s3FileService) Uploads(types file.AssetType, files []multipart.FileHeader) (file.IStaged, status.Object) {
+  return stageUploads(s, s.uploadProcessor, s.references, types, files)
+}
+
+func (s s3FileService) UploadFiles(types file.AssetType, sources []file.Source) (file.IStaged, status.Object) {
+  return stageFiles(s, s.uploadProcessor, s.references, types, sources)
+}
+
+// stage upload the object and the variants into the staging prefix of the asset type, the key is the name of the
+// staged object
+func (s s3FileService) stage(types file.AssetType, info file.UploadInfo, data []byte) (string, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+  defer cancel()
+
+  key := fmt.Sprintf("%s/%s", stagingDir, info.Format.Filename(util.GenerateRandomString(30)))
+  err := s.getClient(types).PutObject(ctx, s.getKey(types, file.Name(key)), data, info.Format.MimeType(), s.getCacheControl(types))
+  if err != nil {
+    return "", err
+  }
+  if types.HasVariant() && info.Format.HasVariant() {
+    err = s.putVariants(ctx, types, file.Name(key), info.Format, data)
+    if err != nil {
+      s.deleteObjects(ctx, types, file.Name(key))
+      return "", err
+    }
+  }
+  return key, nil
+}
+
+// promote copy the staged object and the variants, S3 doesn't support moving the object, so the staged objects are
+// deleted on discard
+func (s s3FileService) promote(types file.AssetType, key string, info file.UploadInfo) error {
+  ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+  defer cancel()
+
+  err := s.getClient(types).CopyObject(ctx, s.getKey(types, file.Name(key)), s.getKey(types, info.Name))
+  if err != nil {
+    return err
+  }
+  if types.HasVariant() && info.Format.HasVariant() {
+    for _, variant := range file.Variants {
+      err = s.getClient(types).CopyObject(ctx, s.getVariantKey(types, file.Name(key), variant), s.getVariantKey(types, info.Name, variant))
+      if err != nil {
+        s.deleteObjects(ctx, types, info.Name)
+        return err
+      }
+    }
+  }
+  return nil
+}
+
+func (s s3FileService) discard(types file.AssetType, key string) {
+  ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+  defer cancel()
+  s.deleteObjects(ctx, types, file.Name(key))
+}
+
+func (s s3FileService) Delete(types file.AssetType, filename file.Name) status.Object {
+  // The objects are only deleted when it is the last reference
+  err := s.references.Release(types, filename, func() error {
+    ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+    defer cancel()
+    return s.deleteObjects(ctx, types, filename)
+  })
+  if err != nil {
+    return status.InternalError()
+  }
+  return status.Success(status.DELETED)
+}
+
+// deleteObjects delete the object and the variants, deleting variant which doesn't exist is not an error
+func (s s3FileService) deleteObjects(ctx context.Context, types file.AssetType, filename file.Name) error {
+  err := s.getClient(types).DeleteObject(ctx, s.getKey(types, filename))
+  if err != nil {
+    return err
+  }
+  if types.HasVariant() {
+    for _, variant := range file.Variants {
+      s.getClient(types).DeleteObject(ctx, s.getVariantKey(types, filename, variant))
+    }
+  }
+  return nil
+}
+
+func (s s3FileService) Open(types file.AssetType, filename file.Name) (io.ReadCloser, status.Object) {
+  // The reader is used after this function returns, so it can't have timeout
+  src, err := s.getClient(types).GetObject(context.Background(), s.getKey(types, filename))
+  if errors.Is(err, s3.ErrNotFound) {
+    return nil, status.Error(status.OBJECT_NOT_FOUND)
+  }
+  if err != nil {
+    return nil, status.InternalError()
+  }
+  return src, status.Success()
+}
+
+func (s s3FileService) Endpoint(types file.AssetType) string {
+  if types.IsPrivate() {
+    return fmt.Sprintf("%s/%s", s.privateClient.BucketURL(), types.String())
+  }
+  return fmt.Sprintf("%s/%s", s.publicURL, types.String())
+}
+
+func (s s3FileService) GetFullpath(assetType file.AssetType, filename file.Name) string {
+  if len(filename) == 0 || filename == file.NoFile {
+    return ""
+  }
+  return s.getURL(assetType, s.getKey(assetType, filename))
+}
+
+func (s s3FileService) GetVariantFullpath(assetType file.AssetType, filename file.Name, variant file.Variant) string {
+  if !assetType.HasVariant() || len(filename) == 0 || filename == file.NoFile {
+    return s.GetFullpath(assetType, filename)
+  }
+  if format, err := file.ParseFileFormat(filename.String()); err != nil || !format.HasVariant() {
+    return ""
+  }
+  return s.getURL(assetType, s.getVariantKey(assetType, filename, variant))
+}
+
+// getURL get the public url of the object, private asset will use presigned url of the private bucket instead
+func (s s3FileService) getURL(assetType file.AssetType, key string) string {
+  if !assetType.IsPrivate() {
+    return fmt.Sprintf("%s/%s", s.publicURL, key)
+  }
+  start, duration := file.SigningWindow(time.Now(), s.urlDuration)
+  return s.privateClient.PresignGetObject(key, start, duration)
+}
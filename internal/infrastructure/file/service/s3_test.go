@@ -0,0 +1,176 @@
+package service
+
+import (
+  "io"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/s3/s3test"
+  "net/http"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+)
+
+const (
+  testPublicBucket  = "public"
+  testPrivateBucket = "private"
+)
+
+func testS3Service(t *testing.T) (*s3test.Server, IFile, *memoryReference) {
+  server := s3test.NewServer()
+  t.Cleanup(server.Close)
+  server.CreateBucket(testPublicBucket, true)
+  server.CreateBucket(testPrivateBucket, false)
+
+  config := testConfig()
+  config.S3Endpoint = server.URL
+  config.S3Bucket = testPublicBucket
+  config.S3PrivateBucket = testPrivateBucket
+  config.S3AccessKey = s3test.AccessKey
+  config.S3SecretKey = s3test.SecretKey
+  config.S3PathStyle = true
+
+  references := newMemoryReference()
+  return server, NewS3FileService(config, references), references
+}
+
+func testGet(t *testing.T, url string) (int, []byte) {
+  response, err := http.Get(url)
+  require.NoError(t, err)
+  defer response.Body.Close()
+  data, err := io.ReadAll(response.Body)
+  require.NoError(t, err)
+  return response.StatusCode, data
+}
+
+func TestNewS3FileService_PrivateBucket(t *testing.T) {
+  for _, privateBucket := range []string{"", testPublicBucket} {
+    config := testConfig()
+    config.S3Endpoint = "http://localhost:9000"
+    config.S3Bucket = testPublicBucket
+    config.S3PrivateBucket = privateBucket
+    assert.Panics(t, func() { NewS3FileService(config, newMemoryReference()) })
+  }
+}
+
+func TestS3FileService_Upload_Public(t *testing.T) {
+  server, service, references := testS3Service(t)
+  data := testJPEG(t, 600, 300)
+
+  info, stat := service.Upload(file.CoverAsset, &testFileHeaders(t, []string{"cover.jpeg"}, [][]byte{data})[0])
+  require.False(t, stat.IsError())
+  assert.EqualValues(t, 1, references.Count(file.CoverAsset, info.Name))
+
+  want := []string{"covers/" + info.Name.String()}
+  for _, variant := range file.Variants {
+    want = append(want, "covers/"+variant.String()+"/"+info.Name.String())
+  }
+  assert.ElementsMatch(t, want, server.Keys(testPublicBucket))
+  assert.Empty(t, server.Keys(testPrivateBucket))
+
+  object, _ := server.Object(testPublicBucket, "covers/"+info.Name.String())
+  assert.Equal(t, "image/jpeg", object.ContentType)
+  assert.Equal(t, s3CacheControl, object.CacheControl)
+
+  // The public asset is read directly from the bucket
+  url := service.GetFullpath(file.CoverAsset, info.Name)
+  assert.Equal(t, server.URL+"/"+testPublicBucket+"/covers/"+info.Name.String(), url)
+  code, body := testGet(t, url)
+  assert.Equal(t, http.StatusOK, code)
+  assert.Equal(t, object.Data, body)
+
+  code, _ = testGet(t, service.GetVariantFullpath(file.CoverAsset, info.Name, file.VariantSmall))
+  assert.Equal(t, http.StatusOK, code)
+}
+
+func TestS3FileService_Uploads_Private(t *testing.T) {
+  server, service, references := testS3Service(t)
+  first, second := testJPEG(t, 600, 300), testJPEG(t, 300, 600)
+
+  staged, stat := service.Uploads(file.MangaAsset, testFileHeaders(t, []string{"1.jpg", "2.jpg"}, [][]byte{first, second}))
+  require.False(t, stat.IsError())
+  require.False(t, staged.Commit(nil).IsError())
+  infos := staged.Infos()
+  require.Len(t, infos, 2)
+
+  // The staged objects are removed after they are copied
+  var want []string
+  for _, info := range infos {
+    assert.EqualValues(t, 1, references.Count(file.MangaAsset, info.Name))
+    want = append(want, "mangas/"+info.Name.String())
+    for _, variant := range file.Variants {
+      want = append(want, "mangas/"+variant.String()+"/"+info.Name.String())
+    }
+  }
+  assert.ElementsMatch(t, want, server.Keys(testPrivateBucket))
+  assert.Empty(t, server.Keys(testPublicBucket))
+
+  key := "mangas/" + infos[0].Name.String()
+  object, _ := server.Object(testPrivateBucket, key)
+  assert.Equal(t, s3PrivateCacheControl, object.CacheControl)
+
+  // The private asset could only be read through presigned url
+  code, _ := testGet(t, server.URL+"/"+testPrivateBucket+"/"+key)
+  assert.Equal(t, http.StatusForbidden, code)
+
+  url := service.GetFullpath(file.MangaAsset, infos[0].Name)
+  assert.Contains(t, url, "X-Amz-Signature=")
+  code, body := testGet(t, url)
+  assert.Equal(t, http.StatusOK, code)
+  assert.Equal(t, object.Data, body)
+
+  variantURL := service.GetVariantFullpath(file.MangaAsset, infos[0].Name, file.VariantMedium)
+  assert.Contains(t, variantURL, "/"+testPrivateBucket+"/mangas/"+file.VariantMedium.String()+"/")
+  code, _ = testGet(t, variantURL)
+  assert.Equal(t, http.StatusOK, code)
+}
+
+func TestS3FileService_Uploads_Rollback(t *testing.T) {
+  server, service, _ := testS3Service(t)
+
+  staged, stat := service.Uploads(file.MangaAsset, testFileHeaders(t, []string{"1.jpg"}, [][]byte{testJPEG(t, 32, 32)}))
+  require.False(t, stat.IsError())
+  assert.NotEmpty(t, server.Keys(testPrivateBucket))
+
+  staged.Rollback()
+  assert.Empty(t, server.Keys(testPrivateBucket))
+}
+
+func TestS3FileService_Delete(t *testing.T) {
+  server, service, references := testS3Service(t)
+  data := testJPEG(t, 64, 64)
+
+  // The same content is only stored once
+  info, stat := service.Upload(file.ProfileAsset, &testFileHeaders(t, []string{"a.jpg"}, [][]byte{data})[0])
+  require.False(t, stat.IsError())
+  _, stat = service.Upload(file.ProfileAsset, &testFileHeaders(t, []string{"b.jpeg"}, [][]byte{data})[0])
+  require.False(t, stat.IsError())
+  require.EqualValues(t, 2, references.Count(file.ProfileAsset, info.Name))
+  stored := server.Keys(testPublicBucket)
+  assert.Len(t, stored, 1+len(file.Variants))
+
+  require.False(t, service.Delete(file.ProfileAsset, info.Name).IsError())
+  assert.Equal(t, stored, server.Keys(testPublicBucket))
+
+  require.False(t, service.Delete(file.ProfileAsset, info.Name).IsError())
+  assert.Empty(t, server.Keys(testPublicBucket))
+  assert.Zero(t, references.Count(file.ProfileAsset, info.Name))
+}
+
+func TestS3FileService_Open(t *testing.T) {
+  server, service, _ := testS3Service(t)
+  server.PutObject(testPrivateBucket, "mangas/page.png", s3test.Object{Data: []byte("page")})
+
+  src, stat := service.Open(file.MangaAsset, "page.png")
+  require.False(t, stat.IsError())
+  data, err := io.ReadAll(src)
+  require.NoError(t, src.Close())
+  require.NoError(t, err)
+  assert.Equal(t, []byte("page"), data)
+
+  _, stat = service.Open(file.MangaAsset, "missing.png")
+  assert.Equal(t, status.Error(status.OBJECT_NOT_FOUND).Code, stat.Code)
+  _, stat = service.Open(file.CoverAsset, "page.png")
+  assert.True(t, stat.IsError())
+}
@@ -1,15 +1,28 @@
 package service
 
 import (
+  "io"
   "manga-explorer/internal/common/status"
   "manga-explorer/internal/infrastructure/file"
   "mime/multipart"
 )
 
 type IFile interface {
-  Upload(types file.AssetType, header *multipart.FileHeader) (file.Name, status.Object)
-  Uploads(types file.AssetType, header []multipart.FileHeader) ([]file.Name, status.Object) // TODO: Handle when there is an error in the middle of uploading
+  // Upload save the file after the metadata is stripped, the image could be re-encoded based on the asset type, so
+  // the stored format and size are returned
+  Upload(types file.AssetType, header *multipart.FileHeader) (file.UploadInfo, status.Object)
+  // Uploads stage all the files, nothing is staged when one of them is failed. The caller should commit the staged
+  // files after the records using them are saved or roll them back otherwise
+  Uploads(types file.AssetType, header []multipart.FileHeader) (file.IStaged, status.Object)
+  // UploadFiles stage the content of the sources like Uploads, used when the files are not coming from multipart form
+  UploadFiles(types file.AssetType, sources []file.Source) (file.IStaged, status.Object)
   Delete(types file.AssetType, filename file.Name) status.Object
+  // Open read the stored file, the caller should close the reader
+  Open(types file.AssetType, filename file.Name) (io.ReadCloser, status.Object)
   Endpoint(assetType file.AssetType) string
+  // GetFullpath get the url of the file, private asset like manga pages gets signed url which will expire
   GetFullpath(assetType file.AssetType, filename file.Name) string
+  // GetVariantFullpath get the url of resized version of the image, it will return the original url when the asset
+  // type doesn't have variant and empty string when the variant could not be created from the format, like AVIF
+  GetVariantFullpath(assetType file.AssetType, filename file.Name, variant file.Variant) string
 }
@@ -0,0 +1,136 @@
+package service
+
+import (
+  "github.com/uptrace/bun"
+  "io"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
+  "mime/multipart"
+)
+
+// The staged files are stored under the asset directory, so the files left by crashed upload are collected by gc
+const stagingDir = ".staging"
+
+// stager storage which supports staging the file before it is stored
+type stager interface {
+  // stage write the processed file into the staging area and get the key of the staged file
+  stage(types file.AssetType, info file.UploadInfo, data []byte) (string, error)
+  // promote move the staged file into the storage with the name of the info
+  promote(types file.AssetType, key string, info file.UploadInfo) error
+  // discard remove the staged file, it is also called after the file is promoted
+  discard(types file.AssetType, key string)
+  Delete(types file.AssetType, filename file.Name) status.Object
+}
+
+// stageUploads process and stage all files, nothing is left on the staging area when one of them is failed
+func stageUploads(storage stager, processor uploadProcessor, references repository.IReference, types file.AssetType, files []multipart.FileHeader) (file.IStaged, status.Object) {
+  staged := &stagedFiles{
+    storage:    storage,
+    references: references,
+    types:      types,
+  }
+  for i := range files {
+    format, stat := processor.validateHeader(types, &files[i])
+    if stat.IsError() {
+      staged.Rollback()
+      return nil, stat
+    }
+
+    src, err := files[i].Open()
+    if err != nil {
+      staged.Rollback()
+      return nil, status.InternalError()
+    }
+    stat = staged.add(processor, format, src)
+    src.Close()
+    if stat.IsError() {
+      staged.Rollback()
+      return nil, stat
+    }
+  }
+  return staged, status.Success()
+}
+
+// stageFiles process and stage the content of all sources like stageUploads
+func stageFiles(storage stager, processor uploadProcessor, references repository.IReference, types file.AssetType, sources []file.Source) (file.IStaged, status.Object) {
+  staged := &stagedFiles{
+    storage:    storage,
+    references: references,
+    types:      types,
+  }
+  for _, source := range sources {
+    stat := staged.add(processor, source.Format, source.Reader)
+    if stat.IsError() {
+      staged.Rollback()
+      return nil, stat
+    }
+  }
+  return staged, status.Success()
+}
+
+type stagedFiles struct {
+  storage    stager
+  references repository.IReference
+  types      file.AssetType
+  keys       []string
+  infos      []file.UploadInfo
+  done       bool
+}
+
+// add process the file and write it into the staging area
+func (s *stagedFiles) add(processor uploadProcessor, format file.Format, src io.Reader) status.Object {
+  data, info, stat := processor.process(s.types, format, src)
+  if stat.IsError() {
+    return stat
+  }
+  info.Name = file.ContentName(info.Format, data)
+
+  key, err := s.storage.stage(s.types, info, data)
+  if err != nil {
+    return status.InternalError()
+  }
+  s.keys = append(s.keys, key)
+  s.infos = append(s.infos, info)
+  return status.Success()
+}
+
+func (s *stagedFiles) Infos() []file.UploadInfo {
+  return s.infos
+}
+
+func (s *stagedFiles) Commit(tx bun.IDB) status.Object {
+  if s.done {
+    return status.Success()
+  }
+  s.done = true
+  defer s.discard()
+
+  references := s.references.WithTx(tx)
+  for i, info := range s.infos {
+    // The staged file is only promoted when no one uses the same content yet
+    err := references.Acquire(s.types, info.Name, func() error {
+      return s.storage.promote(s.types, s.keys[i], info)
+    })
+    if err != nil {
+      // The references are rolled back with the transaction, the files which are already promoted are no longer
+      // referenced, so they are collected later by gc
+      return status.InternalError()
+    }
+  }
+  return status.Created()
+}
+
+func (s *stagedFiles) Rollback() {
+  if s.done {
+    return
+  }
+  s.done = true
+  s.discard()
+}
+
+func (s *stagedFiles) discard() {
+  for _, key := range s.keys {
+    s.storage.discard(s.types, key)
+  }
+}
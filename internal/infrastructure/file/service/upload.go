@@ -0,0 +1,112 @@
+package service
+
+import (
+  "errors"
+  "fmt"
+  "io"
+  "manga-explorer/internal/common"
+  "manga-explorer/internal/common/status"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/util"
+  "mime/multipart"
+)
+
+// newUploadProcessor create the validation and processing of uploaded image shared by all storages, it will panic
+// when the image processing config is invalid
+func newUploadProcessor(config *common.Config) uploadProcessor {
+  processor := uploadProcessor{config: config}
+  for _, asset := range util.SliceWrap(file.MangaAsset, file.ProfileAsset, file.CoverAsset, file.VolumeAsset) {
+    if !processor.processOption(asset).WebP.Validate() {
+      panic(fmt.Sprintf("Invalid WebP mode of %s, it should be lossless, near-lossless or empty", asset))
+    }
+  }
+  return processor
+}
+
+type uploadProcessor struct {
+  config *common.Config
+}
+
+// maxSize get the upload size limit of the asset type in bytes
+func (u uploadProcessor) maxSize(types file.AssetType) int64 {
+  switch types {
+  case file.MangaAsset:
+    return u.config.MaxPageSize << 20
+  case file.CoverAsset:
+    return u.config.MaxCoverSize << 20
+  case file.VolumeAsset:
+    return u.config.MaxVolumeSize << 20
+  case file.ProfileAsset:
+    return u.config.MaxProfileSize << 20
+  }
+  return 0
+}
+
+// processOption get the image processing of the asset type
+func (u uploadProcessor) processOption(types file.AssetType) file.ProcessOption {
+  var processing common.ImageProcessing
+  switch types {
+  case file.MangaAsset:
+    processing = u.config.PageProcessing
+  case file.CoverAsset:
+    processing = u.config.CoverProcessing
+  case file.VolumeAsset:
+    processing = u.config.VolumeProcessing
+  case file.ProfileAsset:
+    processing = u.config.ProfileProcessing
+  }
+  return file.ProcessOption{
+    WebP:    file.WebPMode(processing.WebP),
+    Quality: processing.WebPQuality,
+  }
+}
+
+// validateHeader check the size and the format from the filename of the multipart file before it is opened
+func (u uploadProcessor) validateHeader(types file.AssetType, fileHeader *multipart.FileHeader) (file.Format, status.Object) {
+  if fileHeader.Size > u.maxSize(types) {
+    return file.FormatUnknown, status.Error(status.FILE_TOO_LARGE)
+  }
+
+  // Get and validate format
+  format, err := file.ParseFileFormat(fileHeader.Filename)
+  if err != nil || !format.Validate() {
+    return file.FormatUnknown, status.Error(status.FILE_FORMAT_UNSUPPORTED)
+  }
+  return format, status.Success()
+}
+
+// process read the content, make sure it is matched with the format and process the image. The returned info doesn't
+// have the name yet.
+func (u uploadProcessor) process(types file.AssetType, format file.Format, src io.Reader) ([]byte, file.UploadInfo, status.Object) {
+  // The size of the reader is unknown, so read one more byte to check if it is exceeding the limit
+  maxSize := u.maxSize(types)
+  data, err := io.ReadAll(io.LimitReader(src, maxSize+1))
+  if err != nil {
+    return nil, file.UploadInfo{}, status.InternalError()
+  }
+  if int64(len(data)) > maxSize {
+    return nil, file.UploadInfo{}, status.Error(status.FILE_TOO_LARGE)
+  }
+
+  // Make sure the content is matched with the format
+  err = file.CheckFormat(data[:min(len(data), file.SniffLength)], format)
+  if errors.Is(err, file.ErrFormatMismatch) {
+    return nil, file.UploadInfo{}, status.Error(status.FILE_FORMAT_MISMATCH)
+  }
+  if err != nil || !format.Validate() {
+    return nil, file.UploadInfo{}, status.Error(status.FILE_FORMAT_UNSUPPORTED)
+  }
+
+  processed, processedFormat, err := file.ProcessImage(data, format, u.processOption(types))
+  if errors.Is(err, file.ErrImageTooLarge) {
+    return nil, file.UploadInfo{}, status.Error(status.FILE_TOO_LARGE)
+  }
+  if err != nil {
+    return nil, file.UploadInfo{}, status.Error(status.FILE_FORMAT_UNSUPPORTED)
+  }
+  return processed, file.UploadInfo{
+    Format:       processedFormat.Canonical(),
+    OriginalSize: int64(len(data)),
+    Size:         int64(len(processed)),
+  }, status.Success()
+}
@@ -0,0 +1,72 @@
+package file
+
+import (
+  "crypto/hmac"
+  "crypto/sha256"
+  "encoding/base64"
+  "errors"
+  "fmt"
+  "strconv"
+  "time"
+)
+
+var (
+  ErrSignatureInvalid = errors.New("url signature is invalid")
+  ErrSignatureExpired = errors.New("url signature is expired")
+)
+
+// IsPrivate check if the asset type should only be accessed through signed url, covers and avatars are public
+func (a AssetType) IsPrivate() bool {
+  return a == MangaAsset
+}
+
+// SigningWindow get the start time of current signing window and how long the url signed in it is valid. The window
+// is a quarter of the ttl, so the same url is created for the whole window and could be cached by the browser, while
+// it is still valid at least for the ttl.
+func SigningWindow(now time.Time, ttl time.Duration) (time.Time, time.Duration) {
+  window := max(ttl/4, time.Second)
+  return now.Truncate(window), ttl + window
+}
+
+func NewURLSigner(key []byte, ttl time.Duration) URLSigner {
+  return URLSigner{
+    key: key,
+    ttl: max(ttl, time.Second),
+    now: time.Now,
+  }
+}
+
+// URLSigner create and verify url signed with HMAC-SHA256 which is valid until the expiry time
+type URLSigner struct {
+  key []byte
+  ttl time.Duration
+  now func() time.Time
+}
+
+// Sign create the query of the signed url for the path
+func (s URLSigner) Sign(path string) string {
+  start, duration := SigningWindow(s.now(), s.ttl)
+  expires := strconv.FormatInt(start.Add(duration).Unix(), 10)
+  return fmt.Sprintf("expires=%s&signature=%s", expires, s.signature(path, expires))
+}
+
+// Verify check the signature of the path and make sure it is not expired yet
+func (s URLSigner) Verify(path, expires, signature string) error {
+  expiry, err := strconv.ParseInt(expires, 10, 64)
+  if err != nil {
+    return ErrSignatureInvalid
+  }
+  if !hmac.Equal([]byte(signature), []byte(s.signature(path, expires))) {
+    return ErrSignatureInvalid
+  }
+  if s.now().Unix() > expiry {
+    return ErrSignatureExpired
+  }
+  return nil
+}
+
+func (s URLSigner) signature(path, expires string) string {
+  mac := hmac.New(sha256.New, s.key)
+  mac.Write([]byte(path + "\n" + expires))
+  return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
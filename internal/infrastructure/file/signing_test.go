@@ -0,0 +1,148 @@
+package file
+
+import (
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+  "net/url"
+  "strconv"
+  "testing"
+  "time"
+)
+
+func TestURLSigner_Verify(t *testing.T) {
+  signedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+  signer := NewURLSigner([]byte("secret"), time.Hour)
+  signer.now = func() time.Time { return signedAt }
+
+  const path = "/static/mangas/abc.webp"
+  query, err := url.ParseQuery(signer.Sign(path))
+  require.NoError(t, err)
+  expires, signature := query.Get("expires"), query.Get("signature")
+  expiry, err := strconv.ParseInt(expires, 10, 64)
+  require.NoError(t, err)
+
+  otherSigner := NewURLSigner([]byte("other secret"), time.Hour)
+
+  type args struct {
+    path      string
+    expires   string
+    signature string
+  }
+  tests := []struct {
+    name    string
+    signer  URLSigner
+    now     time.Time
+    args    args
+    wantErr error
+  }{
+    {
+      name:   "Valid signature",
+      signer: signer,
+      now:    signedAt.Add(time.Minute),
+      args: args{
+        path:      path,
+        expires:   expires,
+        signature: signature,
+      },
+      wantErr: nil,
+    },
+    {
+      name:   "Valid at the expiry time",
+      signer: signer,
+      now:    time.Unix(expiry, 0),
+      args: args{
+        path:      path,
+        expires:   expires,
+        signature: signature,
+      },
+      wantErr: nil,
+    },
+    {
+      name:   "Expired signature",
+      signer: signer,
+      now:    time.Unix(expiry+1, 0),
+      args: args{
+        path:      path,
+        expires:   expires,
+        signature: signature,
+      },
+      wantErr: ErrSignatureExpired,
+    },
+    {
+      name:   "Modified path",
+      signer: signer,
+      now:    signedAt,
+      args: args{
+        path:      "/static/mangas/other.webp",
+        expires:   expires,
+        signature: signature,
+      },
+      wantErr: ErrSignatureInvalid,
+    },
+    {
+      name:   "Modified expiry",
+      signer: signer,
+      now:    signedAt,
+      args: args{
+        path:      path,
+        expires:   strconv.FormatInt(expiry+3600, 10),
+        signature: signature,
+      },
+      wantErr: ErrSignatureInvalid,
+    },
+    {
+      name:   "Malformed expiry",
+      signer: signer,
+      now:    signedAt,
+      args: args{
+        path:      path,
+        expires:   "tomorrow",
+        signature: signature,
+      },
+      wantErr: ErrSignatureInvalid,
+    },
+    {
+      name:   "Wrong key",
+      signer: otherSigner,
+      now:    signedAt,
+      args: args{
+        path:      path,
+        expires:   expires,
+        signature: signature,
+      },
+      wantErr: ErrSignatureInvalid,
+    },
+    {
+      name:   "Empty signature",
+      signer: signer,
+      now:    signedAt,
+      args: args{
+        path:      path,
+        expires:   expires,
+        signature: "",
+      },
+      wantErr: ErrSignatureInvalid,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      now := tt.now
+      tt.signer.now = func() time.Time { return now }
+      err := tt.signer.Verify(tt.args.path, tt.args.expires, tt.args.signature)
+      assert.ErrorIs(t, err, tt.wantErr)
+    })
+  }
+}
+
+func TestURLSigner_Sign(t *testing.T) {
+  signer := NewURLSigner([]byte("secret"), time.Hour)
+  signer.now = func() time.Time { return time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC) }
+  first := signer.Sign("/static/mangas/abc.webp")
+
+  // Same url is created in the signing window, so it could be cached
+  signer.now = func() time.Time { return time.Date(2024, 1, 1, 12, 14, 0, 0, time.UTC) }
+  assert.Equal(t, first, signer.Sign("/static/mangas/abc.webp"))
+
+  signer.now = func() time.Time { return time.Date(2024, 1, 1, 12, 15, 0, 0, time.UTC) }
+  assert.NotEqual(t, first, signer.Sign("/static/mangas/abc.webp"))
+}
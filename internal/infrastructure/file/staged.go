@@ -0,0 +1,24 @@
+package file
+
+import (
+  "github.com/uptrace/bun"
+  "io"
+  "manga-explorer/internal/common/status"
+)
+
+// Source content of the file which is not coming from multipart form, like the images inside an archive
+type Source struct {
+  Format Format
+  Reader io.Reader
+}
+
+// IStaged files which are uploaded to the staging area, they are not stored until committed
+type IStaged interface {
+  // Infos get the info of the staged files ordered as they are uploaded
+  Infos() []UploadInfo
+  // Commit move the staged files into the storage, either all of them are stored or none of them. The references of
+  // the files are added inside the transaction, so they are rolled back together with the records using the files
+  Commit(tx bun.IDB) status.Object
+  // Rollback discard the staged files, it does nothing after the files are committed
+  Rollback()
+}
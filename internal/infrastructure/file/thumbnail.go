@@ -0,0 +1,160 @@
+package file
+
+import (
+  "errors"
+  "image"
+  "image/draw"
+  "image/gif"
+  "image/jpeg"
+  "image/png"
+  "io"
+  "manga-explorer/internal/util"
+  "strconv"
+)
+
+var ErrVariantUnsupported = errors.New("format doesn't support variant")
+
+// Variant resized version of the image, the value is the maximum width
+type Variant uint16
+
+const (
+  VariantSmall  Variant = 256
+  VariantMedium Variant = 512
+  VariantLarge  Variant = 1024
+)
+
+var Variants = []Variant{VariantSmall, VariantMedium, VariantLarge}
+
+// ParseVariant parse the variant from the width, it will return false when the width is not one of the variants
+func ParseVariant(width string) (Variant, bool) {
+  value, err := strconv.ParseUint(width, 10, 16)
+  if err != nil {
+    return 0, false
+  }
+  variant := Variant(value)
+  return variant, util.IsOneOf(variant, Variants...)
+}
+
+func (v Variant) String() string {
+  return strconv.Itoa(int(v))
+}
+
+// HasVariant check if the asset type is image which could have variants
+func (a AssetType) HasVariant() bool {
+  return util.IsOneOf(a, MangaAsset, CoverAsset, ProfileAsset)
+}
+
+// HasVariant check if the variant of the image format could be created, AVIF doesn't have variant because it could not
+// be decoded
+func (f Format) HasVariant() bool {
+  return util.IsOneOf(f, FormatJPG, FormatJPEG, FormatPNG, FormatGIF, FormatWebP)
+}
+
+// variantQuality quality of the lossy variants, JPEG and WebP
+const variantQuality = 85
+
+// WriteVariant resize the image into the variant width and keep the aspect ratio. The EXIF orientation of JPEG is
+// applied first, because the variant doesn't have the metadata. The image which is already smaller than the variant is
+// written as is. Only the first frame of animated GIF is used.
+func WriteVariant(dst io.Writer, src []byte, format Format, variant Variant) error {
+  if !format.HasVariant() {
+    return ErrVariantUnsupported
+  }
+
+  img, err := decodeImage(src)
+  if err != nil {
+    return err
+  }
+  img = orient(img, jpegOrientation(src))
+  if img.Bounds().Dx() <= int(variant) {
+    _, err = dst.Write(src)
+    return err
+  }
+
+  resized := resize(img, int(variant))
+  switch format {
+  case FormatPNG:
+    return png.Encode(dst, resized)
+  case FormatGIF:
+    return gif.Encode(dst, resized, &gif.Options{NumColors: 256, Drawer: draw.FloydSteinberg})
+  case FormatWebP:
+    return EncodeWebP(dst, resized, variantQuality)
+  default:
+    return jpeg.Encode(dst, resized, &jpeg.Options{Quality: variantQuality})
+  }
+}
+
+// orient transform the image based on the EXIF orientation, so it is displayed the same way without the metadata.
+// See https://magnushoff.com/articles/jpeg-orientation/
+func orient(img image.Image, orientation uint16) image.Image {
+  if orientation < 2 || orientation > 8 {
+    return img
+  }
+
+  bounds := img.Bounds()
+  width, height := bounds.Dx(), bounds.Dy()
+  // Orientation 5 until 8 are rotated by 90 degrees, so the dimension is swapped
+  dstWidth, dstHeight := width, height
+  if orientation >= 5 {
+    dstWidth, dstHeight = height, width
+  }
+  dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+  for y := 0; y < height; y++ {
+    for x := 0; x < width; x++ {
+      var dx, dy int
+      switch orientation {
+      case 2: // Mirrored horizontally
+        dx, dy = width-1-x, y
+      case 3: // Rotated 180 degrees
+        dx, dy = width-1-x, height-1-y
+      case 4: // Mirrored vertically
+        dx, dy = x, height-1-y
+      case 5: // Transposed
+        dx, dy = y, x
+      case 6: // Rotated 90 degrees clockwise
+        dx, dy = height-1-y, x
+      case 7: // Transversed
+        dx, dy = height-1-y, width-1-x
+      case 8: // Rotated 90 degrees counterclockwise
+        dx, dy = y, width-1-x
+      }
+      dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+    }
+  }
+  return dst
+}
+
+// resize downscale the image by averaging the source pixels covered by each destination pixel
+func resize(img image.Image, width int) *image.RGBA {
+  bounds := img.Bounds()
+  height := max(1, bounds.Dy()*width/bounds.Dx())
+  dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+  for y := 0; y < height; y++ {
+    startY := bounds.Min.Y + y*bounds.Dy()/height
+    endY := max(startY+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+    for x := 0; x < width; x++ {
+      startX := bounds.Min.X + x*bounds.Dx()/width
+      endX := max(startX+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+      var r, g, b, a, count uint64
+      for sy := startY; sy < endY; sy++ {
+        for sx := startX; sx < endX; sx++ {
+          pr, pg, pb, pa := img.At(sx, sy).RGBA()
+          r += uint64(pr)
+          g += uint64(pg)
+          b += uint64(pb)
+          a += uint64(pa)
+          count++
+        }
+      }
+
+      offset := dst.PixOffset(x, y)
+      for i, value := range [4]uint64{r / count, g / count, b / count, a / count} {
+        dst.Pix[offset+i] = uint8(value >> 8)
+      }
+    }
+  }
+  return dst
+}
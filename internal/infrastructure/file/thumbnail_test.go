@@ -0,0 +1,162 @@
+package file
+
+import (
+  "bytes"
+  "fmt"
+  "image"
+  "image/color"
+  "image/gif"
+  "image/jpeg"
+  "image/png"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+)
+
+var (
+  testRed  = color.RGBA{R: 255, A: 255}
+  testBlue = color.RGBA{B: 255, A: 255}
+)
+
+// testQuadrantImage create image which the top left quadrant is red and the rest is blue
+func testQuadrantImage(width, height int) *image.RGBA {
+  img := image.NewRGBA(image.Rect(0, 0, width, height))
+  for y := 0; y < height; y++ {
+    for x := 0; x < width; x++ {
+      if x < width/2 && y < height/2 {
+        img.Set(x, y, testRed)
+      } else {
+        img.Set(x, y, testBlue)
+      }
+    }
+  }
+  return img
+}
+
+func testEncode(t *testing.T, img image.Image, format Format) []byte {
+  buf := &bytes.Buffer{}
+  var err error
+  switch format {
+  case FormatJPG, FormatJPEG:
+    err = jpeg.Encode(buf, img, &jpeg.Options{Quality: 95})
+  case FormatPNG:
+    err = png.Encode(buf, img)
+  case FormatGIF:
+    err = gif.Encode(buf, img, nil)
+  case FormatWebP:
+    err = EncodeWebP(buf, img, 100)
+  default:
+    t.Fatalf("unsupported format %s", format)
+  }
+  require.NoError(t, err)
+  return buf.Bytes()
+}
+
+func isRed(c color.Color) bool {
+  r, g, b, _ := c.RGBA()
+  return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func TestWriteVariant(t *testing.T) {
+  for _, format := range []Format{FormatJPG, FormatJPEG, FormatPNG, FormatGIF, FormatWebP} {
+    src := testEncode(t, testQuadrantImage(1200, 600), format)
+    for _, variant := range Variants {
+      t.Run(fmt.Sprintf("%s %s", format, variant), func(t *testing.T) {
+        dst := &bytes.Buffer{}
+        require.NoError(t, WriteVariant(dst, src, format, variant))
+
+        detected, err := DetectFormat(dst.Bytes())
+        require.NoError(t, err)
+        assert.True(t, detected.Equal(format))
+
+        img, _, err := image.Decode(dst)
+        require.NoError(t, err)
+        assert.Equal(t, int(variant), img.Bounds().Dx())
+        assert.Equal(t, int(variant)/2, img.Bounds().Dy())
+        assert.True(t, isRed(img.At(int(variant)/4, int(variant)/8)))
+        assert.False(t, isRed(img.At(int(variant)*3/4, int(variant)*3/8)))
+      })
+    }
+  }
+}
+
+func TestWriteVariant_Smaller(t *testing.T) {
+  for _, format := range []Format{FormatJPG, FormatPNG, FormatGIF, FormatWebP} {
+    t.Run(format.String(), func(t *testing.T) {
+      src := testEncode(t, testQuadrantImage(100, 50), format)
+      dst := &bytes.Buffer{}
+      require.NoError(t, WriteVariant(dst, src, format, VariantSmall))
+      assert.Equal(t, src, dst.Bytes())
+    })
+  }
+}
+
+func TestWriteVariant_Unsupported(t *testing.T) {
+  avif := []byte("\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf")
+  dst := &bytes.Buffer{}
+  assert.ErrorIs(t, WriteVariant(dst, avif, FormatAVIF, VariantSmall), ErrVariantUnsupported)
+  assert.Zero(t, dst.Len())
+  assert.False(t, Format(FormatAVIF).HasVariant())
+}
+
+func TestWriteVariant_Invalid(t *testing.T) {
+  dst := &bytes.Buffer{}
+  assert.ErrorIs(t, WriteVariant(dst, []byte("not an image"), FormatPNG, VariantSmall), ErrImageInvalid)
+}
+
+func TestWriteVariant_TooLarge(t *testing.T) {
+  dst := &bytes.Buffer{}
+  assert.ErrorIs(t, WriteVariant(dst, testHugePNG(t), FormatPNG, VariantSmall), ErrImageTooLarge)
+  assert.Zero(t, dst.Len())
+}
+
+func TestWriteVariant_Orientation(t *testing.T) {
+  // The red quadrant is on the top left of the stored image, the corner is where it is displayed
+  tests := []struct {
+    orientation uint16
+    rotated     bool
+    right       bool
+    bottom      bool
+  }{
+    {orientation: 1},
+    {orientation: 2, right: true},
+    {orientation: 3, right: true, bottom: true},
+    {orientation: 4, bottom: true},
+    {orientation: 5, rotated: true},
+    {orientation: 6, rotated: true, right: true},
+    {orientation: 7, rotated: true, right: true, bottom: true},
+    {orientation: 8, rotated: true, bottom: true},
+  }
+  for _, tt := range tests {
+    t.Run(fmt.Sprintf("Orientation %d", tt.orientation), func(t *testing.T) {
+      encoded := testEncode(t, testQuadrantImage(600, 300), FormatJPEG)
+      src := append(append(append([]byte{}, encoded[:2]...), orientationSegment(tt.orientation)...), encoded[2:]...)
+      require.Equal(t, tt.orientation, jpegOrientation(src))
+
+      dst := &bytes.Buffer{}
+      require.NoError(t, WriteVariant(dst, src, FormatJPEG, VariantSmall))
+      // The variant doesn't have the metadata, so the orientation should be applied to the pixels
+      assert.Zero(t, jpegOrientation(dst.Bytes()))
+
+      img, err := jpeg.Decode(dst)
+      require.NoError(t, err)
+      width, height := 256, 128
+      if tt.rotated {
+        height = 512
+      }
+      require.Equal(t, width, img.Bounds().Dx())
+      require.Equal(t, height, img.Bounds().Dy())
+
+      x, y := width/4, height/4
+      if tt.right {
+        x = width * 3 / 4
+      }
+      if tt.bottom {
+        y = height * 3 / 4
+      }
+      assert.True(t, isRed(img.At(x, y)))
+      assert.False(t, isRed(img.At(width-1-x, height-1-y)))
+    })
+  }
+}
@@ -0,0 +1,483 @@
+package file
+
+import (
+  "encoding/binary"
+  "errors"
+  "image"
+  "image/color"
+  "io"
+  "math/bits"
+  "slices"
+)
+
+// WebPMaxDimension maximum width and height of WebP image
+const WebPMaxDimension = 16384
+
+var ErrWebPTooLarge = errors.New("image is too large for WebP")
+
+const (
+  vp8lPredictorBits = 4 // Block size of the predictor transform is 16x16
+  vp8lGreenAlphabet = 256 + 24
+  vp8lDistAlphabet  = 40
+)
+
+var vp8lCodeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// EncodeWebP encode the image as VP8L (lossless WebP). Quality lower than 100 quantize the color values before encoding
+// (near-lossless), so the image compress better while the alpha is kept exact.
+// See https://datatracker.ietf.org/doc/html/rfc9649
+func EncodeWebP(dst io.Writer, img image.Image, quality int) error {
+  bounds := img.Bounds()
+  width, height := bounds.Dx(), bounds.Dy()
+  if width == 0 || height == 0 {
+    return ErrImageInvalid
+  }
+  if width > WebPMaxDimension || height > WebPMaxDimension {
+    return ErrWebPTooLarge
+  }
+
+  // Drop the lower bits of each color based on the quality, 100 is lossless
+  shift := uint(min(5, max(0, (100-quality+19)/20)))
+
+  pixels := make([]uint32, 0, width*height)
+  hasAlpha := false
+  for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+    for x := bounds.Min.X; x < bounds.Max.X; x++ {
+      c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+      if c.A != 0xFF {
+        hasAlpha = true
+      }
+      pixels = append(pixels, uint32(c.A)<<24|
+        uint32(quantise(c.R, shift))<<16|uint32(quantise(c.G, shift))<<8|uint32(quantise(c.B, shift)))
+    }
+  }
+
+  writer := bitWriter{}
+  writer.write(0x2F, 8)
+  writer.write(uint32(width-1), 14)
+  writer.write(uint32(height-1), 14)
+  if hasAlpha {
+    writer.write(1, 1)
+  } else {
+    writer.write(0, 1)
+  }
+  writer.write(0, 3) // Version
+
+  // Subtract green transform
+  writer.write(1, 1)
+  writer.write(2, 2)
+  for i, pixel := range pixels {
+    green := pixel >> 8 & 0xFF
+    red := (pixel>>16 - green) & 0xFF
+    blue := (pixel - green) & 0xFF
+    pixels[i] = pixel&0xFF00FF00 | red<<16 | blue
+  }
+
+  // Predictor transform
+  writer.write(1, 1)
+  writer.write(0, 2)
+  writer.write(vp8lPredictorBits-2, 3)
+  modes, residuals := predict(pixels, width, height)
+  writeEntropyImage(&writer, modes, (width+(1<<vp8lPredictorBits)-1)>>vp8lPredictorBits, false)
+
+  // No more transform
+  writer.write(0, 1)
+  writeEntropyImage(&writer, residuals, width, true)
+
+  data := writer.bytes()
+  padding := len(data) % 2
+  header := make([]byte, 20)
+  copy(header[0:], "RIFF")
+  binary.LittleEndian.PutUint32(header[4:], uint32(12+len(data)+padding))
+  copy(header[8:], "WEBPVP8L")
+  binary.LittleEndian.PutUint32(header[16:], uint32(len(data)))
+  if _, err := dst.Write(header); err != nil {
+    return err
+  }
+  if _, err := dst.Write(data); err != nil {
+    return err
+  }
+  if padding != 0 {
+    _, err := dst.Write([]byte{0})
+    return err
+  }
+  return nil
+}
+
+func quantise(value uint8, shift uint) uint8 {
+  if shift == 0 {
+    return value
+  }
+  rounded := (uint32(value) + 1<<(shift-1)) >> shift << shift
+  return uint8(min(rounded, 0xFF))
+}
+
+// predict choose the predictor mode of each block which has the smallest residuals, only left (1), top (2) and
+// select (11) are used. It returns the modes as sub-image and the residuals of each pixel.
+func predict(pixels []uint32, width, height int) ([]uint32, []uint32) {
+  blockSize := 1 << vp8lPredictorBits
+  blocksX := (width + blockSize - 1) / blockSize
+  blocksY := (height + blockSize - 1) / blockSize
+
+  modes := make([]uint32, blocksX*blocksY)
+  residuals := make([]uint32, len(pixels))
+  for by := 0; by < blocksY; by++ {
+    for bx := 0; bx < blocksX; bx++ {
+      bestMode, bestCost := 1, -1
+      for _, mode := range []int{1, 2, 11} {
+        cost := 0
+        for y := by * blockSize; y < min(height, (by+1)*blockSize); y++ {
+          for x := bx * blockSize; x < min(width, (bx+1)*blockSize); x++ {
+            cost += residualCost(subPixels(pixels[y*width+x], predictPixel(pixels, width, x, y, mode)))
+          }
+        }
+        if bestCost < 0 || cost < bestCost {
+          bestMode, bestCost = mode, cost
+        }
+      }
+
+      modes[by*blocksX+bx] = uint32(bestMode) << 8 // Mode is stored on the green channel
+      for y := by * blockSize; y < min(height, (by+1)*blockSize); y++ {
+        for x := bx * blockSize; x < min(width, (bx+1)*blockSize); x++ {
+          residuals[y*width+x] = subPixels(pixels[y*width+x], predictPixel(pixels, width, x, y, bestMode))
+        }
+      }
+    }
+  }
+  return modes, residuals
+}
+
+func predictPixel(pixels []uint32, width, x, y, mode int) uint32 {
+  switch {
+  case x == 0 && y == 0:
+    return 0xFF000000
+  case y == 0:
+    return pixels[x-1]
+  case x == 0:
+    return pixels[(y-1)*width]
+  }
+
+  left := pixels[y*width+x-1]
+  top := pixels[(y-1)*width+x]
+  switch mode {
+  case 1:
+    return left
+  case 2:
+    return top
+  default:
+    topLeft := pixels[(y-1)*width+x-1]
+    distanceLeft, distanceTop := 0, 0
+    for shift := 0; shift < 32; shift += 8 {
+      l, t, tl := int(left>>shift&0xFF), int(top>>shift&0xFF), int(topLeft>>shift&0xFF)
+      estimate := l + t - tl
+      distanceLeft += abs(estimate - l)
+      distanceTop += abs(estimate - t)
+    }
+    if distanceLeft < distanceTop {
+      return left
+    }
+    return top
+  }
+}
+
+func subPixels(a, b uint32) uint32 {
+  result := uint32(0)
+  for shift := 0; shift < 32; shift += 8 {
+    result |= (a>>shift - b>>shift) & 0xFF << shift
+  }
+  return result
+}
+
+func residualCost(residual uint32) int {
+  cost := 0
+  for shift := 0; shift < 32; shift += 8 {
+    cost += abs(int(int8(residual >> shift)))
+  }
+  return cost
+}
+
+func abs(value int) int {
+  if value < 0 {
+    return -value
+  }
+  return value
+}
+
+// writeEntropyImage write the pixels with single prefix code group and without color cache. Repeated pixels of the
+// left or top neighbour are written as backward references, which is the common case for the flat area.
+func writeEntropyImage(writer *bitWriter, pixels []uint32, width int, isMain bool) {
+  writer.write(0, 1) // No color cache
+  if isMain {
+    writer.write(0, 1) // No meta prefix codes
+  }
+
+  tokens := backwardReferences(pixels, width)
+  histograms := [5][]uint32{
+    make([]uint32, vp8lGreenAlphabet),
+    make([]uint32, 256),
+    make([]uint32, 256),
+    make([]uint32, 256),
+    make([]uint32, vp8lDistAlphabet),
+  }
+  for _, token := range tokens {
+    if token.length == 0 {
+      histograms[0][token.pixel>>8&0xFF]++
+      histograms[1][token.pixel>>16&0xFF]++
+      histograms[2][token.pixel&0xFF]++
+      histograms[3][token.pixel>>24]++
+      continue
+    }
+    lengthPrefix, _, _ := lz77Prefix(token.length)
+    distancePrefix, _, _ := lz77Prefix(token.distance)
+    histograms[0][256+lengthPrefix]++
+    histograms[4][distancePrefix]++
+  }
+
+  var codes [5][]uint32
+  var lengths [5][]uint8
+  for i, histogram := range histograms {
+    codes[i], lengths[i] = writePrefixCode(writer, histogram)
+  }
+
+  for _, token := range tokens {
+    if token.length == 0 {
+      for i, symbol := range [4]uint32{token.pixel >> 8 & 0xFF, token.pixel >> 16 & 0xFF, token.pixel & 0xFF, token.pixel >> 24} {
+        writer.write(codes[i][symbol], uint(lengths[i][symbol]))
+      }
+      continue
+    }
+    lengthPrefix, lengthBits, lengthExtra := lz77Prefix(token.length)
+    writer.write(codes[0][256+lengthPrefix], uint(lengths[0][256+lengthPrefix]))
+    writer.write(lengthExtra, lengthBits)
+    distancePrefix, distanceBits, distanceExtra := lz77Prefix(token.distance)
+    writer.write(codes[4][distancePrefix], uint(lengths[4][distancePrefix]))
+    writer.write(distanceExtra, distanceBits)
+  }
+}
+
+// vp8lToken literal pixel or backward reference when the length is not zero
+type vp8lToken struct {
+  pixel    uint32
+  length   uint32
+  distance uint32 // Distance code, 1 is the top pixel and 2 is the left pixel
+}
+
+// backwardReferences find the longest run of the pixels which are the same as the left or the top one
+func backwardReferences(pixels []uint32, width int) []vp8lToken {
+  const minLength, maxLength = 3, 4096
+
+  tokens := make([]vp8lToken, 0, len(pixels)/4)
+  for i := 0; i < len(pixels); {
+    leftLength, topLength := 0, 0
+    for i > 0 && i+leftLength < len(pixels) && leftLength < maxLength && pixels[i+leftLength] == pixels[i+leftLength-1] {
+      leftLength++
+    }
+    for i >= width && i+topLength < len(pixels) && topLength < maxLength && pixels[i+topLength] == pixels[i+topLength-width] {
+      topLength++
+    }
+
+    switch {
+    case max(leftLength, topLength) < minLength:
+      tokens = append(tokens, vp8lToken{pixel: pixels[i]})
+      i++
+    case leftLength >= topLength:
+      tokens = append(tokens, vp8lToken{length: uint32(leftLength), distance: 2})
+      i += leftLength
+    default:
+      tokens = append(tokens, vp8lToken{length: uint32(topLength), distance: 1})
+      i += topLength
+    }
+  }
+  return tokens
+}
+
+// lz77Prefix split the length or distance code into the prefix symbol and the extra bits
+func lz77Prefix(value uint32) (uint32, uint, uint32) {
+  value--
+  if value < 4 {
+    return value, 0, 0
+  }
+  highest := uint(bits.Len32(value) - 1)
+  second := value >> (highest - 1) & 1
+  extraBits := highest - 1
+  return uint32(2*highest) + second, extraBits, value & (1<<extraBits - 1)
+}
+
+// writePrefixCode write the prefix code of the histogram and return the codes with the lengths of each symbol
+func writePrefixCode(writer *bitWriter, histogram []uint32) ([]uint32, []uint8) {
+  symbols := []int{}
+  for symbol, count := range histogram {
+    if count > 0 {
+      symbols = append(symbols, symbol)
+    }
+  }
+
+  lengths := make([]uint8, len(histogram))
+  // Simple code for one or two symbols, single symbol doesn't use any bit
+  if len(symbols) <= 2 && (len(symbols) == 0 || symbols[len(symbols)-1] < 256) {
+    if len(symbols) == 0 {
+      symbols = append(symbols, 0)
+    }
+    writer.write(1, 1)
+    writer.write(uint32(len(symbols)-1), 1)
+    if symbols[0] < 2 {
+      writer.write(0, 1)
+      writer.write(uint32(symbols[0]), 1)
+    } else {
+      writer.write(1, 1)
+      writer.write(uint32(symbols[0]), 8)
+    }
+    if len(symbols) == 2 {
+      writer.write(uint32(symbols[1]), 8)
+      lengths[symbols[0]], lengths[symbols[1]] = 1, 1
+    }
+    return canonicalCodes(lengths), lengths
+  }
+
+  lengths = huffmanLengths(histogram, 15)
+
+  // Code lengths are written literally using another prefix code, which needs at least two symbols
+  lengthHistogram := make([]uint32, 19)
+  for _, length := range lengths {
+    lengthHistogram[length]++
+  }
+  used := 0
+  for _, count := range lengthHistogram {
+    if count > 0 {
+      used++
+    }
+  }
+  if used == 1 {
+    if lengthHistogram[0] == 0 {
+      lengthHistogram[0] = 1
+    } else {
+      lengthHistogram[1] = 1
+    }
+  }
+  lengthLengths := huffmanLengths(lengthHistogram, 7)
+  lengthCodes := canonicalCodes(lengthLengths)
+
+  count := 4
+  for i, symbol := range vp8lCodeLengthOrder {
+    if lengthLengths[symbol] != 0 {
+      count = max(count, i+1)
+    }
+  }
+  writer.write(0, 1) // Normal code
+  writer.write(uint32(count-4), 4)
+  for _, symbol := range vp8lCodeLengthOrder[:count] {
+    writer.write(uint32(lengthLengths[symbol]), 3)
+  }
+  writer.write(0, 1) // All symbols are written
+  for _, length := range lengths {
+    writer.write(lengthCodes[length], uint(lengthLengths[length]))
+  }
+  return canonicalCodes(lengths), lengths
+}
+
+// huffmanLengths create the code length of each symbol, the counts are flattened until the longest code is not
+// exceeding the limit
+func huffmanLengths(histogram []uint32, limit int) []uint8 {
+  type node struct {
+    weight  uint64
+    symbols []int
+  }
+
+  counts := slices.Clone(histogram)
+  lengths := make([]uint8, len(histogram))
+  for {
+    nodes := []node{}
+    for symbol, count := range counts {
+      if count > 0 {
+        nodes = append(nodes, node{weight: uint64(count), symbols: []int{symbol}})
+      }
+    }
+
+    clear(lengths)
+    for len(nodes) > 1 {
+      slices.SortStableFunc(nodes, func(a, b node) int {
+        if a.weight < b.weight {
+          return -1
+        }
+        if a.weight > b.weight {
+          return 1
+        }
+        return 0
+      })
+      merged := node{weight: nodes[0].weight + nodes[1].weight}
+      for _, child := range nodes[:2] {
+        for _, symbol := range child.symbols {
+          lengths[symbol]++
+        }
+        merged.symbols = append(merged.symbols, child.symbols...)
+      }
+      nodes = append(nodes[2:], merged)
+    }
+
+    if int(slices.Max(lengths)) <= limit {
+      return lengths
+    }
+    for symbol, count := range counts {
+      if count > 0 {
+        counts[symbol] = (count + 1) / 2
+      }
+    }
+  }
+}
+
+// canonicalCodes assign the canonical prefix codes, the bits are reversed because the bit stream is written from the
+// least significant bit
+func canonicalCodes(lengths []uint8) []uint32 {
+  var lengthCount [16]uint32
+  for _, length := range lengths {
+    lengthCount[length]++
+  }
+  lengthCount[0] = 0
+
+  var next [16]uint32
+  code := uint32(0)
+  for length := 1; length < 16; length++ {
+    code = (code + lengthCount[length-1]) << 1
+    next[length] = code
+  }
+
+  codes := make([]uint32, len(lengths))
+  for symbol, length := range lengths {
+    if length == 0 {
+      continue
+    }
+    value := next[length]
+    next[length]++
+
+    reversed := uint32(0)
+    for i := uint8(0); i < length; i++ {
+      reversed = reversed<<1 | value>>i&1
+    }
+    codes[symbol] = reversed
+  }
+  return codes
+}
+
+// bitWriter write the bits from the least significant bit as used by VP8L
+type bitWriter struct {
+  data  []byte
+  bits  uint64
+  count uint
+}
+
+func (b *bitWriter) write(value uint32, count uint) {
+  b.bits |= uint64(value) << b.count
+  b.count += count
+  for b.count >= 8 {
+    b.data = append(b.data, byte(b.bits))
+    b.bits >>= 8
+    b.count -= 8
+  }
+}
+
+func (b *bitWriter) bytes() []byte {
+  if b.count > 0 {
+    return append(b.data, byte(b.bits))
+  }
+  return b.data
+}
@@ -0,0 +1,110 @@
+package file
+
+import (
+  "bytes"
+  "fmt"
+  "image"
+  "image/color"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+  "github.com/stretchr/testify/require"
+  "golang.org/x/image/webp"
+)
+
+// testGradientImage create image with different color on each pixel, the dimension is not multiple of the predictor
+// block size, so the partial blocks are also encoded
+func testGradientImage(width, height int, alpha bool) *image.NRGBA {
+  img := image.NewNRGBA(image.Rect(0, 0, width, height))
+  for y := 0; y < height; y++ {
+    for x := 0; x < width; x++ {
+      c := color.NRGBA{R: uint8(x * 255 / width), G: uint8(y * 255 / height), B: uint8((x*7 + y*13) % 256), A: 0xFF}
+      if alpha {
+        c.A = uint8((x + y) * 255 / (width + height))
+      }
+      img.SetNRGBA(x, y, c)
+    }
+  }
+  return img
+}
+
+func colorDiff(a, b uint8) int {
+  if a > b {
+    return int(a - b)
+  }
+  return int(b - a)
+}
+
+func TestEncodeWebP(t *testing.T) {
+  tests := []struct {
+    name      string
+    img       *image.NRGBA
+    quality   int
+    tolerance int
+  }{
+    {
+      name:    "Lossless",
+      img:     testGradientImage(37, 23, false),
+      quality: 100,
+    },
+    {
+      name:    "Lossless with alpha",
+      img:     testGradientImage(37, 23, true),
+      quality: 100,
+    },
+    {
+      name:    "Single pixel",
+      img:     testGradientImage(1, 1, false),
+      quality: 100,
+    },
+    {
+      name:      "Near lossless",
+      img:       testGradientImage(64, 40, false),
+      quality:   80,
+      tolerance: 1 << 1,
+    },
+    {
+      name:      "Lowest quality",
+      img:       testGradientImage(64, 40, true),
+      quality:   0,
+      tolerance: 1 << 4,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      buf := &bytes.Buffer{}
+      require.NoError(t, EncodeWebP(buf, tt.img, tt.quality))
+
+      format, err := DetectFormat(buf.Bytes())
+      require.NoError(t, err)
+      assert.EqualValues(t, FormatWebP, format)
+
+      decoded, err := webp.Decode(buf)
+      require.NoError(t, err)
+      require.Equal(t, tt.img.Bounds(), decoded.Bounds())
+
+      bounds := tt.img.Bounds()
+      for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+        for x := bounds.Min.X; x < bounds.Max.X; x++ {
+          want := tt.img.NRGBAAt(x, y)
+          got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+          // The alpha is always kept exact
+          require.Equal(t, want.A, got.A, fmt.Sprintf("alpha at %d,%d", x, y))
+          if want.A == 0 {
+            continue
+          }
+          for i, pair := range [][2]uint8{{want.R, got.R}, {want.G, got.G}, {want.B, got.B}} {
+            require.LessOrEqual(t, colorDiff(pair[0], pair[1]), tt.tolerance, fmt.Sprintf("channel %d at %d,%d", i, x, y))
+          }
+        }
+      }
+    })
+  }
+}
+
+func TestEncodeWebP_Invalid(t *testing.T) {
+  buf := &bytes.Buffer{}
+  assert.ErrorIs(t, EncodeWebP(buf, image.NewNRGBA(image.Rect(0, 0, 0, 10)), 100), ErrImageInvalid)
+  assert.ErrorIs(t, EncodeWebP(buf, image.NewNRGBA(image.Rect(0, 0, WebPMaxDimension+1, 1)), 100), ErrWebPTooLarge)
+  assert.Zero(t, buf.Len())
+}
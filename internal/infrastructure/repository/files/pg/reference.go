@@ -0,0 +1,125 @@
+package pg
+
+import (
+  "context"
+  "database/sql"
+  "errors"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/infrastructure/file"
+  "manga-explorer/internal/infrastructure/file/repository"
+  "time"
+)
+
+// The row is locked while the file is written or deleted, so it needs longer timeout for remote storage
+const referenceTimeout = time.Minute
+
+func NewReference(db bun.IDB) repository.IReference {
+  return &referenceRepository{db: db}
+}
+
+type referenceRepository struct {
+  db bun.IDB
+}
+
+func (r referenceRepository) WithTx(tx bun.IDB) repository.IReference {
+  return &referenceRepository{db: tx}
+}
+
+func (r referenceRepository) Acquire(types file.AssetType, name file.Name, store func() error) error {
+  ctx, cancel := context.WithTimeout(context.Background(), referenceTimeout)
+  defer cancel()
+
+  return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+    // The conflicting row is locked until the transaction ends, so concurrent release can't delete the file
+    reference := file.NewReference(types, name)
+    _, err := tx.NewInsert().
+      Model(&reference).
+      On("CONFLICT (asset_type, name) DO UPDATE").
+      Set("ref_count = ref.ref_count + 1").
+      Set("updated_at = EXCLUDED.updated_at").
+      Returning("ref_count").
+      Exec(ctx)
+    if err != nil {
+      return err
+    }
+    if reference.RefCount > 1 {
+      return nil
+    }
+    return store()
+  })
+}
+
+func (r referenceRepository) Release(types file.AssetType, name file.Name, remove func() error) error {
+  ctx, cancel := context.WithTimeout(context.Background(), referenceTimeout)
+  defer cancel()
+
+  return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+    reference := file.Reference{}
+    err := tx.NewUpdate().
+      Model(&reference).
+      Set("ref_count = ref_count - 1").
+      Where("asset_type = ? AND name = ?", types, name).
+      Returning("ref_count").
+      Scan(ctx)
+    if errors.Is(err, sql.ErrNoRows) {
+      // The file is uploaded before the references are counted, so it is only used by the caller
+      return remove()
+    }
+    if err != nil {
+      return err
+    }
+    if reference.RefCount > 0 {
+      return nil
+    }
+
+    err = remove()
+    if err != nil {
+      return err
+    }
+    _, err = tx.NewDelete().
+      Model(&reference).
+      Where("asset_type = ? AND name = ?", types, name).
+      Exec(ctx)
+    return err
+  })
+}
+
+func (r referenceRepository) Collect(types file.AssetType, name file.Name, before time.Time, remove func() error) (bool, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), referenceTimeout)
+  defer cancel()
+
+  collected := false
+  err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+    // Lock the row, so the file can't be acquired again while it is deleted
+    reference := file.Reference{}
+    err := tx.NewSelect().
+      Model(&reference).
+      Where("asset_type = ? AND name = ?", types, name).
+      For("UPDATE").
+      Scan(ctx)
+    if errors.Is(err, sql.ErrNoRows) {
+      // The file is uploaded before the references are counted
+      err = remove()
+      collected = err == nil
+      return err
+    }
+    if err != nil {
+      return err
+    }
+    if !reference.UpdatedAt.Before(before) {
+      return nil
+    }
+
+    err = remove()
+    if err != nil {
+      return err
+    }
+    _, err = tx.NewDelete().
+      Model(&reference).
+      WherePK().
+      Exec(ctx)
+    collected = err == nil
+    return err
+  })
+  return collected, err
+}
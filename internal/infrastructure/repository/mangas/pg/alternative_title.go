@@ -0,0 +1,53 @@
+package pg
+
+import (
+  "context"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/repository"
+  "manga-explorer/internal/util"
+  "time"
+)
+
+func NewAlternativeTitleRepository(db bun.IDB) repository.IAlternativeTitle {
+  return &alternativeTitleRepository{db: db}
+}
+
+type alternativeTitleRepository struct {
+  db bun.IDB
+}
+
+func (a alternativeTitleRepository) Create(titles []mangas.AlternativeTitle) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := a.db.NewInsert().
+    Model(&titles).
+    Returning("NULL").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (a alternativeTitleRepository) FindByMangaId(mangaId string) ([]mangas.AlternativeTitle, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.AlternativeTitle
+  err := a.db.NewSelect().
+    Model(&result).
+    Where("manga_id = ?", mangaId).
+    Order("kind", "language", "title").
+    Scan(ctx)
+  return util.CheckSliceResult(result, err).Unwrap()
+}
+
+func (a alternativeTitleRepository) DeleteMangaSpecific(mangaId string, titleIds []string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := a.db.NewDelete().
+    Model(util.Nil[mangas.AlternativeTitle]()).
+    Where("manga_id = ? AND id IN (?)", mangaId, bun.In(titleIds)).
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
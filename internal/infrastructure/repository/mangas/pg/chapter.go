@@ -2,15 +2,23 @@ package pg
 
 import (
   "context"
+  "database/sql"
+  "errors"
   "github.com/uptrace/bun"
+  "github.com/uptrace/bun/driver/pgdriver"
   "manga-explorer/internal/domain/mangas"
   "manga-explorer/internal/domain/mangas/repository"
   repo "manga-explorer/internal/infrastructure/repository"
   "manga-explorer/internal/util"
   "manga-explorer/internal/util/containers"
+  "slices"
   "time"
 )
 
+// pageCommitTimeout time given to commit the image of each page inside the transaction of the pages, committing the
+// image could copy it on remote storage
+const pageCommitTimeout = 5 * time.Second
+
 func NewMangaChapter(db bun.IDB) repository.IChapter {
   return &chapterRepository{db: db}
 }
@@ -19,27 +27,243 @@ type chapterRepository struct {
   db bun.IDB
 }
 
-func (c chapterRepository) CreateChapter(chapter *mangas.Chapter) error {
+func (c chapterRepository) CreateChapter(chapter *mangas.Chapter, groups []mangas.ChapterGroup) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
-  res, err := c.db.NewInsert().
+  // Make sure the volume is belonged to the same manga
+  if len(chapter.VolumeId) != 0 {
+    exists, err := c.db.NewSelect().
+      Model((*mangas.Volume)(nil)).
+      Where("id = ? AND manga_id = ?", chapter.VolumeId, chapter.MangaId).
+      Exists(ctx)
+    if err != nil {
+      return err
+    }
+    if !exists {
+      return sql.ErrNoRows
+    }
+  }
+
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  res, err := tx.NewInsert().
     Model(chapter).
     Returning("NULL").
     Exec(ctx)
-  return util.CheckSqlResult(res, err)
+
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return util.CheckSqlResult(res, err)
+  }
+
+  // Credit groups
+  if len(groups) > 0 {
+    res, err = tx.NewInsert().
+      Model(&groups).
+      Returning("NULL").
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return groupChapterError(err)
+    }
+  }
+
+  // Chapter without group is checked after the groups are credited
+  if err = checkChapterConstraint(ctx, tx); err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
+}
+
+// groupChapterError get mangas.ErrGroupChapterDuplicate when the group is credited on the same chapter and
+// mangas.ErrChapterDuplicate when the chapter without group already exists, it is checked by the database when the
+// credited groups or the chapter is changed
+func groupChapterError(err error) error {
+  var pgErr pgdriver.Error
+  if errors.As(err, &pgErr) {
+    switch pgErr.Field('n') {
+    case mangas.ChapterGroupConstraint:
+      return mangas.ErrGroupChapterDuplicate
+    case mangas.ChapterConstraint:
+      return mangas.ErrChapterDuplicate
+    }
+  }
+  return err
+}
+
+// checkChapterConstraint run the deferred check of chapters without group, so the violation is returned before the
+// transaction is committed
+func checkChapterConstraint(ctx context.Context, tx bun.Tx) error {
+  _, err := tx.ExecContext(ctx, "SET CONSTRAINTS ? IMMEDIATE", bun.Ident(mangas.ChapterConstraint))
+  return groupChapterError(err)
 }
 
 func (c chapterRepository) EditChapter(chapter *mangas.Chapter) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
-  res, err := c.db.NewUpdate().
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  query := tx.NewUpdate().
     Model(chapter).
     WherePK().
-    ExcludeColumn("id", "translator_id", "created_at").
-    Exec(ctx)
-  return util.CheckSqlResult(res, err)
+    ExcludeColumn("id", "manga_id", "translator_id", "created_at")
+
+  // Only allow volume which is belonged to the same manga
+  if len(chapter.VolumeId) != 0 {
+    query = query.Where("EXISTS (SELECT 1 FROM volumes WHERE volumes.id = ? AND volumes.manga_id = chapter.manga_id)", chapter.VolumeId)
+  }
+
+  res, err := query.Exec(ctx)
+  err = util.CheckSqlResult(res, err)
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return groupChapterError(err)
+  }
+
+  // The changed chapter could be the same as other chapter without group
+  if err = checkChapterConstraint(ctx, tx); err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
+}
+
+func (c chapterRepository) EditChapterGroups(additionals, removes []mangas.ChapterGroup) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  // Add groups
+  if len(additionals) > 0 {
+    res, err := tx.NewInsert().
+      Model(&additionals).
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return groupChapterError(util.CheckSqlResult(res, err))
+    }
+  }
+
+  // Remove groups
+  if len(removes) > 0 {
+    res, err := tx.NewDelete().
+      Model(&removes).
+      WherePK().
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  // Removing all groups could make the chapter the same as other chapter without group
+  if err = checkChapterConstraint(ctx, tx); err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
+}
+
+func (c chapterRepository) MoveChapters(mangaId, volumeId string, chapterIds []string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  // Empty volume id will detach the chapters
+  var volume any
+  if len(volumeId) != 0 {
+    volume = volumeId
+  }
+
+  query := tx.NewUpdate().
+    Model((*mangas.Chapter)(nil)).
+    Set("volume_id = ?", volume).
+    Set("updated_at = ?", time.Now()).
+    Where("manga_id = ? AND id IN (?)", mangaId, bun.In(chapterIds))
+
+  if volume != nil {
+    query = query.Where("EXISTS (SELECT 1 FROM volumes WHERE volumes.id = ? AND volumes.manga_id = chapter.manga_id)", volumeId)
+  }
+
+  res, err := query.Exec(ctx)
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return groupChapterError(err)
+  }
+
+  // All chapters should be moved, otherwise some of them are not belonged to the manga
+  affected, err := res.RowsAffected()
+  if err != nil || affected != int64(len(chapterIds)) {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    if err != nil {
+      return err
+    }
+    return sql.ErrNoRows
+  }
+
+  // The moved chapters could be the same as other chapters without group on the volume
+  if err = checkChapterConstraint(ctx, tx); err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
 }
 
 func (c chapterRepository) DeleteChapter(chapterId string) error {
@@ -68,8 +292,12 @@ func (c chapterRepository) FindChapter(id string) (*mangas.Chapter, error) {
       return query.Order("page.number")
     }).
     Relation("Translator").
+    Relation("Groups").
+    Relation("Manga", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Column("id", "original_title")
+    }).
     Where("chapter.id = ?", id).
-    Group("chapter.id", "translator.id").
+    Group("chapter.id", "translator.id", "manga.id").
     Scan(ctx)
   if err != nil {
     return nil, err
@@ -77,6 +305,100 @@ func (c chapterRepository) FindChapter(id string) (*mangas.Chapter, error) {
   return chapter, nil
 }
 
+func (c chapterRepository) FindMangaChapters(filter *mangas.ChapterFilter, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Chapter], error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.Chapter
+  query := c.db.NewSelect().
+    Model(&result).
+    ColumnExpr("chapter.*").
+    Relation("Translator").
+    Relation("Groups").
+    Where("chapter.manga_id = ?", filter.MangaId)
+
+  if filter.HasUser() {
+    query = query.ColumnExpr("EXISTS (?) AS is_read", c.db.NewSelect().
+      Model(util.Nil[mangas.ChapterHistory]()).
+      Where("chapter_history.chapter_id = chapter.id AND chapter_history.user_id = ?", filter.UserId))
+  }
+  if filter.HasLanguage() {
+    query = query.Where("chapter.language = ?", filter.Language)
+  }
+  if filter.HasVolume() {
+    query = query.Where("chapter.volume_id = ?", filter.VolumeId)
+  }
+  if filter.HasTranslator() {
+    query = query.Where("chapter.translator_id = ?", filter.TranslatorId)
+  }
+
+  direction := "ASC"
+  if filter.IsDescending {
+    direction = "DESC"
+  }
+  switch filter.Order {
+  case mangas.ChapterOrderPublishDate:
+    query = query.OrderExpr("chapter.publish_date "+direction+" NULLS LAST").
+      OrderExpr("chapter.number " + direction)
+  default:
+    query = query.OrderExpr("chapter.number " + direction)
+  }
+  // Make the pagination stable for chapters with the same number
+  query = query.OrderExpr("chapter.created_at " + direction).
+    OrderExpr("chapter.id")
+
+  query = parameter.Insert(query)
+  count, err := query.ScanAndCount(ctx)
+
+  res := util.CheckSliceResult(result, err)
+  return repo.NewResult(res.Data, count), res.Err
+}
+
+func (c chapterRepository) FindSiblingChapters(chapter *mangas.Chapter) (*mangas.Chapter, *mangas.Chapter, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  prev, err := c.findSiblingChapter(ctx, chapter, false)
+  if err != nil {
+    return nil, nil, err
+  }
+  next, err := c.findSiblingChapter(ctx, chapter, true)
+  if err != nil {
+    return nil, nil, err
+  }
+  return prev, next, nil
+}
+
+func (c chapterRepository) findSiblingChapter(ctx context.Context, chapter *mangas.Chapter, isNext bool) (*mangas.Chapter, error) {
+  operator, direction := "<", "DESC"
+  if isNext {
+    operator, direction = ">", "ASC"
+  }
+
+  result := new(mangas.Chapter)
+  err := c.db.NewSelect().
+    Model(result).
+    Column("id", "language", "title", "number", "label").
+    Where("manga_id = ?", chapter.MangaId).
+    Where("number "+operator+" ?", chapter.Number).
+    // Chapters credited to the same group first, then the same language, then any chapter
+    OrderExpr("EXISTS (SELECT 1 FROM chapter_groups AS cg JOIN chapter_groups AS own ON own.group_id = cg.group_id "+
+      "WHERE cg.chapter_id = chapter.id AND own.chapter_id = ?) DESC", chapter.Id).
+    OrderExpr("language = ? DESC", chapter.Language).
+    OrderExpr("number " + direction).
+    OrderExpr("created_at").
+    Limit(1).
+    Scan(ctx)
+
+  if errors.Is(err, sql.ErrNoRows) {
+    return nil, nil
+  }
+  if err != nil {
+    return nil, err
+  }
+  return result, nil
+}
+
 func (c chapterRepository) FindVolumeDetails(volumeId string) (*mangas.Volume, error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
@@ -93,6 +415,63 @@ func (c chapterRepository) FindVolumeDetails(volumeId string) (*mangas.Volume, e
         Group("chapter.id", "translator.id")
     }).
     Relation("Chapters.Translator").
+    Relation("Chapters.Groups").
+    Where("volume.id = ?", volumeId).
+    Scan(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+  return volume, nil
+}
+
+func (c chapterRepository) FindChapterArchive(chapterId string) (*mangas.Chapter, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  chapter := new(mangas.Chapter)
+  err := c.db.NewSelect().
+    Model(chapter).
+    Relation("Pages", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("page.number")
+    }).
+    Relation("Translator").
+    Relation("Groups").
+    Relation("Volume").
+    Relation("Manga").
+    Relation("Manga.Genres").
+    Relation("Manga.Tags").
+    Relation("Manga.Staff.Person").
+    Relation("Manga.Translations").
+    Where("chapter.id = ?", chapterId).
+    Scan(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+  return chapter, nil
+}
+
+func (c chapterRepository) FindVolumeArchive(volumeId string) (*mangas.Volume, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  volume := new(mangas.Volume)
+  err := c.db.NewSelect().
+    Model(volume).
+    Relation("Manga").
+    Relation("Manga.Genres").
+    Relation("Manga.Tags").
+    Relation("Manga.Staff.Person").
+    Relation("Manga.Translations").
+    Relation("Chapters", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("chapter.number", "chapter.created_at")
+    }).
+    Relation("Chapters.Pages", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("page.number")
+    }).
+    Relation("Chapters.Translator").
+    Relation("Chapters.Groups").
     Where("volume.id = ?", volumeId).
     Scan(ctx)
 
@@ -127,17 +506,139 @@ func (c chapterRepository) DeleteChapterPages(chapterId string, pages []uint16)
   return util.CheckSqlResult(res, err)
 }
 
-func (c chapterRepository) InsertChapterPages(pages []mangas.Page) error {
+func (c chapterRepository) InsertChapterPages(pages []mangas.Page, commit func(tx bun.IDB) error) error {
+  // The images are committed inside the transaction, so the timeout grows with the number of pages
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5+time.Duration(len(pages))*pageCommitTimeout)
+  defer cancel()
+
+  return c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+    res, err := tx.NewInsert().
+      Model(&pages).
+      Returning("NULL").
+      Exec(ctx)
+    err = util.CheckSqlResult(res, err)
+    if err != nil || commit == nil {
+      return err
+    }
+    return commit(tx)
+  })
+}
+
+func (c chapterRepository) ReorderChapterPages(chapterId string, pageIds []string) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
-  res, err := c.db.NewInsert().
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  err = c.reorderChapterPages(ctx, tx, chapterId, pageIds)
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
+}
+
+func (c chapterRepository) reorderChapterPages(ctx context.Context, tx bun.Tx, chapterId string, pageIds []string) error {
+  var currentIds []string
+  err := tx.NewSelect().
+    Model(util.Nil[mangas.Page]()).
+    Column("id").
+    Where("chapter_id = ?", chapterId).
+    For("UPDATE").
+    Scan(ctx, &currentIds)
+  if err != nil {
+    return err
+  }
+  if len(currentIds) == 0 {
+    return sql.ErrNoRows
+  }
+
+  // The new order should contain exactly the same pages
+  if len(currentIds) != len(pageIds) {
+    return mangas.ErrPageOrderMismatch
+  }
+  for _, id := range pageIds {
+    if !slices.Contains(currentIds, id) {
+      return mangas.ErrPageOrderMismatch
+    }
+  }
+
+  // Negate the numbers first, so the unique constraint of the chapter and number is not violated while renumbering
+  _, err = tx.NewUpdate().
+    Model(util.Nil[mangas.Page]()).
+    Set("number = -number").
+    Where("chapter_id = ?", chapterId).
+    Exec(ctx)
+  if err != nil {
+    return err
+  }
+
+  pages := make([]mangas.Page, 0, len(pageIds))
+  for i, id := range pageIds {
+    pages = append(pages, mangas.Page{Id: id, Number: uint16(i + 1)})
+  }
+  res, err := tx.NewUpdate().
     Model(&pages).
-    Returning("NULL").
+    Column("number").
+    Bulk().
     Exec(ctx)
+
   return util.CheckSqlResult(res, err)
 }
 
+func (c chapterRepository) ReplaceChapterPage(page *mangas.Page, commit func(tx bun.IDB) error) (*mangas.Page, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5+pageCommitTimeout)
+  defer cancel()
+
+  tx, err := c.db.BeginTx(ctx, nil)
+  if err != nil {
+    return nil, err
+  }
+
+  previous, err := c.replaceChapterPage(ctx, tx, page)
+  if err == nil && commit != nil {
+    err = commit(tx)
+  }
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return nil, err2
+    }
+    return nil, err
+  }
+
+  return previous, tx.Commit()
+}
+
+func (c chapterRepository) replaceChapterPage(ctx context.Context, tx bun.Tx, page *mangas.Page) (*mangas.Page, error) {
+  previous := new(mangas.Page)
+  err := tx.NewSelect().
+    Model(previous).
+    Where("chapter_id = ? AND number = ?", page.ChapterId, page.Number).
+    For("UPDATE").
+    Scan(ctx)
+  if err != nil {
+    return nil, err
+  }
+
+  res, err := tx.NewUpdate().
+    Model(page).
+    Column("image_url", "width", "height", "size", "mime_type", "blur_hash").
+    Where("id = ?", previous.Id).
+    Exec(ctx)
+  if err = util.CheckSqlResult(res, err); err != nil {
+    return nil, err
+  }
+  return previous, nil
+}
+
 func (c chapterRepository) InsertChapterHistories(history *mangas.ChapterHistory) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
@@ -163,7 +664,7 @@ func (c chapterRepository) FindMangaChapterHistories(userId string, mangaId stri
     Relation("Chapter").
     Relation("Chapter.Translator").
     Relation("Chapter.Volume").
-    Where("user_id = ? AND chapter__volume.manga_id = ?", userId, mangaId).
+    Where("user_id = ? AND chapter.manga_id = ?", userId, mangaId).
     OrderExpr("last_view DESC")
 
   query = pagedQuery.Insert(query)
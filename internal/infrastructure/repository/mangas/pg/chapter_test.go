@@ -18,7 +18,7 @@ import (
   "time"
 )
 
-func createChapterForTest(volumeId, translatorId, title string, lang countries.CountryCode, chapter uint64, id ...string) *mangas.Chapter {
+func createChapterForTest(volumeId, translatorId, title string, lang countries.CountryCode, chapter float64, id ...string) *mangas.Chapter {
   tmp := mangas.NewChapter(volumeId, translatorId, title, lang, chapter, time.Now())
 
   if len(id) == 1 {
@@ -28,7 +28,7 @@ func createChapterForTest(volumeId, translatorId, title string, lang countries.C
   return &tmp
 }
 
-func createChapterForTest2(volumeId, translatorId, title string, lang string, chapter uint64, id ...string) *mangas.Chapter {
+func createChapterForTest2(volumeId, translatorId, title string, lang string, chapter float64, id ...string) *mangas.Chapter {
   tmp := mangas.NewChapter(volumeId, translatorId, title, countries.Indonesia, chapter, time.Now())
 
   tmp.Language = common.Language(lang)
@@ -136,7 +136,7 @@ func Test_chapterRepository_CreateChapter(t *testing.T) {
         require.NoError(t, tx.Rollback())
       }(tx)
 
-      err := c.CreateChapter(tt.args.chapter)
+      err := c.CreateChapter(tt.args.chapter, nil)
       if !tt.wantErr(t, err) {
         t.Errorf("CreateChapter(%v)", tt.args.chapter)
         return
@@ -165,6 +165,33 @@ func Test_chapterRepository_CreateChapter(t *testing.T) {
   }
 }
 
+func Test_chapterRepository_CreateChapter_Ungrouped(t *testing.T) {
+  tx, err := Db.Begin()
+  require.NoError(t, err)
+  defer func(tx bun.Tx) {
+    require.NoError(t, tx.Rollback())
+  }(tx)
+  c := NewMangaChapter(tx)
+
+  newChapter := func(volumeId string, lang countries.CountryCode) *mangas.Chapter {
+    chapter := createChapterForTest(volumeId, "4afa29b2-d543-4489-b8ef-93f57781c9f6", "Title", lang, 1000.5)
+    chapter.MangaId = "df3be3a1-f02f-4d2e-afe8-83dc61f46839"
+    return chapter
+  }
+
+  require.NoError(t, c.CreateChapter(newChapter("92077652-ebc9-413c-8bfc-7f72a60a128c", countries.Indonesia), nil))
+  // Same number and language on the same volume
+  err = c.CreateChapter(newChapter("92077652-ebc9-413c-8bfc-7f72a60a128c", countries.Indonesia), nil)
+  assert.ErrorIs(t, err, mangas.ErrChapterDuplicate)
+  // Different language
+  assert.NoError(t, c.CreateChapter(newChapter("92077652-ebc9-413c-8bfc-7f72a60a128c", countries.Japan), nil))
+
+  // Chapters without volume are also unique
+  require.NoError(t, c.CreateChapter(newChapter("", countries.Indonesia), nil))
+  err = c.CreateChapter(newChapter("", countries.Indonesia), nil)
+  assert.ErrorIs(t, err, mangas.ErrChapterDuplicate)
+}
+
 func Test_chapterRepository_DeleteChapter(t *testing.T) {
   type args struct {
     chapterId string
@@ -763,7 +790,7 @@ func Test_chapterRepository_InsertChapterPage(t *testing.T) {
       defer func(tx2 bun.Tx) {
         require.NoError(t, tx2.Rollback())
       }(tx)
-      err := c.InsertChapterPages(tt.args.pages)
+      err := c.InsertChapterPages(tt.args.pages, nil)
       if !tt.wantErr(t, err) {
         t.Errorf(fmt.Sprintf("InsertChapterPages(%v)", tt.args.pages))
         return
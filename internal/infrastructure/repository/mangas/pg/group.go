@@ -0,0 +1,167 @@
+package pg
+
+import (
+  "context"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/repository"
+  repo "manga-explorer/internal/infrastructure/repository"
+  "manga-explorer/internal/util"
+  "strings"
+  "time"
+)
+
+func NewGroup(db bun.IDB) repository.IGroup {
+  return &groupRepository{db: db}
+}
+
+type groupRepository struct {
+  db bun.IDB
+}
+
+func (g groupRepository) CreateGroup(group *mangas.Group) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := g.db.NewInsert().
+    Model(group).
+    Returning("NULL").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (g groupRepository) UpdateGroup(group *mangas.Group) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := g.db.NewUpdate().
+    Model(group).
+    WherePK().
+    ExcludeColumn("id", "created_at").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (g groupRepository) DeleteGroupById(groupId string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := g.db.NewDelete().
+    Model(util.Nil[mangas.Group]()).
+    Where("id = ?", groupId).
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (g groupRepository) FindGroupById(groupId string) (*mangas.Group, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  result := new(mangas.Group)
+  err := g.db.NewSelect().
+    Model(result).
+    Relation("Members", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("group_member.role", "group_member.created_at")
+    }).
+    Relation("Members.User").
+    Where("grp.id = ?", groupId).
+    Scan(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+  return result, nil
+}
+
+func (g groupRepository) ListGroups(name string, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Group], error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.Group
+  query := g.db.NewSelect().
+    Model(&result).
+    Order("grp.name")
+
+  if len(name) > 0 {
+    query = query.Where("LOWER(grp.name) LIKE ?", "%"+strings.ToLower(name)+"%")
+  }
+  query = parameter.Insert(query)
+
+  count, err := query.ScanAndCount(ctx)
+
+  res := util.CheckSliceResult(result, err)
+  return repo.NewResult(res.Data, count), res.Err
+}
+
+func (g groupRepository) EditGroupMembers(additionals, removes []mangas.GroupMember) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  tx, err := g.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  // Add members or update the role
+  if len(additionals) > 0 {
+    res, err := tx.NewInsert().
+      Model(&additionals).
+      On("CONFLICT (group_id, user_id) DO UPDATE").
+      Set("role = EXCLUDED.role").
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  // Remove members
+  if len(removes) > 0 {
+    res, err := tx.NewDelete().
+      Model(&removes).
+      WherePK().
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  return tx.Commit()
+}
+
+func (g groupRepository) FindGroupChapters(groupId string, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Chapter], error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.Chapter
+  query := g.db.NewSelect().
+    Model(&result).
+    Relation("Manga", g.excludeMangaColumns).
+    Relation("Translator").
+    Relation("Groups").
+    Where("chapter.id IN (?)", g.db.NewSelect().
+      Model(util.Nil[mangas.ChapterGroup]()).
+      Column("chapter_id").
+      Where("group_id = ?", groupId)).
+    Order("chapter.created_at DESC")
+
+  query = parameter.Insert(query)
+
+  count, err := query.ScanAndCount(ctx)
+
+  res := util.CheckSliceResult(result, err)
+  return repo.NewResult(res.Data, count), res.Err
+}
+
+func (g groupRepository) excludeMangaColumns(query *bun.SelectQuery) *bun.SelectQuery {
+  return query.Column("id", "original_title")
+}
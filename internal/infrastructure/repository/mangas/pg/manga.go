@@ -28,12 +28,45 @@ func (m mangaRepository) DeleteVolume(mangaId string, volumes []uint32) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
-  res, err := m.db.NewDelete().
+  tx, err := m.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  volumeIdQuery := tx.NewSelect().
+    Model((*mangas.Volume)(nil)).
+    Column("id").
+    Where("manga_id = ? AND number IN (?)", mangaId, bun.In(volumes))
+
+  // Detach the chapters, so they will not be deleted along with the volume
+  _, err = tx.NewUpdate().
+    Model((*mangas.Chapter)(nil)).
+    Set("volume_id = NULL").
+    Where("volume_id IN (?)", volumeIdQuery).
+    Exec(ctx)
+
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  res, err := tx.NewDelete().
     Model((*mangas.Volume)(nil)).
     Where("manga_id = ? AND number IN (?)", mangaId, bun.In(volumes)).
     Exec(ctx)
 
-  return util.CheckSqlResult(res, err)
+  if err = util.CheckSqlResult(res, err); err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
 }
 
 func (m mangaRepository) CreateManga(mangas *mangas.Manga, genres []mangas.MangaGenre) error {
@@ -83,7 +116,7 @@ func (m mangaRepository) EditManga(manga *mangas.Manga) error {
   res, err := m.db.NewUpdate().
     Model(manga).
     WherePK().
-    ExcludeColumn("created_at", "id", "cover_url").
+    ExcludeColumn("created_at", "id", "cover_url", "slug", "allow_download", "allow_export").
     Exec(ctx)
 
   return util.CheckSqlResult(res, err)
@@ -148,6 +181,163 @@ func (m mangaRepository) EditMangaGenres(additionals, removes []mangas.MangaGenr
   return tx.Commit()
 }
 
+func (m mangaRepository) EditMangaTags(additionals, removes []mangas.MangaTag) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  tx, err := m.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  // Add tags
+  if len(additionals) > 0 {
+    res, err := tx.NewInsert().
+      Model(&additionals).
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  // Remove tags
+  if len(removes) > 0 {
+    res, err := tx.NewDelete().
+      Model(&removes).
+      WherePK().
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  return tx.Commit()
+}
+
+func (m mangaRepository) EditMangaStaff(additionals, removes []mangas.MangaStaff) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  tx, err := m.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  // Add staff
+  if len(additionals) > 0 {
+    res, err := tx.NewInsert().
+      Model(&additionals).
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  // Remove staff
+  if len(removes) > 0 {
+    res, err := tx.NewDelete().
+      Model(&removes).
+      WherePK().
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return util.CheckSqlResult(res, err)
+    }
+  }
+
+  return tx.Commit()
+}
+
+func (m mangaRepository) CreateMangaRelation(relation *mangas.MangaRelation) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+  defer cancel()
+
+  tx, err := m.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  res, err := tx.NewInsert().
+    Model(relation).
+    Returning("NULL").
+    Exec(ctx)
+
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return util.CheckSqlResult(res, err)
+  }
+
+  // Inverse relation, ignore it when the edge is already created manually
+  inverse, ok := relation.Inverse()
+  if ok {
+    _, err = tx.NewInsert().
+      Model(&inverse).
+      On("CONFLICT DO NOTHING").
+      Returning("NULL").
+      Exec(ctx)
+
+    if err != nil {
+      err2 := tx.Rollback()
+      if err2 != nil {
+        return err2
+      }
+      return err
+    }
+  }
+
+  return tx.Commit()
+}
+
+func (m mangaRepository) DeleteMangaRelation(mangaId, relatedId string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewDelete().
+    Model(util.Nil[mangas.MangaRelation]()).
+    WhereOr("manga_id = ? AND related_id = ?", mangaId, relatedId).
+    WhereOr("manga_id = ? AND related_id = ?", relatedId, mangaId).
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaRepository) FindMangaRelations(mangaId string) ([]mangas.MangaRelation, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.MangaRelation
+  err := m.db.NewSelect().
+    Model(&result).
+    Relation("Related").
+    Where("manga_relation.manga_id = ?", mangaId).
+    Order("manga_relation.kind", "related.publication_year").
+    Scan(ctx)
+
+  return util.CheckSliceResult(result, err).Unwrap()
+}
+
 func (m mangaRepository) FindMangasByFilter(filter *mangas.SearchFilter, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Manga], error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
   defer cancel()
@@ -158,11 +348,32 @@ func (m mangaRepository) FindMangasByFilter(filter *mangas.SearchFilter, pagedQu
     Join("LEFT JOIN manga_genres ON manga_genres.manga_id = manga.id").
     Join("LEFT JOIN genres ON genres.id = manga_genres.genre_id").
     Relation("Genres").
+    Relation("Tags").
+    Where("manga.content_rating IN (?)", bun.In(filter.ContentRatings)).
     Order("manga.original_title")
 
   if len(filter.Title) > 0 {
     title := "%" + strings.ToLower(filter.Title) + "%"
-    query = query.Where("LOWER(manga.original_title) LIKE ?", title)
+    altTitleQuery := m.db.NewSelect().
+      Model(util.Nil[mangas.AlternativeTitle]()).
+      Column("alternative_title.manga_id").
+      Where("LOWER(alternative_title.title) LIKE ?", title)
+
+    query = query.WhereGroup(" AND ", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Where("LOWER(manga.original_title) LIKE ?", title).
+        WhereOr("manga.id IN (?)", altTitleQuery)
+    })
+  }
+
+  if filter.HasStaff() {
+    name := "%" + strings.ToLower(filter.Staff) + "%"
+    staffQuery := m.db.NewSelect().
+      Model(util.Nil[mangas.MangaStaff]()).
+      Join("JOIN people ON people.id = manga_staff.person_id").
+      Column("manga_staff.manga_id").
+      Where("LOWER(people.name) LIKE ? OR LOWER(people.native_name) LIKE ?", name, name)
+
+    query = query.Where("manga.id IN (?)", staffQuery)
   }
 
   if filter.HasOrigin() {
@@ -201,6 +412,19 @@ func (m mangaRepository) FindMangasByFilter(filter *mangas.SearchFilter, pagedQu
     //query = query.Except(exceptQuery)
   }
 
+  // Tags are using subquery, so it will not multiply the genres join
+  if filter.Tags.HasInclude() {
+    includeQuery := m.mangaTagQuery(filter.Tags.Include)
+    if filter.Tags.IsAndOperation {
+      includeQuery = includeQuery.Having("COUNT(DISTINCT tags.id) >= ?", len(filter.Tags.Include))
+    }
+    query = query.Where("manga.id IN (?)", includeQuery)
+  }
+
+  if filter.Tags.HasExclude() {
+    query = query.Where("manga.id NOT IN (?)", m.mangaTagQuery(filter.Tags.Exclude))
+  }
+
   // Paged
   query = pagedQuery.Insert(query)
   count, err := query.ScanAndCount(ctx)
@@ -209,6 +433,16 @@ func (m mangaRepository) FindMangasByFilter(filter *mangas.SearchFilter, pagedQu
   return repo.NewResult(res.Data, count), res.Err
 }
 
+// mangaTagQuery get query of manga ids which has any of the tags
+func (m mangaRepository) mangaTagQuery(tagNames []string) *bun.SelectQuery {
+  return m.db.NewSelect().
+    Table("manga_tags").
+    Join("JOIN tags ON tags.id = manga_tags.tag_id").
+    Column("manga_tags.manga_id").
+    Where("tags.name IN (?)", bun.In(tagNames)).
+    Group("manga_tags.manga_id")
+}
+
 func (m mangaRepository) getMangaSelectQuery(model any) *bun.SelectQuery {
   return m.db.NewSelect().
     Model(model).
@@ -222,13 +456,15 @@ func (m mangaRepository) getMangaSelectQuery(model any) *bun.SelectQuery {
     Group("manga.id")
 }
 
-func (m mangaRepository) FindRandomMangas(limit uint64) ([]mangas.Manga, error) {
+func (m mangaRepository) FindRandomMangas(limit uint64, ratings []mangas.ContentRating) ([]mangas.Manga, error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
   var result []mangas.Manga
   query := m.getMangaSelectQuery(&result).
     Relation("Genres").
+    Relation("Tags").
+    Where("manga.content_rating IN (?)", bun.In(ratings)).
     OrderExpr("RANDOM()").
     Limit(int(limit)).
     Order("manga.original_title")
@@ -238,13 +474,15 @@ func (m mangaRepository) FindRandomMangas(limit uint64) ([]mangas.Manga, error)
   return util.CheckSliceResult(result, err).Unwrap()
 }
 
-func (m mangaRepository) ListMangas(parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Manga], error) {
+func (m mangaRepository) ListMangas(ratings []mangas.ContentRating, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Manga], error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
   var result []mangas.Manga
   query := m.getMangaSelectQuery(&result).
     Relation("Genres").
+    Relation("Tags").
+    Where("manga.content_rating IN (?)", bun.In(ratings)).
     Order("manga.updated_at DESC")
   query = parameter.Insert(query)
 
@@ -265,6 +503,197 @@ func (m mangaRepository) CreateVolume(volume *mangas.Volume) error {
   return util.CheckSqlResult(res, err)
 }
 
+func (m mangaRepository) EditVolume(volume *mangas.Volume) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewUpdate().
+    Model(volume).
+    WherePK().
+    Column("title", "description", "number").
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaRepository) PatchVolume(volume *mangas.Volume) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewUpdate().
+    Model(volume).
+    WherePK().
+    OmitZero().
+    ExcludeColumn("id", "manga_id").
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaRepository) FindVolumeById(id string) (*mangas.Volume, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result mangas.Volume
+  err := m.db.NewSelect().
+    Model(&result).
+    Where("id = ?", id).
+    Scan(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return &result, nil
+}
+
+func (m mangaRepository) FindMangaIdBySlug(slug string) (string, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  // Current slug is prioritized over the previous ones
+  slugs := m.db.NewSelect().
+    Model(util.Nil[mangas.Manga]()).
+    ColumnExpr("id AS manga_id, 0 AS priority").
+    Where("slug = ?", slug).
+    UnionAll(m.db.NewSelect().
+      Model(util.Nil[mangas.MangaSlug]()).
+      ColumnExpr("manga_id, 1 AS priority").
+      Where("slug = ?", slug))
+
+  var mangaId string
+  err := m.db.NewSelect().
+    TableExpr("(?) AS slugs", slugs).
+    Column("manga_id").
+    Order("priority").
+    Limit(1).
+    Scan(ctx, &mangaId)
+  return mangaId, err
+}
+
+func (m mangaRepository) FindSimilarSlugs(slug string) ([]string, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []string
+  err := m.db.NewSelect().
+    Model(util.Nil[mangas.Manga]()).
+    Column("slug").
+    Where("slug = ? OR slug LIKE ?", slug, slug+"-%").
+    UnionAll(m.db.NewSelect().
+      Model(util.Nil[mangas.MangaSlug]()).
+      Column("slug").
+      Where("slug = ? OR slug LIKE ?", slug, slug+"-%")).
+    Scan(ctx, &result)
+
+  return result, err
+}
+
+func (m mangaRepository) UpdateMangaSlug(mangaId, slug string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  tx, err := m.db.BeginTx(ctx, nil)
+  if err != nil {
+    return err
+  }
+
+  err = m.updateMangaSlug(ctx, tx, mangaId, slug)
+  if err != nil {
+    err2 := tx.Rollback()
+    if err2 != nil {
+      return err2
+    }
+    return err
+  }
+
+  return tx.Commit()
+}
+
+func (m mangaRepository) updateMangaSlug(ctx context.Context, tx bun.Tx, mangaId, slug string) error {
+  var current mangas.Manga
+  err := tx.NewSelect().
+    Model(&current).
+    Column("id", "slug").
+    Where("id = ?", mangaId).
+    For("UPDATE").
+    Scan(ctx)
+  if err != nil {
+    return err
+  }
+  if current.Slug == slug {
+    return nil
+  }
+
+  // Previous slug of other manga could not be used
+  used, err := tx.NewSelect().
+    Model(util.Nil[mangas.MangaSlug]()).
+    Where("slug = ? AND manga_id != ?", slug, mangaId).
+    Exists(ctx)
+  if err != nil {
+    return err
+  }
+  if used {
+    return mangas.ErrSlugAlreadyUsed
+  }
+
+  // Reuse the previous slug of the same manga
+  _, err = tx.NewDelete().
+    Model(util.Nil[mangas.MangaSlug]()).
+    Where("slug = ? AND manga_id = ?", slug, mangaId).
+    Exec(ctx)
+  if err != nil {
+    return err
+  }
+
+  if len(current.Slug) != 0 {
+    history := mangas.NewMangaSlug(mangaId, current.Slug)
+    _, err = tx.NewInsert().
+      Model(&history).
+      Returning("NULL").
+      Exec(ctx)
+    if err != nil {
+      return err
+    }
+  }
+
+  res, err := tx.NewUpdate().
+    Model(&current).
+    Set("slug = ?", slug).
+    Set("updated_at = ?", time.Now()).
+    WherePK().
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaRepository) EditMangaDownload(mangaId string, allowed bool) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewUpdate().
+    Model(util.Nil[mangas.Manga]()).
+    Set("allow_download = ?", allowed).
+    Set("updated_at = ?", time.Now()).
+    Where("id = ?", mangaId).
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaRepository) EditMangaExport(mangaId string, allowed bool) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewUpdate().
+    Model(util.Nil[mangas.Manga]()).
+    Set("allow_export = ?", allowed).
+    Set("updated_at = ?", time.Now()).
+    Where("id = ?", mangaId).
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
 func (m mangaRepository) FindMinimalMangaById(id string) (*mangas.Manga, error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
@@ -289,6 +718,7 @@ func (m mangaRepository) FindMangasById(ids ...string) ([]mangas.Manga, error) {
   var result []mangas.Manga
   query := m.getMangaSelectQuery(&result).
     Relation("Genres").
+    Relation("Tags").
     Relation("Volumes", func(query *bun.SelectQuery) *bun.SelectQuery {
       return query.Order("number")
     }).
@@ -301,7 +731,30 @@ func (m mangaRepository) FindMangasById(ids ...string) ([]mangas.Manga, error) {
         Group("chapter.id", "translator.id")
     }).
     Relation("Volumes.Chapters.Translator").
+    Relation("Volumes.Chapters.Groups").
+    Relation("Chapters", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Where("chapter.volume_id IS NULL").
+        Order("number", "created_at").
+        ColumnExpr("COUNT(DISTINCT comment.*) AS total_comment, chapter.*").
+        Join("LEFT JOIN comments AS comment").
+        JoinOn("comment.object_type = ?", mangas.CommentObjectChapter.String()).
+        JoinOn("comment.object_id = chapter.id").
+        Group("chapter.id", "translator.id")
+    }).
+    Relation("Chapters.Translator").
+    Relation("Chapters.Groups").
     Relation("Translations").
+    Relation("AlternativeTitles", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("kind", "language", "title")
+    }).
+    Relation("Staff", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("manga_staff.role")
+    }).
+    Relation("Staff.Person").
+    Relation("Relations", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("manga_relation.kind")
+    }).
+    Relation("Relations.Related").
     Where("manga.id IN (?)", bun.In(ids))
 
   err := query.Scan(ctx)
@@ -309,7 +762,7 @@ func (m mangaRepository) FindMangasById(ids ...string) ([]mangas.Manga, error) {
   return util.CheckSliceResult(result, err).Unwrap()
 }
 
-func (m mangaRepository) FindMangaHistories(userId string, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.MangaHistory], error) {
+func (m mangaRepository) FindMangaHistories(userId string, ratings []mangas.ContentRating, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.MangaHistory], error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
 
@@ -320,9 +773,11 @@ func (m mangaRepository) FindMangaHistories(userId string, pagedQuery repo.Query
     //Join("JOIN ? AS chapter ON ? = ?", bun.Ident("chapters"), bun.Ident("chapter.id"), bun.Ident("chapter_id")).
     //Join("JOIN ? AS volume ON ? = ?", bun.Ident("volumes"), bun.Ident("volume.id"), bun.Ident("chapter.volume_id")).
     //Join("JOIN ? AS manga ON ? = ?", bun.Ident("mangas"), bun.Ident("manga.id"), bun.Ident("volume.manga_id")).
-    Relation("Chapter.Volume.Manga.Genres").
+    Relation("Chapter.Manga.Genres").
+    Relation("Chapter.Manga.Tags").
     Where("user_id = ?", userId).
-    Group("chapter.id", "chapter__volume.id", "chapter__volume__manga.id").
+    Where("chapter__manga.content_rating IN (?)", bun.In(ratings)).
+    Group("chapter.id", "chapter__manga.id").
     Order("last_view DESC")
 
   query = pagedQuery.Insert(query)
@@ -332,14 +787,14 @@ func (m mangaRepository) FindMangaHistories(userId string, pagedQuery repo.Query
   actual := containers.CastSlicePtr(result, func(current *mangas.ChapterHistory) mangas.MangaHistory {
     return mangas.MangaHistory{
       LastView: current.LastView,
-      Manga:    current.Chapter.Volume.Manga,
+      Manga:    current.Chapter.Manga,
     }
   })
   res := util.CheckSliceResult(actual, err)
   return repo.NewResult(res.Data, count), res.Err
 }
 
-func (m mangaRepository) FindMangaFavorites(userId string, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.MangaFavorite], error) {
+func (m mangaRepository) FindMangaFavorites(userId string, ratings []mangas.ContentRating, pagedQuery repo.QueryParameter) (repo.PagedQueryResult[[]mangas.MangaFavorite], error) {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
   //ctx := context.Background()
@@ -350,8 +805,10 @@ func (m mangaRepository) FindMangaFavorites(userId string, pagedQuery repo.Query
     Model(&result).
     Group("manga.id").
     Where("manga_favorite.user_id = ?", userId).
+    Where("manga.content_rating IN (?)", bun.In(ratings)).
     Relation("Manga").
     Relation("Manga.Genres").
+    Relation("Manga.Tags").
     Join("LEFT JOIN ? ON ? = ?", bun.Ident("rates"), bun.Ident("manga.id"), bun.Ident("rates.manga_id")).
     Join("LEFT JOIN comments AS comment").
     JoinOn("comment.object_type = ?", mangas.CommentObjectManga.String()).
@@ -480,7 +480,7 @@ func Test_mangaRepository_FindMangaFavorites(t *testing.T) {
   for _, tt := range tests {
     t.Run(tt.name, func(t *testing.T) {
       mangaRepo := NewManga(Db)
-      got, err := mangaRepo.FindMangaFavorites(tt.args.userId, tt.args.param)
+      got, err := mangaRepo.FindMangaFavorites(tt.args.userId, mangas.DefaultContentRatings, tt.args.param)
       if (err != nil) != tt.wantErr {
         t.Errorf("FindMangaFavorites() error = %v, wantErr %v", err, tt.wantErr)
         return
@@ -692,7 +692,7 @@ func Test_mangaRepository_FindMangaHistories(t *testing.T) {
   for _, tt := range tests {
     mangaRepo := NewManga(Db)
     t.Run(tt.name, func(t *testing.T) {
-      got, err := mangaRepo.FindMangaHistories(tt.args.userId, tt.args.param)
+      got, err := mangaRepo.FindMangaHistories(tt.args.userId, mangas.DefaultContentRatings, tt.args.param)
       if (err != nil) != tt.wantErr {
         t.Errorf("FindMangaHistories() error = %v, wantErr %v", err, tt.wantErr)
         return
@@ -1166,7 +1166,7 @@ func Test_mangaRepository_FindRandomMangas(t *testing.T) {
   for _, tt := range tests {
     m := NewManga(Db)
     t.Run(tt.name, func(t *testing.T) {
-      got, err := m.FindRandomMangas(tt.args.limit)
+      got, err := m.FindRandomMangas(tt.args.limit, mangas.DefaultContentRatings)
       if (err != nil) != tt.wantErr {
         t.Errorf("FindRandomMangas() error = %v, wantErr %v", err, tt.wantErr)
         return
@@ -1243,7 +1243,7 @@ func Test_mangaRepository_ListMangas(t *testing.T) {
   for _, tt := range tests {
     m := NewManga(Db)
     t.Run(tt.name, func(t *testing.T) {
-      got, err := m.ListMangas(tt.args.param)
+      got, err := m.ListMangas(mangas.DefaultContentRatings, tt.args.param)
       if (err != nil) != tt.wantErr {
         t.Errorf("ListMangas() error = %v, wantErr %v", err, tt.wantErr)
         return
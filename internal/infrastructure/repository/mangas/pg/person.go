@@ -0,0 +1,95 @@
+package pg
+
+import (
+  "context"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/repository"
+  repo "manga-explorer/internal/infrastructure/repository"
+  "manga-explorer/internal/util"
+  "strings"
+  "time"
+)
+
+func NewPerson(db bun.IDB) repository.IPerson {
+  return &personRepository{db: db}
+}
+
+type personRepository struct {
+  db bun.IDB
+}
+
+func (p personRepository) CreatePerson(person *mangas.Person) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := p.db.NewInsert().
+    Model(person).
+    Returning("NULL").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (p personRepository) UpdatePerson(person *mangas.Person) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := p.db.NewUpdate().
+    Model(person).
+    WherePK().
+    ExcludeColumn("id", "created_at").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (p personRepository) DeletePersonById(personId string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := p.db.NewDelete().
+    Model(util.Nil[mangas.Person]()).
+    Where("id = ?", personId).
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (p personRepository) FindPersonById(personId string) (*mangas.Person, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  result := new(mangas.Person)
+  err := p.db.NewSelect().
+    Model(result).
+    Relation("Works", func(query *bun.SelectQuery) *bun.SelectQuery {
+      return query.Order("manga_staff.role")
+    }).
+    Relation("Works.Manga").
+    Where("person.id = ?", personId).
+    Scan(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+  return result, nil
+}
+
+func (p personRepository) ListPeople(name string, parameter repo.QueryParameter) (repo.PagedQueryResult[[]mangas.Person], error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.Person
+  query := p.db.NewSelect().
+    Model(&result).
+    Order("person.name")
+
+  if len(name) > 0 {
+    name = "%" + strings.ToLower(name) + "%"
+    query = query.Where("LOWER(person.name) LIKE ? OR LOWER(person.native_name) LIKE ?", name, name)
+  }
+  query = parameter.Insert(query)
+
+  count, err := query.ScanAndCount(ctx)
+
+  res := util.CheckSliceResult(result, err)
+  return repo.NewResult(res.Data, count), res.Err
+}
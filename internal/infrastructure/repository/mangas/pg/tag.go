@@ -0,0 +1,67 @@
+package pg
+
+import (
+  "context"
+  "github.com/uptrace/bun"
+  "manga-explorer/internal/domain/mangas"
+  "manga-explorer/internal/domain/mangas/repository"
+  "manga-explorer/internal/util"
+  "time"
+)
+
+func NewMangaTag(db bun.IDB) repository.ITag {
+  return &mangaTagRepository{db: db}
+}
+
+type mangaTagRepository struct {
+  db bun.IDB
+}
+
+func (m mangaTagRepository) CreateTag(tag *mangas.Tag) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewInsert().
+    Model(tag).
+    Returning("NULL").
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaTagRepository) UpdateTag(tag *mangas.Tag) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  // Group is not using OmitZero, because the first group is zero valued
+  res, err := m.db.NewUpdate().
+    Model(tag).
+    WherePK().
+    Column("name", "group", "updated_at").
+    Exec(ctx)
+
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaTagRepository) DeleteTagById(tagId string) error {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  res, err := m.db.NewDelete().
+    Model((*mangas.Tag)(nil)).
+    Where("id = ?", tagId).
+    Exec(ctx)
+  return util.CheckSqlResult(res, err)
+}
+
+func (m mangaTagRepository) ListTags() ([]mangas.Tag, error) {
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+
+  var result []mangas.Tag
+  err := m.db.NewSelect().
+    Model(&result).
+    Order("group", "name").
+    Scan(ctx)
+
+  return util.CheckSliceResult(result, err).Unwrap()
+}
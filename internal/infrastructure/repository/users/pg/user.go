@@ -51,6 +51,7 @@ func (u UserRepository) UpdateProfileByUserId(profile *users.Profile) error {
     OmitZero().
     ExcludeColumn("user_id").
     Where("user_id = ?", profile.UserId)
+  query = resetAllowedRatings(query, profile)
 
   res, err := query.
     Exec(ctx)
@@ -67,12 +68,21 @@ func (u UserRepository) UpdateProfile(profile *users.Profile) error {
     OmitZero().
     ExcludeColumn("user_id").
     WherePK()
+  query = resetAllowedRatings(query, profile)
 
   res, err := query.Exec(ctx)
 
   return util.CheckSqlResult(res, err)
 }
 
+// resetAllowedRatings set the allowed ratings to NULL when it is explicitly empty, so the default ratings are used
+func resetAllowedRatings(query *bun.UpdateQuery, profile *users.Profile) *bun.UpdateQuery {
+  if profile.AllowedRatings != nil && len(profile.AllowedRatings) == 0 {
+    return query.Value("allowed_ratings", "NULL")
+  }
+  return query
+}
+
 func (u UserRepository) CreateUser(user *users.User, profile *users.Profile) error {
   ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
   defer cancel()
@@ -25,7 +25,14 @@ func HttpCodeFromError(err status.Object) int {
     status.PAGE_INSERT_FAILED, status.PAGE_NOT_FOUND, status.GENRE_ALREADY_EXIST, status.GENRE_NOT_FOUND,
     status.RATING_NOT_FOUND, status.COMMENT_PARENT_NOT_FOUND, status.COMMENT_PARENT_DIFFERENT_SCOPE,
     status.COMMENT_CREATE_FAILED, status.VOLUME_CREATE_FAILED, status.MANGA_TRANSLATION_CREATE_FAILED,
-    status.EMPTY_BODY_REQUEST:
+    status.EMPTY_BODY_REQUEST, status.PERSON_NOT_FOUND, status.PERSON_UPDATE_FAILED, status.MANGA_STAFF_UPDATE_FAILED,
+    status.MANGA_ALT_TITLE_ALREADY_EXIST, status.MANGA_ALT_TITLE_NOT_FOUND, status.MANGA_ALT_TITLE_CREATE_FAILED,
+    status.MANGA_RELATION_SELF_REFERENCE, status.MANGA_RELATION_NOT_FOUND, status.MANGA_RELATION_CREATE_FAILED,
+    status.TAG_ALREADY_EXIST, status.TAG_NOT_FOUND, status.VOLUME_NOT_FOUND, status.VOLUME_UPDATE_FAILED,
+    status.CHAPTER_MOVE_FAILED, status.GROUP_NOT_FOUND, status.GROUP_ALREADY_EXIST, status.GROUP_UPDATE_FAILED,
+    status.MANGA_SLUG_ALREADY_EXIST, status.PAGE_ARCHIVE_INVALID, status.PAGE_IMAGE_INVALID,
+    status.DOWNLOAD_DISABLED, status.EXPORT_DISABLED, status.PAGE_ORDER_INVALID, status.FILE_FORMAT_UNSUPPORTED,
+    status.FILE_FORMAT_MISMATCH, status.FILE_TOO_LARGE:
     return http.StatusBadRequest
   case status.USER_AGENT_UNKNOWN_ERROR, status.CREDENTIALS_NOT_FOUND, status.JWT_TOKEN_MALFORMED,
     status.ACCESS_TOKEN_EXPIRED, status.ACCESS_TOKEN_WITHOUT_REFRESH_TOKEN, status.AUTH_UNAUTHORIZED,
@@ -4,6 +4,7 @@ import (
   "github.com/gin-gonic/gin"
   "manga-explorer/internal/common/dto"
   "manga-explorer/internal/common/status"
+  "mime"
 )
 
 // Success Used to set common.Response as response for success response
@@ -29,6 +30,23 @@ func Error(ctx *gin.Context, status status.Object) {
 
 }
 
+// File Used to stream the file as attachment when the status is not error. Error that happens while streaming could
+// not be sent to the client, because the headers are already sent
+func File(ctx *gin.Context, status status.Object, file *dto.FileResponse) {
+  if status.IsError() {
+    Error(ctx, status)
+    return
+  }
+
+  ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Filename}))
+  ctx.Header("Content-Type", file.ContentType)
+  ctx.Status(HttpCodeFromError(status))
+  if err := file.Write(ctx.Writer); err != nil {
+    _ = ctx.Error(err)
+    ctx.Abort()
+  }
+}
+
 // Conditional Used to set response based on the common.Object passed as parameter. depending
 // on the value of the common.Object it will call Error or Success
 func Conditional(ctx *gin.Context, status status.Object, successData any, page *dto.ResponsePage) {
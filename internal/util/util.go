@@ -133,3 +133,59 @@ func SliceWrap[T any](datas ...T) []T {
   t := []T{}
   return append(t, datas...)
 }
+
+// Slugify Convert the string into lowercase alphanumeric words separated by '-', non-ascii characters will be removed
+func Slugify(str string) string {
+  builder := strings.Builder{}
+  builder.Grow(len(str))
+
+  separated := true // Prevent leading and consecutive separator
+  for _, r := range strings.ToLower(str) {
+    if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+      builder.WriteRune(r)
+      separated = false
+    } else if !separated {
+      builder.WriteByte('-')
+      separated = true
+    }
+  }
+
+  return strings.TrimSuffix(builder.String(), "-")
+}
+
+// NaturalLess Compare the strings by treating the digits as number, so "page2" will be less than "page10"
+func NaturalLess(a, b string) bool {
+  i, j := 0, 0
+  for i < len(a) && j < len(b) {
+    if isDigit(a[i]) && isDigit(b[j]) {
+      // Take the whole number
+      startA, startB := i, j
+      for i < len(a) && isDigit(a[i]) {
+        i++
+      }
+      for j < len(b) && isDigit(b[j]) {
+        j++
+      }
+      numA := strings.TrimLeft(a[startA:i], "0")
+      numB := strings.TrimLeft(b[startB:j], "0")
+      if len(numA) != len(numB) {
+        return len(numA) < len(numB)
+      }
+      if numA != numB {
+        return numA < numB
+      }
+      continue
+    }
+
+    if a[i] != b[j] {
+      return a[i] < b[j]
+    }
+    i++
+    j++
+  }
+  return len(a)-i < len(b)-j
+}
+
+func isDigit(c byte) bool {
+  return c >= '0' && c <= '9'
+}
@@ -50,3 +50,88 @@ func TestSetDefaultString(t *testing.T) {
     })
   }
 }
+
+func TestSlugify(t *testing.T) {
+  tests := []struct {
+    name     string
+    str      string
+    expected string
+  }{
+    {
+      name:     "Simple title",
+      str:      "Homeboy",
+      expected: "homeboy",
+    },
+    {
+      name:     "Title with symbols",
+      str:      "Freddy vs. Jason",
+      expected: "freddy-vs-jason",
+    },
+    {
+      name:     "Leading and trailing symbols",
+      str:      "  ...Hello, World!!  ",
+      expected: "hello-world",
+    },
+    {
+      name:     "Non-ascii title",
+      str:      "進撃の巨人",
+      expected: "",
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      assert.Equal(t, tt.expected, Slugify(tt.str))
+    })
+  }
+}
+
+func TestNaturalLess(t *testing.T) {
+  tests := []struct {
+    name     string
+    a        string
+    b        string
+    expected bool
+  }{
+    {
+      name:     "Different number length",
+      a:        "page2.jpg",
+      b:        "page10.jpg",
+      expected: true,
+    },
+    {
+      name:     "Leading zeros",
+      a:        "010.png",
+      b:        "9.png",
+      expected: false,
+    },
+    {
+      name:     "Same number different suffix",
+      a:        "001a.png",
+      b:        "001b.png",
+      expected: true,
+    },
+    {
+      name:     "Nested directory",
+      a:        "chapter 2/01.jpg",
+      b:        "chapter 10/01.jpg",
+      expected: true,
+    },
+    {
+      name:     "Prefix is less",
+      a:        "page",
+      b:        "page1",
+      expected: true,
+    },
+    {
+      name:     "Equal string",
+      a:        "page1",
+      b:        "page1",
+      expected: false,
+    },
+  }
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      assert.Equal(t, tt.expected, NaturalLess(tt.a, tt.b))
+    })
+  }
+}